@@ -0,0 +1,204 @@
+package identifiers
+
+import (
+	"bytes"
+	"slices"
+)
+
+// AccountIndex is an index of identifiers keyed by their 24-byte account ID (fingerprint).
+//
+// It is a radix tree over the account IDs, where each leaf holds the identifiers of one account
+// in sorted order. This allows every identifier under an account (such as all variants of an
+// AssetID or LogicID) to be listed efficiently, along with ordered scans over all accounts that
+// share a prefix. An AccountIndex is not safe for concurrent use, and must be created with
+// NewAccountIndex.
+type AccountIndex struct {
+	root *radixNode
+	size int
+}
+
+// radixNode is a node in the radix tree of an AccountIndex.
+// Leaf nodes are at the depth of a full account ID and hold the identifiers of that account.
+type radixNode struct {
+	// prefix is the label of the edge from the parent node
+	prefix []byte
+	// children are the child nodes, sorted by the first byte of their prefix
+	children []*radixNode
+	// ids are the identifiers of the account (only for leaf nodes)
+	ids IdentifierList
+}
+
+// NewAccountIndex creates a new empty AccountIndex
+func NewAccountIndex() *AccountIndex {
+	return &AccountIndex{root: &radixNode{}}
+}
+
+// child returns the position of the child node with the given first byte and whether it exists
+func (node *radixNode) child(first byte) (int, bool) {
+	return slices.BinarySearchFunc(node.children, first, func(child *radixNode, first byte) int {
+		return int(child.prefix[0]) - int(first)
+	})
+}
+
+// lookup returns the node for the given remaining key, or nil if it does not exist.
+// If partial is true, the node whose path ends within its prefix is also returned.
+func (node *radixNode) lookup(remaining []byte, partial bool) *radixNode {
+	for len(remaining) > 0 {
+		position, found := node.child(remaining[0])
+		if !found {
+			return nil
+		}
+
+		child := node.children[position]
+
+		switch {
+		case bytes.HasPrefix(remaining, child.prefix):
+			remaining = remaining[len(child.prefix):]
+		case partial && bytes.HasPrefix(child.prefix, remaining):
+			return child
+		default:
+			return nil
+		}
+
+		node = child
+	}
+
+	return node
+}
+
+// walk calls the given function for each identifier in the subtree of the node,
+// ordered by their account ID and then by the identifier within each account.
+// Returns false if the iteration was stopped by the function.
+func (node *radixNode) walk(fn func(Identifier) bool) bool {
+	for _, id := range node.ids {
+		if !fn(id) {
+			return false
+		}
+	}
+
+	for _, child := range node.children {
+		if !child.walk(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// remove removes the given identifier from the subtree of the node, pruning any
+// empty nodes and merging nodes with a single child. Returns whether it was removed.
+func (node *radixNode) remove(remaining []byte, id Identifier) bool {
+	if len(remaining) == 0 {
+		position, found := node.ids.SearchBinary(id)
+		if found {
+			node.ids = slices.Delete(node.ids, position, position+1)
+		}
+
+		return found
+	}
+
+	position, found := node.child(remaining[0])
+	if !found || !bytes.HasPrefix(remaining, node.children[position].prefix) {
+		return false
+	}
+
+	child := node.children[position]
+	if !child.remove(remaining[len(child.prefix):], id) {
+		return false
+	}
+
+	switch {
+	case len(child.ids) == 0 && len(child.children) == 0:
+		// Prune the empty child node
+		node.children = slices.Delete(node.children, position, position+1)
+	case len(child.ids) == 0 && len(child.children) == 1:
+		// Merge the child node with its only child
+		grandchild := child.children[0]
+		grandchild.prefix = append(slices.Clip(child.prefix), grandchild.prefix...)
+		node.children[position] = grandchild
+	}
+
+	return true
+}
+
+// Insert adds the given identifier to the index under its account ID.
+// Returns false if the identifier is already in the index.
+func (index *AccountIndex) Insert(id TaggedIdentifier) bool {
+	account := id.AccountID()
+	node, remaining := index.root, account[:]
+
+	for len(remaining) > 0 {
+		position, found := node.child(remaining[0])
+		if !found {
+			// Add a new leaf node for the account
+			leaf := &radixNode{prefix: slices.Clone(remaining), ids: IdentifierList{Identifier(id.Bytes())}}
+			node.children = slices.Insert(node.children, position, leaf)
+			index.size++
+
+			return true
+		}
+
+		child := node.children[position]
+
+		// Find the length of the common prefix of the child and the remaining key
+		common := 0
+		for common < len(child.prefix) && child.prefix[common] == remaining[common] {
+			common++
+		}
+
+		// Split the child node if the key diverges within its prefix
+		if common < len(child.prefix) {
+			split := &radixNode{prefix: slices.Clip(child.prefix[:common]), children: []*radixNode{child}}
+			child.prefix = child.prefix[common:]
+			node.children[position] = split
+			child = split
+		}
+
+		node, remaining = child, remaining[common:]
+	}
+
+	if !node.ids.InsertSorted(Identifier(id.Bytes())) {
+		return false
+	}
+
+	index.size++
+
+	return true
+}
+
+// Remove removes the given identifier from the index.
+// Returns false if the identifier is not in the index.
+func (index *AccountIndex) Remove(id TaggedIdentifier) bool {
+	account := id.AccountID()
+	if !index.root.remove(account[:], Identifier(id.Bytes())) {
+		return false
+	}
+
+	index.size--
+
+	return true
+}
+
+// Len returns the number of identifiers in the index
+func (index *AccountIndex) Len() int { return index.size }
+
+// AllVariantsOf returns all identifiers in the index with the given account ID in ascending order.
+// This includes all variants of all kinds of identifiers that share the account ID.
+func (index *AccountIndex) AllVariantsOf(account [24]byte) []Identifier {
+	node := index.root.lookup(account[:], false)
+	if node == nil {
+		return nil
+	}
+
+	return slices.Clone(node.ids)
+}
+
+// ScanPrefix calls the given function for each identifier in the index whose account ID starts with
+// the given prefix, ordered by their account ID and then by the identifier within each account.
+// An empty prefix scans all identifiers in the index.
+// The scan stops if the function returns false.
+func (index *AccountIndex) ScanPrefix(prefix []byte, fn func(Identifier) bool) {
+	if node := index.root.lookup(prefix, true); node != nil {
+		node.walk(fn)
+	}
+}
@@ -0,0 +1,109 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// accountWith returns an account ID with the given leading bytes followed by zeroes
+func accountWith(leading ...byte) (account [24]byte) {
+	copy(account[:], leading)
+	return account
+}
+
+func TestAccountIndex(t *testing.T) {
+	accountA := accountWith(0xAA, 0x01)
+	accountB := accountWith(0xAA, 0x02)
+	accountC := accountWith(0xBB)
+
+	assetA0 := must(GenerateAssetIDv0(accountA, 0, 0))
+	assetA1 := must(GenerateAssetIDv0(accountA, 1, 0))
+	logicA0 := must(GenerateLogicIDv0(accountA, 0, 0))
+	assetB0 := must(GenerateAssetIDv0(accountB, 0, 0))
+	logicC5 := must(GenerateLogicIDv0(accountC, 5, 0))
+
+	index := NewAccountIndex()
+	for _, id := range []TaggedIdentifier{assetA1, logicC5, assetA0, logicA0, assetB0} {
+		require.True(t, index.Insert(id))
+	}
+
+	require.False(t, index.Insert(assetA0))
+	require.Equal(t, 5, index.Len())
+
+	t.Run("AllVariantsOf", func(t *testing.T) {
+		require.Equal(t, []Identifier{
+			assetA0.AsIdentifier(), assetA1.AsIdentifier(), logicA0.AsIdentifier(),
+		}, index.AllVariantsOf(accountA))
+
+		require.Equal(t, []Identifier{assetB0.AsIdentifier()}, index.AllVariantsOf(accountB))
+		require.Nil(t, index.AllVariantsOf(accountWith(0xAA, 0x03)))
+		require.Nil(t, index.AllVariantsOf(accountWith(0xCC)))
+	})
+
+	t.Run("ScanPrefix", func(t *testing.T) {
+		scan := func(prefix ...byte) (scanned []Identifier) {
+			index.ScanPrefix(prefix, func(id Identifier) bool {
+				scanned = append(scanned, id)
+				return true
+			})
+
+			return scanned
+		}
+
+		require.Equal(t, []Identifier{
+			assetA0.AsIdentifier(), assetA1.AsIdentifier(), logicA0.AsIdentifier(), assetB0.AsIdentifier(),
+		}, scan(0xAA))
+
+		require.Equal(t, []Identifier{assetB0.AsIdentifier()}, scan(0xAA, 0x02))
+		require.Equal(t, []Identifier{logicC5.AsIdentifier()}, scan(0xBB, 0x00, 0x00))
+		require.Len(t, scan(), 5)
+		require.Nil(t, scan(0xAA, 0x03))
+		require.Nil(t, scan(0xBB, 0x01))
+		require.Nil(t, scan(make([]byte, 25)...))
+
+		// The scan stops when the function returns false
+		count := 0
+		index.ScanPrefix(nil, func(Identifier) bool {
+			count++
+			return count < 2
+		})
+		require.Equal(t, 2, count)
+
+		count = 0
+		index.ScanPrefix(nil, func(Identifier) bool {
+			count++
+			return count < 4
+		})
+		require.Equal(t, 4, count)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		require.False(t, index.Remove(must(GenerateAssetIDv0(accountA, 7, 0))))
+		require.False(t, index.Remove(must(GenerateAssetIDv0(accountWith(0xAA, 0x03), 0, 0))))
+		require.False(t, index.Remove(must(GenerateAssetIDv0(accountWith(0xCC), 0, 0))))
+
+		require.True(t, index.Remove(assetA1))
+		require.False(t, index.Remove(assetA1))
+		require.Equal(t, 4, index.Len())
+
+		// Removing the only identifier of an account prunes its node and merges its parent
+		require.True(t, index.Remove(assetB0))
+		require.Nil(t, index.AllVariantsOf(accountB))
+		require.Len(t, index.root.children, 2)
+		require.Len(t, index.root.children[0].prefix, 24)
+
+		require.Equal(t, []Identifier{assetA0.AsIdentifier(), logicA0.AsIdentifier()}, index.AllVariantsOf(accountA))
+
+		// Accounts can be re-inserted after being merged
+		require.True(t, index.Insert(assetB0))
+		require.Equal(t, []Identifier{assetB0.AsIdentifier()}, index.AllVariantsOf(accountB))
+
+		for _, id := range []TaggedIdentifier{assetA0, logicA0, assetB0, logicC5} {
+			require.True(t, index.Remove(id))
+		}
+
+		require.Equal(t, 0, index.Len())
+		require.Empty(t, index.root.children)
+	})
+}
@@ -0,0 +1,122 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/hex"
+	"errors"
+)
+
+// Address is a 32-byte address of an account in the MOI Protocol.
+//
+// Addresses predate identifiers and relate to them as follows:
+//   - The 24-byte account ID (fingerprint) of an identifier is the 24 bytes in
+//     the middle of an address, with the first and last 4 bytes being truncated.
+//     This rule is implemented by AccountIDFromAddress.
+//   - The address derived from an identifier is the 32 bytes of the identifier itself,
+//     such that the fingerprint of the identifier is always recovered from its address.
+//     This rule is implemented by the DerivedAddress methods of the identifiers.
+type Address [32]byte
+
+// NewAddressFromHex creates a new Address from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional), with a length of 64 characters (32 bytes)
+func NewAddressFromHex(data string) (Address, error) {
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Check length of the data
+	if len(decoded) != 32 {
+		return Nil, errors.New("invalid length: address must be 32 bytes")
+	}
+
+	return Address(decoded), nil
+}
+
+// MustAddressFromHex is an enforced version of NewAddressFromHex.
+// Panics if an error occurs. Use with caution.
+func MustAddressFromHex(data string) Address { return must(NewAddressFromHex(data)) }
+
+// AccountIDFromAddress returns the 24-byte account ID for the given Address.
+// The account ID is the 24 bytes in the middle of the address, which is also used
+// as the fingerprint when an identifier is generated for an address.
+func AccountIDFromAddress(address Address) [24]byte {
+	return trimFingerprint(address)
+}
+
+// Bytes returns the Address as a []byte
+func (address Address) Bytes() []byte { return address[:] }
+
+// String returns the Address as a hex-encoded string.
+// This is identical to Address.Hex() but is required for the fmt.Stringer interface
+func (address Address) String() string { return address.Hex() }
+
+// Hex returns the Address as a hex-encoded string with the 0x prefix
+func (address Address) Hex() string {
+	return prefix0xString + hex.EncodeToString(address[:])
+}
+
+// AccountID returns the 24-byte account ID for the Address.
+// This is identical to calling AccountIDFromAddress with the Address.
+func (address Address) AccountID() [24]byte {
+	return AccountIDFromAddress(address)
+}
+
+var (
+	// Ensure Address implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*Address)(nil)
+	_ encoding.TextUnmarshaler   = (*Address)(nil)
+	_ encoding.BinaryMarshaler   = (*Address)(nil)
+	_ encoding.BinaryUnmarshaler = (*Address)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for Address
+func (address Address) MarshalText() ([]byte, error) {
+	return marshal32(address)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Address
+func (address *Address) UnmarshalText(data []byte) error {
+	decoded, err := unmarshal32(data)
+	if err != nil {
+		return err
+	}
+
+	*address = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Address.
+// The Address is encoded as its raw 32 bytes.
+func (address Address) MarshalBinary() ([]byte, error) {
+	return address.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Address.
+// The data must be 32 bytes long
+func (address *Address) UnmarshalBinary(data []byte) error {
+	// Check length of the data
+	if len(data) != 32 {
+		return errors.New("invalid length: address must be 32 bytes")
+	}
+
+	*address = Address(data)
+	return nil
+}
+
+// DerivedAddress returns the Address derived from the Identifier.
+// The fingerprint of the Identifier is the account ID of the derived address.
+func (id Identifier) DerivedAddress() Address { return Address(id) }
+
+// DerivedAddress returns the Address derived from the ParticipantID.
+// The fingerprint of the ParticipantID is the account ID of the derived address.
+func (participant ParticipantID) DerivedAddress() Address { return Address(participant) }
+
+// DerivedAddress returns the Address derived from the AssetID.
+// The fingerprint of the AssetID is the account ID of the derived address.
+func (asset AssetID) DerivedAddress() Address { return Address(asset) }
+
+// DerivedAddress returns the Address derived from the LogicID.
+// The fingerprint of the LogicID is the account ID of the derived address.
+func (logic LogicID) DerivedAddress() Address { return Address(logic) }
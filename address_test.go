@@ -0,0 +1,87 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddress(t *testing.T) {
+	address := MustAddressFromHex("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+
+	assert.Equal(t, address[:], address.Bytes())
+	assert.Equal(t, "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f", address.Hex())
+	assert.Equal(t, address.Hex(), address.String())
+
+	// The account ID is the 24 bytes in the middle of the address
+	expected := [24]byte{
+		0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b,
+		0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13,
+		0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b,
+	}
+
+	assert.Equal(t, expected, AccountIDFromAddress(address))
+	assert.Equal(t, expected, address.AccountID())
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := NewAddressFromHex("0xZZ")
+		require.EqualError(t, err, "encoding/hex: invalid byte: U+005A 'Z'")
+
+		_, err = NewAddressFromHex("0x0102")
+		require.EqualError(t, err, "invalid length: address must be 32 bytes")
+
+		assert.Panics(t, func() { MustAddressFromHex("0x0102") })
+	})
+
+	t.Run("TextMarshal", func(t *testing.T) {
+		encoded, err := json.Marshal(address)
+		require.NoError(t, err)
+		require.Equal(t, `"`+address.Hex()+`"`, string(encoded))
+
+		var decoded Address
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, address, decoded)
+
+		require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"0102"`), &decoded))
+	})
+
+	t.Run("BinaryMarshal", func(t *testing.T) {
+		encoded, err := address.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, address.Bytes(), encoded)
+
+		var decoded Address
+
+		require.NoError(t, decoded.UnmarshalBinary(encoded))
+		require.Equal(t, address, decoded)
+
+		require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: address must be 32 bytes")
+	})
+}
+
+func TestDerivedAddress(t *testing.T) {
+	participant := RandomParticipantIDv0()
+	asset := RandomAssetIDv0()
+	logic := RandomLogicIDv0()
+	id := RandomTopicIDv0().AsIdentifier()
+
+	tests := []struct {
+		address     Address
+		fingerprint [24]byte
+		bytes       []byte
+	}{
+		{participant.DerivedAddress(), participant.Fingerprint(), participant.Bytes()},
+		{asset.DerivedAddress(), asset.Fingerprint(), asset.Bytes()},
+		{logic.DerivedAddress(), logic.Fingerprint(), logic.Bytes()},
+		{id.DerivedAddress(), id.Fingerprint(), id.Bytes()},
+	}
+
+	for _, tt := range tests {
+		// The fingerprint is always recovered from the derived address
+		assert.Equal(t, tt.fingerprint, AccountIDFromAddress(tt.address))
+		assert.Equal(t, tt.bytes, tt.address.Bytes())
+	}
+}
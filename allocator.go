@@ -0,0 +1,140 @@
+package identifiers
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Store persists the variant IDs reserved for each base identifier by a VariantAllocator.
+// Implementations can be backed by memory (MemoryStore), a file (FileStore) or a database, such as a SQL
+// table with a row per base identifier that is updated with UPDATE ... SET last = last + count RETURNING last.
+type Store interface {
+	// Reserve atomically reserves up to the given number of the next unreserved variant IDs for the
+	// given base identifier, and returns the first and last reserved variant IDs (both inclusive).
+	// Variant IDs are reserved in ascending order starting from 1, and fewer variant IDs are reserved
+	// if the variant space is nearly exhausted. Once Reserve returns, the reservation must be durable,
+	// so that no variant ID is ever reserved twice. Returns ErrVariantsExhausted if all variant IDs
+	// of the base identifier have been reserved.
+	Reserve(ctx context.Context, base Identifier, count uint32) (first, last uint32, err error)
+}
+
+// VariantAllocator hands out the next unused variant of base identifiers, for services that mint
+// sequential variants (such as the editions of an asset). The first variant of a base identifier is 1.
+//
+// Variants are reserved from a Store in blocks and handed out from memory, so that the store is only
+// accessed once per block. Reservations are durable before any of their variants are handed out,
+// which makes the allocator crash-safe: variants of a block that are not handed out before a crash
+// or restart are skipped, but no variant is ever handed out twice. A VariantAllocator is safe for
+// concurrent use, and must be created with NewVariantAllocator.
+type VariantAllocator struct {
+	mutex     sync.Mutex
+	store     Store
+	blockSize uint32
+
+	// blocks are the unused reserved variants of each base identifier
+	blocks map[Identifier]*variantBlock
+}
+
+// variantBlock is a range of reserved variants, of which the variants from next to last are unused
+type variantBlock struct {
+	next, last uint32
+	exhausted  bool
+}
+
+// NewVariantAllocator creates a new VariantAllocator that reserves variants from the given Store
+// in blocks of the given size. Larger blocks reduce the accesses to the store, but skip more variants
+// when the allocator is restarted. Panics if the block size is zero.
+func NewVariantAllocator(store Store, blockSize uint32) *VariantAllocator {
+	if blockSize == 0 {
+		panic("variant allocator block size must be positive")
+	}
+
+	return &VariantAllocator{store: store, blockSize: blockSize, blocks: make(map[Identifier]*variantBlock)}
+}
+
+// Next returns the next unused variant of the given base identifier, which must be valid and have a zero
+// variant ID. Variants of the same base identifier are handed out in ascending order. Returns an error if
+// the base identifier is invalid, if the store fails or if all variants of the base identifier are used.
+func (allocator *VariantAllocator) Next(ctx context.Context, base Identifier) (Identifier, error) {
+	if err := base.Validate(); err != nil {
+		return Nil, fmt.Errorf("invalid base: %w", err)
+	}
+
+	if base.IsVariant() {
+		return Nil, errors.New("invalid base: identifier is a variant")
+	}
+
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	block, ok := allocator.blocks[base]
+	if !ok || block.exhausted {
+		first, last, err := allocator.store.Reserve(ctx, base, allocator.blockSize)
+		if err != nil {
+			return Nil, err
+		}
+
+		block = &variantBlock{next: first, last: last}
+		allocator.blocks[base] = block
+	}
+
+	variant := base
+	binary.BigEndian.PutUint32(variant[28:], block.next)
+
+	// Mark the block as exhausted instead of incrementing past the last variant
+	if block.next == block.last {
+		block.exhausted = true
+	} else {
+		block.next++
+	}
+
+	return variant, nil
+}
+
+// reserveVariants reserves up to count (at least one) variant IDs after the given last reserved
+// variant ID, and returns the first and last reserved variant IDs. Returns ErrVariantsExhausted if no
+// variant IDs remain after the last reserved variant ID.
+func reserveVariants(reserved, count uint32) (first, last uint32, err error) {
+	if reserved == math.MaxUint32 {
+		return 0, 0, ErrVariantsExhausted
+	}
+
+	first, last = reserved+1, reserved+min(max(count, 1), math.MaxUint32-reserved)
+
+	return first, last, nil
+}
+
+// MemoryStore is a Store that keeps its reservations in memory, for tests and for services
+// whose variants do not need to survive a restart. The zero value is an empty store that is
+// ready to use. A MemoryStore is safe for concurrent use.
+type MemoryStore struct {
+	mutex    sync.Mutex
+	reserved map[Identifier]uint32
+}
+
+// Reserve implements the Store interface for MemoryStore
+func (store *MemoryStore) Reserve(ctx context.Context, base Identifier, count uint32) (uint32, uint32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	first, last, err := reserveVariants(store.reserved[base], count)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if store.reserved == nil {
+		store.reserved = make(map[Identifier]uint32)
+	}
+
+	store.reserved[base] = last
+
+	return first, last, nil
+}
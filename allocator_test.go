@@ -0,0 +1,167 @@
+package identifiers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// faultyStore is a Store that fails every reservation
+type faultyStore struct{}
+
+var errFaultyStore = errors.New("faulty store")
+
+func (faultyStore) Reserve(context.Context, Identifier, uint32) (uint32, uint32, error) {
+	return 0, 0, errFaultyStore
+}
+
+func TestNewVariantAllocator(t *testing.T) {
+	assert.PanicsWithValue(t, "variant allocator block size must be positive", func() {
+		NewVariantAllocator(&MemoryStore{}, 0)
+	})
+}
+
+func TestVariantAllocator_Next(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Sequential", func(t *testing.T) {
+		store := &MemoryStore{}
+		allocator := NewVariantAllocator(store, 4)
+
+		asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1, AssetStateful)).AsIdentifier()
+		logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+
+		for variant := uint32(1); variant <= 10; variant++ {
+			next, err := allocator.Next(ctx, asset)
+			require.NoError(t, err)
+			require.Equal(t, must(asset.DeriveVariant(variant, nil, nil)), next)
+		}
+
+		// Test that base identifiers have independent variants
+		next, err := allocator.Next(ctx, logic)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), next.Variant())
+
+		// Test that variants are reserved from the store in blocks
+		assert.Equal(t, uint32(12), store.reserved[asset])
+		assert.Equal(t, uint32(4), store.reserved[logic])
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		store := &MemoryStore{}
+		base := must(GenerateParticipantIDv0(RandomFingerprint(), 0)).AsIdentifier()
+
+		first := NewVariantAllocator(store, 8)
+		for range 3 {
+			_, err := first.Next(ctx, base)
+			require.NoError(t, err)
+		}
+
+		// Test that a new allocator skips the unused variants of the previous block
+		next, err := NewVariantAllocator(store, 8).Next(ctx, base)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(9), next.Variant())
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		allocator := NewVariantAllocator(&MemoryStore{}, 3)
+		base := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+
+		var (
+			group    sync.WaitGroup
+			mutex    sync.Mutex
+			variants = make(map[uint32]bool)
+		)
+
+		for range 8 {
+			group.Add(1)
+
+			go func() {
+				defer group.Done()
+
+				for range 50 {
+					next, err := allocator.Next(ctx, base)
+					assert.NoError(t, err)
+
+					mutex.Lock()
+					assert.False(t, variants[next.Variant()])
+					variants[next.Variant()] = true
+					mutex.Unlock()
+				}
+			}()
+		}
+
+		group.Wait()
+		assert.Len(t, variants, 400)
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		base := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+		store := &MemoryStore{reserved: map[Identifier]uint32{base: math.MaxUint32 - 3}}
+		allocator := NewVariantAllocator(store, 2)
+
+		for _, expected := range []uint32{math.MaxUint32 - 2, math.MaxUint32 - 1, math.MaxUint32} {
+			next, err := allocator.Next(ctx, base)
+			require.NoError(t, err)
+			assert.Equal(t, expected, next.Variant())
+		}
+
+		_, err := allocator.Next(ctx, base)
+		assert.ErrorIs(t, err, ErrVariantsExhausted)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		allocator := NewVariantAllocator(&MemoryStore{}, 2)
+
+		var invalid Identifier
+		invalid[0] = 0xF0
+
+		_, err := allocator.Next(ctx, invalid)
+		assert.EqualError(t, err, "invalid base: invalid tag: unsupported tag kind")
+
+		_, err = allocator.Next(ctx, must(RandomLogicIDv0().AsIdentifier().DeriveVariant(5, nil, nil)))
+		assert.EqualError(t, err, "invalid base: identifier is a variant")
+
+		base := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+
+		_, err = NewVariantAllocator(faultyStore{}, 2).Next(ctx, base)
+		assert.ErrorIs(t, err, errFaultyStore)
+	})
+}
+
+func TestMemoryStore_Reserve(t *testing.T) {
+	var store MemoryStore
+
+	base := RandomAssetIDv0().AsIdentifier()
+
+	first, last, err := store.Reserve(context.Background(), base, 10)
+	require.NoError(t, err)
+	assert.Equal(t, [2]uint32{1, 10}, [2]uint32{first, last})
+
+	// Test that at least one variant is reserved
+	first, last, err = store.Reserve(context.Background(), base, 0)
+	require.NoError(t, err)
+	assert.Equal(t, [2]uint32{11, 11}, [2]uint32{first, last})
+
+	// Test that fewer variants are reserved at the end of the variant space
+	store.reserved[base] = math.MaxUint32 - 2
+
+	first, last, err = store.Reserve(context.Background(), base, 10)
+	require.NoError(t, err)
+	assert.Equal(t, [2]uint32{math.MaxUint32 - 1, math.MaxUint32}, [2]uint32{first, last})
+
+	_, _, err = store.Reserve(context.Background(), base, 10)
+	assert.ErrorIs(t, err, ErrVariantsExhausted)
+
+	// Test that a cancelled context fails the reservation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = store.Reserve(ctx, RandomAssetIDv0().AsIdentifier(), 1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
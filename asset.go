@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand/v2"
+	"time"
 )
 
 // AssetID is a unique identifier for an asset in the MOI Protocol.
@@ -15,6 +16,7 @@ import (
 //   - Tag: The first byte contains the tag for the asset identifier.
 //   - Flags: The second byte contains flags for the asset identifier.
 //   - Metadata: The next 2 bytes contain the standard for the asset.
+//     As of v1, they contain the dimension and an 8-bit standard for the asset.
 //
 // Like all identifiers, the AssetID also contains a Fingerprint and a Variant ID.
 // Flags of an AssetID are specific to a version and are invalid if set in an unsupported version.
@@ -48,6 +50,12 @@ func NewAssetIDFromBytes(data []byte) (AssetID, error) {
 // The given value must decode as hexadecimal string (0x prefix is optional),
 // with a length of 64 characters (32 bytes) and validate into an AssetID.
 func NewAssetIDFromHex(data string) (AssetID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindAsset {
+		return AssetID(id), nil
+	}
+
 	// Decode the given hex string into []byte
 	decoded, err := decodeHexString(data)
 	if err != nil {
@@ -56,7 +64,15 @@ func NewAssetIDFromHex(data string) (AssetID, error) {
 
 	// Create a new AssetID from the decoded value
 	// Length check is performed in NewAssetIDFromBytes
-	return NewAssetIDFromBytes(decoded)
+	converted, err := NewAssetIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
 }
 
 // MustAssetID is an enforced version of NewAssetID.
@@ -83,6 +99,9 @@ func (asset AssetID) Hex() string {
 	return prefix0xString + hex.EncodeToString(asset[:])
 }
 
+// IsNil returns if the AssetID is nil, i.e., 0x000..000
+func (asset AssetID) IsNil() bool { return asset == AssetID{} }
+
 // AsIdentifier returns the AssetID as an AssetID.
 func (asset AssetID) AsIdentifier() Identifier {
 	return Identifier(asset)
@@ -93,11 +112,28 @@ func (asset AssetID) Tag() IdentifierTag {
 	return IdentifierTag(asset[0])
 }
 
+// Flags returns the byte of flag bits from the AssetID
+func (asset AssetID) Flags() byte {
+	return asset[1]
+}
+
+// ListFlags returns the flags (including custom flags) that are set on the AssetID, ordered by their bit index.
+// Set bits that are not supported by the AssetID are not listed (see FlagSet.List).
+func (asset AssetID) ListFlags() []Flag {
+	return NewFlagSet(asset.Tag(), asset.Flags()).List()
+}
+
 // Fingerprint returns the 24-byte fingerprint ID from the AssetID.
 func (asset AssetID) Fingerprint() [24]byte {
 	return trimFingerprint(asset)
 }
 
+// AccountID returns the 24-byte account ID from the AssetID.
+// This is identical to AssetID.Fingerprint()
+func (asset AssetID) AccountID() [24]byte {
+	return trimFingerprint(asset)
+}
+
 // Variant returns the 32-bit variant ID from the AssetID.
 func (asset AssetID) Variant() uint32 {
 	variant := trimVariant(asset)
@@ -110,12 +146,39 @@ func (asset AssetID) IsVariant() bool {
 	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
 }
 
+// Series returns the variant ID of the AssetID as the position of the asset in its series,
+// and whether the variant ID of its kind is interpreted as a series (see VariantSemantics).
+func (asset AssetID) Series() (uint32, bool) {
+	return variantAs(asset.AsIdentifier(), SeriesVariant)
+}
+
 // Standard returns the 16-bit standard for the AssetID.
+// As of v1, the standard is encoded in 8 bits and is at most 255.
 func (asset AssetID) Standard() uint16 {
+	if asset.Tag().Version() >= 1 {
+		// get the standard from the 3rd byte
+		return uint16(asset[3])
+	}
+
 	// get the standard from the 2nd and 3rd bytes
 	return binary.BigEndian.Uint16(asset[2:4])
 }
 
+// Dimension returns the 8-bit dimension for the AssetID.
+// Returns 0 for v0 AssetIDs, which have no dimension.
+//
+// Every 8-bit value is a valid dimension, as the dimension of the legacy AssetID format was an unconstrained
+// byte, so it is not checked by AssetID.Validate. Applications that only accept a narrower range of dimensions
+// can enforce it by registering a MetadataValidator for KindAsset.
+func (asset AssetID) Dimension() uint8 {
+	if asset.Tag().Version() == 0 {
+		return 0
+	}
+
+	// get the dimension from the 2nd byte
+	return asset[2]
+}
+
 // Flag returns if the given Flag is set on the AssetID.
 //
 // If the specified flag is not supported by the AssetID,
@@ -135,26 +198,39 @@ func (asset AssetID) Flag(flag Flag) bool {
 func (asset AssetID) Validate() error {
 	// Check basic validity of the identifier tag
 	if err := asset.Tag().Validate(); err != nil {
-		return fmt.Errorf("invalid tag: %w", err)
+		return invalidTag(err)
 	}
 
 	// Check if the tag is an asset tag
 	if asset.Tag().Kind() != KindAsset {
-		return errors.New("invalid tag: not an asset id")
+		return ErrNotAssetID
 	}
 
 	// Check that there are no unsupported flags set
-	if (asset[1] & flagMasks[asset.Tag()]) != 0 {
-		return errors.New("invalid flags: unsupported flags for asset id")
+	if (asset[1] & asset.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: asset.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(asset)); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// DeriveVariant returns a new AssetID with the given variant ID and specified flags set/unset.
+// Returns an error if the given flags are not supported for the AssetID.
+func (asset AssetID) DeriveVariant(variant uint32, set []Flag, unset []Flag) (AssetID, error) {
+	return deriveVariant(asset, variant, set, unset, NewAssetID)
+}
+
 var (
-	// Ensure AssetID implements text marshaling interfaces
-	_ encoding.TextMarshaler   = (*AssetID)(nil)
-	_ encoding.TextUnmarshaler = (*AssetID)(nil)
+	// Ensure AssetID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*AssetID)(nil)
+	_ encoding.TextUnmarshaler   = (*AssetID)(nil)
+	_ encoding.BinaryMarshaler   = (*AssetID)(nil)
+	_ encoding.BinaryUnmarshaler = (*AssetID)(nil)
 )
 
 // MarshalText implements the encoding.TextMarshaler interface for AssetID
@@ -162,9 +238,45 @@ func (asset AssetID) MarshalText() ([]byte, error) {
 	return marshal32(asset)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for AssetID
+// UnmarshalText implements the encoding.TextUnmarshaler interface for AssetID.
+// Legacy AssetID strings (with the 0x prefix) are also accepted if AllowLegacy is enabled.
 func (asset *AssetID) UnmarshalText(data []byte) error {
-	decoded, err := unmarshal32(data)
+	// Convert the legacy AssetID format if allowed
+	if AllowLegacy && has0xPrefixBytes(data) && len(trim0xPrefixBytes(data)) == legacyAssetIDLength*2 {
+		converted, err := ConvertLegacyAssetID(string(data))
+		if err != nil {
+			return err
+		}
+
+		*asset = converted
+		return nil
+	}
+
+	decoded, err := unmarshalCached(data, KindAsset)
+	if err != nil {
+		return err
+	}
+
+	*asset = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for AssetID.
+// The AssetID is encoded as its raw 32 bytes.
+func (asset AssetID) MarshalBinary() ([]byte, error) {
+	return asset.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for AssetID.
+// The data must be 32 bytes long and validate into an AssetID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (asset *AssetID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*asset = AssetID{}
+		return nil
+	}
+
+	decoded, err := NewAssetIDFromBytes(data)
 	if err != nil {
 		return err
 	}
@@ -176,7 +288,7 @@ func (asset *AssetID) UnmarshalText(data []byte) error {
 // GenerateAssetIDv0 creates a new AssetID for v0 with the given parameters.
 // Returns an error if unsupported flags are used.
 //
-// [tag:1][{systemic}{reserved:5}{logical}{stateful}][standard:2][fingerprint:24][variant:4]
+// [tag:1][{systemic}{nested}{reserved:4}{logical}{stateful}][standard:2][fingerprint:24][variant:4]
 func GenerateAssetIDv0(fingerprint [24]byte, variant uint32, standard uint16, flags ...Flag) (AssetID, error) {
 	// Create the metadata buffer
 	// [tag][flags][standard]
@@ -207,6 +319,11 @@ func GenerateAssetIDv0(fingerprint [24]byte, variant uint32, standard uint16, fl
 	buffer = append(buffer, make([]byte, 4)...)
 	binary.BigEndian.PutUint32(buffer[28:], variant)
 
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
 	return AssetID(buffer), nil
 }
 
@@ -231,3 +348,123 @@ func RandomAssetIDv0() AssetID {
 
 	return asset
 }
+
+// DeriveAssetIDv0Salted derives the v0 AssetID of an asset created by the given deployer with the given salt
+// and specification hash (such as the hash of its creation payload), which allows factories to compute the
+// AssetID of an asset counterfactually. The fingerprint is derived from a domain-separated hash of the deployer,
+// salt, specification hash and standard, and the variant is always zero.
+// Returns an error if the deployer is invalid or if unsupported flags are used.
+func DeriveAssetIDv0Salted(
+	deployer ParticipantID, salt, specHash [32]byte, standard uint16, flags ...Flag,
+) (AssetID, error) {
+	// Check that the deployer is a valid participant
+	if err := deployer.Validate(); err != nil {
+		return Nil, fmt.Errorf("invalid deployer: %w", err)
+	}
+
+	// Derive the fingerprint from the deployer, salt, specification hash and standard
+	fingerprint := hashFingerprint("moi.asset.salted",
+		deployer.Bytes(), salt[:], specHash[:], binary.BigEndian.AppendUint16(nil, standard))
+
+	return GenerateAssetIDv0(fingerprint, 0, standard, flags...)
+}
+
+// checkStandardV1 returns ErrInvalidAssetStandard if the given standard does not fit into the 8-bit standard of v1
+func checkStandardV1(standard uint16) error {
+	if standard > math.MaxUint8 {
+		return fmt.Errorf("%w: %d does not fit into 8 bits for v1", ErrInvalidAssetStandard, standard)
+	}
+
+	return nil
+}
+
+// GenerateAssetIDv1 creates a new AssetID for v1 with the given parameters.
+// Unlike v0, the metadata contains the dimension of the asset (as in the legacy AssetID format)
+// and an 8-bit standard. It additionally supports the AssetFungible, AssetMintable and AssetPausable flags.
+// Returns an error if unsupported flags are used or if the standard does not fit into 8 bits.
+//
+// [tag:1][flags:1][dimension:1][standard:1][fingerprint:24][variant:4]
+// [flags] = [{systemic}{nested}{reserved:1}{pausable}{mintable}{fungible}{logical}{stateful}]
+func GenerateAssetIDv1(
+	fingerprint [24]byte, variant uint32, standard uint16, dimension uint8, flags ...Flag,
+) (AssetID, error) {
+	// Check that the standard fits into its metadata byte
+	if err := checkStandardV1(standard); err != nil {
+		return Nil, err
+	}
+
+	// Create the metadata buffer
+	// [tag][flags][dimension][standard]
+	metadata := make([]byte, 4)
+	// Attach the tag for AssetID v1
+	metadata[0] = byte(TagAssetV1)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by AssetID v1
+		if !flag.Supports(TagAssetV1) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Attach the dimension and standard to the metadata
+	metadata[2] = dimension
+	metadata[3] = uint8(standard)
+
+	// Order the asset ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return AssetID(buffer), nil
+}
+
+// RandomAssetIDv1 creates a random v1 AssetID with a
+// random fingerprint ID, variant ID, standard, dimension and flags.
+//   - There is a 50% chance that the AssetLogical flag will be set.
+//   - There is a 50% chance that the AssetStateful flag will be set.
+//   - There is a 50% chance that each of the AssetFungible, AssetMintable and AssetPausable flags will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomAssetIDv1() AssetID {
+	flags := make([]Flag, 0, 5)
+
+	for _, flag := range []Flag{AssetLogical, AssetStateful, AssetFungible, AssetMintable, AssetPausable} {
+		if rand.Int64() > 0 {
+			flags = append(flags, flag)
+		}
+	}
+
+	// Safe to ignore error as the flags are supported
+	asset, _ := GenerateAssetIDv1(
+		RandomFingerprint(), rand.Uint32(), uint16(rand.UintN(math.MaxUint8)), uint8(rand.UintN(math.MaxUint8)), flags...,
+	)
+
+	return asset
+}
+
+// GenerateAssetIDv1Timed creates a new AssetID for v1 with a time-sortable variant for the given time
+// (see TimeVariant), so that the assets minted by the same account sort chronologically.
+// Returns an error if the time is outside the range of time variants, if unsupported flags are used
+// or if the standard does not fit into 8 bits.
+func GenerateAssetIDv1Timed(
+	fingerprint [24]byte, t time.Time, standard uint16, dimension uint8, flags ...Flag,
+) (AssetID, error) {
+	variant, err := TimeVariant(t)
+	if err != nil {
+		return Nil, err
+	}
+
+	return GenerateAssetIDv1(fingerprint, variant, standard, dimension, flags...)
+}
@@ -266,6 +266,34 @@ func TestAssetID_TextMarshal(t *testing.T) {
 			"encoding/hex: invalid byte: U+0059 'Y'",
 		)
 	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		legacy := legacyAssetID(0x01, 2, 0x10, [32]byte{})[2:]
+
+		var decoded AssetID
+
+		// Legacy strings are rejected unless allowed
+		require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"`+legacy+`"`), &decoded))
+
+		AllowLegacy = true
+		t.Cleanup(func() { AllowLegacy = false })
+
+		require.NoError(t, json.Unmarshal([]byte(`"0x`+legacy+`"`), &decoded))
+		require.Equal(t, must(ConvertLegacyAssetID(legacy)), decoded)
+
+		// Legacy strings must have the 0x prefix, like tagged AssetIDs
+		require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"`+legacy+`"`), &decoded))
+
+		// Tagged AssetIDs are still accepted
+		tagged := RandomAssetIDv0()
+		require.NoError(t, json.Unmarshal([]byte(`"`+tagged.Hex()+`"`), &decoded))
+		require.Equal(t, tagged, decoded)
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"`+legacyAssetID(0x04, 0, 0, [32]byte{})+`"`), &decoded),
+			"unsupported flag: legacy asset id flag at bit 2",
+		)
+	})
 }
 
 func TestAssetID_Generation(t *testing.T) {
@@ -292,6 +320,43 @@ func TestAssetID_Generation(t *testing.T) {
 			assert.Equal(t, err, ErrUnsupportedFlag)
 		})
 
+		t.Run("DeriveSalted", func(t *testing.T) {
+			deployer := RandomParticipantIDv0()
+			salt, specHash := [32]byte{1}, [32]byte{2}
+
+			assetID, err := DeriveAssetIDv0Salted(deployer, salt, specHash, 1, AssetStateful)
+			require.NoError(t, err)
+			require.NoError(t, assetID.Validate())
+
+			assert.Equal(t, TagAssetV0, assetID.Tag())
+			assert.Equal(t, uint32(0), assetID.Variant())
+			assert.Equal(t, uint16(1), assetID.Standard())
+			assert.True(t, assetID.Flag(AssetStateful))
+			assert.Equal(t, hashFingerprint("moi.asset.salted", deployer.Bytes(), salt[:], specHash[:], []byte{0, 1}),
+				assetID.Fingerprint())
+
+			// Test deterministic derivation
+			rederived, err := DeriveAssetIDv0Salted(deployer, salt, specHash, 1, AssetStateful)
+			require.NoError(t, err)
+			assert.Equal(t, assetID, rederived)
+
+			// Test that the salt, specification hash and standard affect the fingerprint
+			for _, other := range []AssetID{
+				must(DeriveAssetIDv0Salted(deployer, [32]byte{3}, specHash, 1)),
+				must(DeriveAssetIDv0Salted(deployer, salt, [32]byte{3}, 1)),
+				must(DeriveAssetIDv0Salted(deployer, salt, specHash, 2)),
+			} {
+				assert.NotEqual(t, assetID.Fingerprint(), other.Fingerprint())
+			}
+
+			// Test invalid deployers and unsupported flags
+			_, err = DeriveAssetIDv0Salted(ParticipantID(RandomAssetIDv0()), salt, specHash, 1)
+			assert.EqualError(t, err, "invalid deployer: invalid tag: not a participant id")
+
+			_, err = DeriveAssetIDv0Salted(deployer, salt, specHash, 1, LogicAuxiliary)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
 		t.Run("Random", func(t *testing.T) {
 			assetID := RandomAssetIDv0()
 
@@ -299,4 +364,127 @@ func TestAssetID_Generation(t *testing.T) {
 			assert.Equal(t, TagAssetV0, assetID.Tag())
 		})
 	})
+
+	t.Run("v1", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			assetID, err := GenerateAssetIDv1(fingerprint, 42, 20, 0, AssetStateful, AssetLogical)
+			require.NoError(t, err)
+			require.NoError(t, assetID.Validate())
+
+			assert.Equal(t, TagAssetV1, assetID.Tag())
+			assert.Equal(t, uint32(42), assetID.Variant())
+			assert.Equal(t, uint16(20), assetID.Standard())
+			assert.True(t, assetID.Flag(AssetStateful))
+			assert.True(t, assetID.Flag(AssetLogical))
+
+			// Test unsupported flags
+			_, err = GenerateAssetIDv1(fingerprint, 42, 20, 0, LogicAuxiliary)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Dimension", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			assetID, err := GenerateAssetIDv1(fingerprint, 1, 255, 3, AssetStateful)
+			require.NoError(t, err)
+			require.NoError(t, assetID.Validate())
+
+			assert.Equal(t, uint8(3), assetID.Dimension())
+			assert.Equal(t, uint16(255), assetID.Standard())
+			assert.Equal(t, [2]byte{3, 255}, assetID.AsIdentifier().Metadata())
+
+			// The standard must fit into 8 bits
+			_, err = GenerateAssetIDv1(fingerprint, 1, 256, 3)
+			require.EqualError(t, err, "invalid asset standard: 256 does not fit into 8 bits for v1")
+			require.ErrorIs(t, err, ErrInvalidAssetStandard)
+
+			// Every 8-bit value is a valid dimension
+			for _, dimension := range []uint8{0, 1, 128, 255} {
+				asset := must(GenerateAssetIDv1(fingerprint, 1, 20, dimension))
+				require.NoError(t, asset.Validate())
+				require.NoError(t, asset.AsIdentifier().Validate())
+				assert.Equal(t, dimension, asset.Dimension())
+			}
+
+			// AssetID v0 has no dimension
+			v0 := must(GenerateAssetIDv0(fingerprint, 1, 0x0103))
+			assert.Equal(t, uint8(0), v0.Dimension())
+			assert.Equal(t, uint16(0x0103), v0.Standard())
+		})
+
+		t.Run("V1Flags", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			assetID, err := GenerateAssetIDv1(fingerprint, 0, 0, 0, AssetFungible, AssetMintable, AssetPausable)
+			require.NoError(t, err)
+			require.NoError(t, assetID.Validate())
+
+			assert.Equal(t, byte(0b00011100), assetID.Flags())
+			assert.Equal(t, []Flag{AssetFungible, AssetMintable, AssetPausable}, assetID.ListFlags())
+
+			// The flags are not supported by v0
+			for _, flag := range []Flag{AssetFungible, AssetMintable, AssetPausable} {
+				_, err = GenerateAssetIDv0(fingerprint, 0, 0, flag)
+				assert.Equal(t, err, ErrUnsupportedFlag)
+				assert.False(t, AssetID(must(GenerateAssetIDv0(fingerprint, 0, 0))).Flag(flag))
+			}
+
+			assert.ErrorIs(t, AssetID{byte(TagAssetV0), 0b00000100}.Validate(), ErrBadFlags)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			assetID := RandomAssetIDv1()
+
+			assert.NoError(t, assetID.Validate())
+			assert.Equal(t, TagAssetV1, assetID.Tag())
+		})
+	})
+}
+
+func TestAssetID_ListFlags(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0, Systemic, AssetStateful, AssetLogical))
+	assert.Equal(t, []Flag{AssetStateful, AssetLogical, Systemic}, asset.ListFlags())
+	assert.Empty(t, must(GenerateAssetIDv0(RandomFingerprint(), 0, 0)).ListFlags())
+}
+
+func TestAssetID_DeriveVariant(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 7, AssetStateful))
+
+	derived, err := asset.DeriveVariant(42, []Flag{AssetLogical}, []Flag{AssetStateful})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(42), derived.Variant())
+	assert.Equal(t, uint16(7), derived.Standard())
+	assert.Equal(t, asset.AccountID(), derived.AccountID())
+	assert.True(t, derived.Flag(AssetLogical))
+	assert.False(t, derived.Flag(AssetStateful))
+
+	// Test that the typed derivation matches the untyped derivation
+	assert.Equal(t, must(asset.AsIdentifier().DeriveVariant(42, []Flag{AssetLogical}, []Flag{AssetStateful})),
+		derived.AsIdentifier())
+
+	// Test unsupported flags
+	_, err = asset.DeriveVariant(42, []Flag{LogicIntrinsic}, nil)
+	assert.Equal(t, ErrUnsupportedFlag, err)
+
+	// Test that invalid asset IDs cannot be derived
+	_, err = AssetID(RandomLogicIDv0()).DeriveVariant(42, nil, nil)
+	assert.Equal(t, ErrNotAssetID, err)
+}
+
+func TestAssetID_BinaryMarshal(t *testing.T) {
+	asset := RandomAssetIDv0()
+
+	encoded, err := asset.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, asset.Bytes(), encoded)
+
+	var decoded AssetID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, asset, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: asset id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagLogicV0)}, make([]byte, 31)...)),
+		"invalid tag: not an asset id",
+	)
 }
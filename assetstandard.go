@@ -0,0 +1,106 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// AssetStandard is the 16-bit standard of an asset, which is encoded in the metadata of an AssetID.
+// Known standards are named, and custom standards can be named with RegisterAssetStandard.
+type AssetStandard uint16
+
+const (
+	// MAS0 is the AssetStandard for fungible assets
+	MAS0 AssetStandard = iota
+	// MAS1 is the AssetStandard for non-fungible assets
+	MAS1
+)
+
+var (
+	// assetStandardsLock guards access to assetStandardNames
+	assetStandardsLock sync.RWMutex
+	// assetStandardNames is a map of AssetStandard to its name.
+	// Custom standards can be added to it with RegisterAssetStandard.
+	assetStandardNames = map[AssetStandard]string{
+		MAS0: "MAS0",
+		MAS1: "MAS1",
+	}
+)
+
+// RegisterAssetStandard registers a name for the given custom AssetStandard, which is then
+// recognized by AssetStandard.String, ParseAssetStandard and AssetStandard.Validate.
+// Returns an error if the name is empty, or if the standard or the name is already registered.
+func RegisterAssetStandard(standard AssetStandard, name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidAssetStandard)
+	}
+
+	assetStandardsLock.Lock()
+	defer assetStandardsLock.Unlock()
+
+	if existing, exists := assetStandardNames[standard]; exists {
+		return fmt.Errorf("%w: %d is registered as %q", ErrAssetStandardExists, uint16(standard), existing)
+	}
+
+	for _, existing := range assetStandardNames {
+		if strings.EqualFold(existing, name) {
+			return fmt.Errorf("%w: %q", ErrAssetStandardExists, name)
+		}
+	}
+
+	assetStandardNames[standard] = name
+
+	return nil
+}
+
+// ParseAssetStandard returns the AssetStandard with the given name, such as "MAS0".
+// Names are matched case-insensitively. Returns ErrUnknownAssetStandard if the name is not recognized.
+func ParseAssetStandard(name string) (AssetStandard, error) {
+	assetStandardsLock.RLock()
+	defer assetStandardsLock.RUnlock()
+
+	for standard, standardName := range assetStandardNames {
+		if strings.EqualFold(standardName, name) {
+			return standard, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownAssetStandard, name)
+}
+
+// String returns the name of the AssetStandard, such as "MAS0".
+// Standards without a name are rendered as "standard(<value>)".
+func (standard AssetStandard) String() string {
+	assetStandardsLock.RLock()
+	defer assetStandardsLock.RUnlock()
+
+	if name, ok := assetStandardNames[standard]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("standard(%d)", uint16(standard))
+}
+
+// Validate returns ErrUnknownAssetStandard if the AssetStandard is not a known or registered standard
+func (standard AssetStandard) Validate() error {
+	assetStandardsLock.RLock()
+	defer assetStandardsLock.RUnlock()
+
+	if _, ok := assetStandardNames[standard]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownAssetStandard, uint16(standard))
+	}
+
+	return nil
+}
+
+// ValidateStandard checks if the AssetID is valid (see AssetID.Validate) and that its standard is a
+// known or registered AssetStandard. It is a stricter alternative to AssetID.Validate for applications
+// that only accept assets of recognized standards. Returns ErrUnknownAssetStandard if it is not.
+func (asset AssetID) ValidateStandard() error {
+	if err := asset.Validate(); err != nil {
+		return err
+	}
+
+	return AssetStandard(asset.Standard()).Validate()
+}
@@ -0,0 +1,65 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetStandard(t *testing.T) {
+	assert.Equal(t, "MAS0", MAS0.String())
+	assert.Equal(t, "MAS1", MAS1.String())
+	assert.Equal(t, "standard(20)", AssetStandard(20).String())
+
+	standard, err := ParseAssetStandard("mas1")
+	require.NoError(t, err)
+	assert.Equal(t, MAS1, standard)
+
+	_, err = ParseAssetStandard("MAS20")
+	require.EqualError(t, err, `unknown asset standard: "MAS20"`)
+
+	require.NoError(t, MAS0.Validate())
+	require.EqualError(t, AssetStandard(20).Validate(), "unknown asset standard: 20")
+}
+
+func TestRegisterAssetStandard(t *testing.T) {
+	const custom = AssetStandard(0x0114)
+
+	require.NoError(t, RegisterAssetStandard(custom, "MAS276"))
+	t.Cleanup(func() {
+		assetStandardsLock.Lock()
+		defer assetStandardsLock.Unlock()
+
+		delete(assetStandardNames, custom)
+	})
+
+	assert.Equal(t, "MAS276", custom.String())
+	assert.NoError(t, custom.Validate())
+
+	standard, err := ParseAssetStandard("mas276")
+	require.NoError(t, err)
+	assert.Equal(t, custom, standard)
+
+	t.Run("Errors", func(t *testing.T) {
+		err := RegisterAssetStandard(30, "")
+		require.EqualError(t, err, "invalid asset standard: name must not be empty")
+
+		err = RegisterAssetStandard(MAS1, "MAS1X")
+		require.EqualError(t, err, `asset standard already registered: 1 is registered as "MAS1"`)
+
+		err = RegisterAssetStandard(30, "mas276")
+		require.EqualError(t, err, `asset standard already registered: "mas276"`)
+	})
+}
+
+func TestAssetID_ValidateStandard(t *testing.T) {
+	require.NoError(t, must(GenerateAssetIDv0(RandomFingerprint(), 0, uint16(MAS1))).ValidateStandard())
+
+	err := must(GenerateAssetIDv0(RandomFingerprint(), 0, 20)).ValidateStandard()
+	require.ErrorIs(t, err, ErrUnknownAssetStandard)
+
+	// The AssetID must also be valid
+	err = AssetID(must(GenerateLogicIDv0(RandomFingerprint(), 0, 0))).ValidateStandard()
+	require.ErrorIs(t, err, ErrNotAssetID)
+}
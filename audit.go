@@ -0,0 +1,173 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditEvent represents a lifecycle event of an identifier that can be recorded in an audit log.
+type AuditEvent string
+
+const (
+	AuditGenerated AuditEvent = "generated"
+	AuditDerived   AuditEvent = "derived"
+	AuditMigrated  AuditEvent = "migrated"
+	AuditRevoked   AuditEvent = "revoked"
+)
+
+// Validate returns an error if the AuditEvent is not a recognized lifecycle event.
+func (event AuditEvent) Validate() error {
+	switch event {
+	case AuditGenerated, AuditDerived, AuditMigrated, AuditRevoked:
+		return nil
+	default:
+		return fmt.Errorf("invalid audit event: %q", string(event))
+	}
+}
+
+// AuditRecord is a single record in a hash-chained audit log of identifier lifecycle events.
+// Each record commits to the hash of the record before it, such that any modification,
+// removal or re-ordering of records in the log can be detected with VerifyAuditChain.
+type AuditRecord struct {
+	// Sequence is the position of the record in the log, starting at 1
+	Sequence uint64
+	// Timestamp is the time at which the event occurred
+	Timestamp time.Time
+	// Event is the lifecycle event that occurred
+	Event AuditEvent
+	// Actor is the participant that caused the event
+	Actor ParticipantID
+	// Target is the identifier that the event occurred for
+	Target Identifier
+	// Previous is the hash of the previous record in the log (zero for the first record)
+	Previous [32]byte
+}
+
+// Canonical returns the canonical rendering of the AuditRecord that is used to compute its hash.
+// It is a single line of space separated key=value pairs in a fixed order, with the timestamp
+// normalized to UTC in RFC3339 format with nanosecond precision.
+//
+// seq=<sequence> time=<timestamp> event=<event> actor=<actor> target=<target> prev=<previous>
+func (record AuditRecord) Canonical() string {
+	var builder strings.Builder
+
+	builder.WriteString("seq=" + strconv.FormatUint(record.Sequence, 10))
+	builder.WriteString(" time=" + record.Timestamp.UTC().Format(time.RFC3339Nano))
+	builder.WriteString(" event=" + string(record.Event))
+	builder.WriteString(" actor=" + record.Actor.Hex())
+	builder.WriteString(" target=" + record.Target.Hex())
+	builder.WriteString(" prev=" + prefix0xString + hex.EncodeToString(record.Previous[:]))
+
+	return builder.String()
+}
+
+// Hash returns the SHA-256 hash of the canonical rendering of the AuditRecord.
+func (record AuditRecord) Hash() [32]byte {
+	return sha256.Sum256([]byte(record.Canonical()))
+}
+
+// String returns the canonical rendering of the AuditRecord followed by its hash.
+// This is the format in which audit records are expected to be written into audit logs.
+func (record AuditRecord) String() string {
+	hash := record.Hash()
+	return record.Canonical() + " hash=" + prefix0xString + hex.EncodeToString(hash[:])
+}
+
+// AuditChain produces hash-chained AuditRecord values for a single audit log.
+// It tracks the sequence number and hash of the last record, linking every new record to it.
+// An AuditChain is safe for concurrent use, and its zero value is an empty chain ready for use.
+// A chain whose records were persisted by a previous process is continued with ResumeAuditChain.
+type AuditChain struct {
+	mutex sync.Mutex
+
+	sequence uint64
+	head     [32]byte
+}
+
+// ResumeAuditChain creates an AuditChain that continues an existing audit log, from the hash and sequence
+// number of its last record (see AuditChain.Head and AuditChain.Sequence), such as after a restart. The
+// records of the resumed chain are identical to those of a chain that was never interrupted. A zero
+// head and sequence resume an empty chain, which is equivalent to the zero value of AuditChain.
+func ResumeAuditChain(head [32]byte, sequence uint64) *AuditChain {
+	return &AuditChain{sequence: sequence, head: head}
+}
+
+// Record creates the next AuditRecord in the chain for the given event.
+// Returns an error if the event is not a recognized lifecycle event.
+func (chain *AuditChain) Record(
+	event AuditEvent, actor ParticipantID, target Identifier, timestamp time.Time,
+) (AuditRecord, error) {
+	if err := event.Validate(); err != nil {
+		return AuditRecord{}, err
+	}
+
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+
+	record := AuditRecord{
+		Sequence:  chain.sequence + 1,
+		Timestamp: timestamp,
+		Event:     event,
+		Actor:     actor,
+		Target:    target,
+		Previous:  chain.head,
+	}
+
+	// Advance the chain to the new record
+	chain.sequence, chain.head = record.Sequence, record.Hash()
+
+	return record, nil
+}
+
+// Head returns the hash of the last record in the chain (zero if no records exist)
+func (chain *AuditChain) Head() [32]byte {
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+
+	return chain.head
+}
+
+// Sequence returns the sequence number of the last record in the chain (zero if no records exist)
+func (chain *AuditChain) Sequence() uint64 {
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+
+	return chain.sequence
+}
+
+// VerifyAuditChain verifies that the given records form an unbroken hash chain that ends at the
+// given head hash (see AuditChain.Head), which must be obtained from a trusted source. The records
+// must be in order, start at sequence 1 with a zero previous hash, and every subsequent record must
+// reference the hash of the record before it. The head hash detects tampering with the last record
+// and records cut off the end of the chain, which the links between the records alone cannot detect.
+func VerifyAuditChain(records []AuditRecord, head [32]byte) error {
+	var previous [32]byte
+
+	for idx, record := range records {
+		if err := record.Event.Validate(); err != nil {
+			return fmt.Errorf("invalid audit record %d: %w", idx, err)
+		}
+
+		if record.Sequence != uint64(idx)+1 {
+			return fmt.Errorf("invalid audit record %d: out of sequence", idx)
+		}
+
+		if record.Previous != previous {
+			return fmt.Errorf("invalid audit record %d: broken hash chain", idx)
+		}
+
+		previous = record.Hash()
+	}
+
+	if previous != head {
+		return errors.New("invalid audit chain: head hash mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package identifiers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEvent_Validate(t *testing.T) {
+	for _, event := range []AuditEvent{AuditGenerated, AuditDerived, AuditMigrated, AuditRevoked} {
+		assert.NoError(t, event.Validate())
+	}
+
+	assert.EqualError(t, AuditEvent("deleted").Validate(), `invalid audit event: "deleted"`)
+}
+
+func TestAuditRecord(t *testing.T) {
+	record := AuditRecord{
+		Sequence:  1,
+		Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("IST", 19800)),
+		Event:     AuditGenerated,
+		Actor:     MustParticipantIDFromHex("0x0000000001020304050607081112131415161718212223242526272800000000"),
+		Target:    MustIdentifierFromHex("0x1003001001020304050607081112131415161718212223242526272800000042"),
+	}
+
+	expected := "seq=1 time=2024-01-01T21:34:05.000000006Z event=generated " +
+		"actor=0x0000000001020304050607081112131415161718212223242526272800000000 " +
+		"target=0x1003001001020304050607081112131415161718212223242526272800000042 " +
+		"prev=0x0000000000000000000000000000000000000000000000000000000000000000"
+
+	assert.Equal(t, expected, record.Canonical())
+	assert.Contains(t, record.String(), expected+" hash=0x")
+	assert.Len(t, record.String(), len(expected)+len(" hash=0x")+64)
+}
+
+func TestAuditChain(t *testing.T) {
+	var chain AuditChain
+
+	actor := RandomParticipantIDv0()
+	asset := RandomAssetIDv0().AsIdentifier()
+	derived := must(asset.DeriveVariant(1, nil, nil))
+	timestamp := time.Now()
+
+	records := make([]AuditRecord, 0, 3)
+
+	for _, step := range []struct {
+		event  AuditEvent
+		target Identifier
+	}{
+		{AuditGenerated, asset},
+		{AuditDerived, derived},
+		{AuditRevoked, derived},
+	} {
+		record, err := chain.Record(step.event, actor, step.target, timestamp)
+		require.NoError(t, err)
+
+		records = append(records, record)
+	}
+
+	assert.Equal(t, uint64(3), records[2].Sequence)
+	assert.Equal(t, [32]byte{}, records[0].Previous)
+	assert.Equal(t, records[0].Hash(), records[1].Previous)
+	assert.Equal(t, records[2].Hash(), chain.Head())
+	require.NoError(t, VerifyAuditChain(records, chain.Head()))
+
+	t.Run("InvalidEvent", func(t *testing.T) {
+		_, err := chain.Record("unknown", actor, asset, timestamp)
+		require.EqualError(t, err, `invalid audit event: "unknown"`)
+
+		// The chain must not advance for rejected events
+		assert.Equal(t, records[2].Hash(), chain.Head())
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		tampered := append([]AuditRecord{}, records...)
+		tampered[1].Target = asset
+
+		require.EqualError(t, VerifyAuditChain(tampered, chain.Head()), "invalid audit record 2: broken hash chain")
+	})
+
+	t.Run("TamperedHead", func(t *testing.T) {
+		tampered := append([]AuditRecord{}, records...)
+		tampered[2].Event = AuditGenerated
+
+		require.EqualError(t, VerifyAuditChain(tampered, chain.Head()), "invalid audit chain: head hash mismatch")
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		require.EqualError(t, VerifyAuditChain(records[:2], chain.Head()), "invalid audit chain: head hash mismatch")
+		require.EqualError(t, VerifyAuditChain(nil, chain.Head()), "invalid audit chain: head hash mismatch")
+		require.NoError(t, VerifyAuditChain(nil, [32]byte{}))
+	})
+
+	t.Run("Reordered", func(t *testing.T) {
+		require.EqualError(t,
+			VerifyAuditChain([]AuditRecord{records[1], records[0]}, chain.Head()),
+			"invalid audit record 0: out of sequence",
+		)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		// Resume the chain from the head and sequence persisted after the first record
+		resumed := ResumeAuditChain(records[0].Hash(), records[0].Sequence)
+		assert.Equal(t, records[0].Hash(), resumed.Head())
+		assert.Equal(t, uint64(1), resumed.Sequence())
+
+		appended := append([]AuditRecord{}, records[0])
+
+		for _, record := range records[1:] {
+			next, err := resumed.Record(record.Event, record.Actor, record.Target, record.Timestamp)
+			require.NoError(t, err)
+
+			appended = append(appended, next)
+		}
+
+		// Test that the records after resuming are identical to those of the uninterrupted chain
+		assert.Equal(t, records, appended)
+		assert.Equal(t, chain.Head(), resumed.Head())
+		assert.Equal(t, chain.Sequence(), resumed.Sequence())
+		require.NoError(t, VerifyAuditChain(appended, resumed.Head()))
+
+		// Test that resuming from zero is equivalent to an empty chain
+		assert.Equal(t, [32]byte{}, ResumeAuditChain([32]byte{}, 0).Head())
+	})
+
+	t.Run("BadEvent", func(t *testing.T) {
+		invalid := append([]AuditRecord{}, records...)
+		invalid[0].Event = "unknown"
+
+		require.EqualError(t,
+			VerifyAuditChain(invalid, chain.Head()),
+			`invalid audit record 0: invalid audit event: "unknown"`,
+		)
+	})
+}
@@ -0,0 +1,50 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/base64"
+)
+
+// Base64 wraps an identifier of the type T so that it is marshaled as text (and JSON) in the standard
+// base64 encoding (44 characters) instead of the 0x-prefixed hex encoding (66 characters), which reduces
+// the size of payloads with many identifiers. It can be used for individual fields of a payload, such as
+// with []Base64[AssetID], without affecting the encoding of identifiers elsewhere in the process.
+//
+// Like the identifier types, it unmarshals from both encodings, so the identifiers of a payload can be
+// switched to base64 without breaking consumers that use this package.
+type Base64[T AnyID] struct {
+	ID T
+}
+
+var (
+	// Ensure Base64 implements text marshaling interfaces
+	_ encoding.TextMarshaler   = (*Base64[Identifier])(nil)
+	_ encoding.TextUnmarshaler = (*Base64[Identifier])(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for Base64.
+// The identifier is encoded in the standard base64 encoding.
+func (wrapped Base64[T]) MarshalText() ([]byte, error) {
+	id := [32]byte(wrapped.ID)
+
+	buffer := make([]byte, base64Length32)
+	base64.StdEncoding.Encode(buffer, id[:])
+
+	return buffer, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Base64.
+// The data can be either standard base64 or 0x-prefixed hex, and is decoded
+// with the UnmarshalText method of the identifier type.
+func (wrapped *Base64[T]) UnmarshalText(data []byte) error {
+	var decoded T
+
+	//nolint:forcetypeassert // all identifier types implement encoding.TextUnmarshaler
+	if err := any(&decoded).(encoding.TextUnmarshaler).UnmarshalText(data); err != nil {
+		return err
+	}
+
+	wrapped.ID = decoded
+
+	return nil
+}
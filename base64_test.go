@@ -0,0 +1,61 @@
+package identifiers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64(t *testing.T) {
+	id := RandomAssetIDv0().AsIdentifier()
+
+	hexEncoded, err := json.Marshal(id)
+	require.NoError(t, err)
+	require.Len(t, hexEncoded, 66+2)
+
+	encoded, err := json.Marshal(Base64[Identifier]{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, `"`+base64.StdEncoding.EncodeToString(id[:])+`"`, string(encoded))
+
+	// Unmarshaling auto-detects both encodings
+	for _, data := range [][]byte{encoded, hexEncoded} {
+		var decoded Identifier
+
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, id, decoded)
+
+		var wrapped Base64[Identifier]
+
+		require.NoError(t, json.Unmarshal(data, &wrapped))
+		require.Equal(t, id, wrapped.ID)
+	}
+
+	// Wrapped identifiers are decoded with the UnmarshalText method of their type
+	var assets []Base64[AssetID]
+
+	require.NoError(t, json.Unmarshal([]byte(`[`+string(encoded)+`]`), &assets))
+	require.Equal(t, []Base64[AssetID]{{ID: AssetID(id)}}, assets)
+
+	encodedAssets, err := json.Marshal(assets)
+	require.NoError(t, err)
+	require.Equal(t, `[`+string(encoded)+`]`, string(encodedAssets))
+
+	// The encoding of identifiers that are not wrapped is unaffected
+	hexAsset, err := json.Marshal(NullAssetID{AssetID: AssetID(id), Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, hexEncoded, hexAsset)
+
+	t.Run("Invalid", func(t *testing.T) {
+		var decoded Base64[Identifier]
+
+		err := decoded.UnmarshalText([]byte(strings.Repeat("!", 44)))
+		require.ErrorContains(t, err, "illegal base64 data")
+
+		err = decoded.UnmarshalText([]byte(strings.Repeat("A", 42) + "=="))
+		require.ErrorIs(t, err, ErrInvalidLength)
+		require.Equal(t, Base64[Identifier]{}, decoded)
+	})
+}
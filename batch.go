@@ -0,0 +1,105 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// decodeHexBatch decodes the given hex strings (0x prefix is optional) into 32-byte values,
+// which are converted with the given function. A single buffer is reused for decoding all inputs.
+// Returns the converted values and their errors at the same positions as the inputs,
+// with a nil error slice if all inputs were decoded successfully.
+func decodeHexBatch[T ~[32]byte](inputs []string, convert func([32]byte) (T, error)) ([]T, []error) {
+	var (
+		buffer  []byte
+		decoded = make([]T, len(inputs))
+		errs    []error
+	)
+
+	// fail records the error for the input at the given position
+	fail := func(position int, err error) {
+		if errs == nil {
+			errs = make([]error, len(inputs))
+		}
+
+		errs[position] = err
+	}
+
+	for position, input := range inputs {
+		input = strings.TrimPrefix(input, prefix0xString)
+		if len(input) != 32*2 {
+			fail(position, ErrInvalidLength)
+			continue
+		}
+
+		var data [32]byte
+
+		buffer = append(buffer[:0], input...)
+		if _, err := hex.Decode(data[:], buffer); err != nil {
+			fail(position, err)
+			continue
+		}
+
+		converted, err := convert(data)
+		if err != nil {
+			fail(position, err)
+			continue
+		}
+
+		decoded[position] = converted
+	}
+
+	return decoded, errs
+}
+
+// DecodeHexBatch decodes the given hex strings (0x prefix is optional) into identifiers, which
+// must be valid (see Identifier.Validate). It is intended for bulk-loading large numbers of
+// identifiers, reusing its decoding buffer and validating each distinct tag and flags only once if
+// the validity of the identifier does not depend on its metadata (see FlagRule and MetadataValidator).
+//
+// Returns the identifiers and errors at the same positions as the inputs, with Nil identifiers
+// for inputs that failed. The error slice is nil if all inputs were decoded successfully.
+func DecodeHexBatch(inputs []string) ([]Identifier, []error) {
+	// validity memoizes the validation result for each combination of tag and flags
+	validity := make(map[[2]byte]error)
+	current := registry.Load()
+
+	return decodeHexBatch(inputs, func(data [32]byte) (Identifier, error) {
+		id := Identifier(data)
+
+		err, ok := validity[[2]byte{id[0], id[1]}]
+		if !ok {
+			err = id.Validate()
+
+			// The result can only be memoized if it depends on the tag and flags alone, which is not
+			// the case if the kind has flag rules or metadata validators or for multisig participants
+			if !current.checksMetadata(id) {
+				validity[[2]byte{id[0], id[1]}] = err
+			}
+		}
+
+		if err != nil {
+			return Nil, err
+		}
+
+		return id, nil
+	})
+}
+
+// DecodeParticipantIDHexBatch decodes the given hex strings into ParticipantIDs, like DecodeHexBatch.
+// Each ParticipantID must be valid, see ParticipantID.Validate
+func DecodeParticipantIDHexBatch(inputs []string) ([]ParticipantID, []error) {
+	return decodeHexBatch(inputs, NewParticipantID)
+}
+
+// DecodeAssetIDHexBatch decodes the given hex strings into AssetIDs, like DecodeHexBatch.
+// Each AssetID must be valid, see AssetID.Validate
+func DecodeAssetIDHexBatch(inputs []string) ([]AssetID, []error) {
+	return decodeHexBatch(inputs, NewAssetID)
+}
+
+// DecodeLogicIDHexBatch decodes the given hex strings into LogicIDs, like DecodeHexBatch.
+// Each LogicID must be valid, see LogicID.Validate
+func DecodeLogicIDHexBatch(inputs []string) ([]LogicID, []error) {
+	return decodeHexBatch(inputs, NewLogicID)
+}
@@ -0,0 +1,129 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeHexBatch(t *testing.T) {
+	asset := RandomAssetIDv0()
+	logic := RandomLogicIDv0()
+
+	invalid := asset.AsIdentifier()
+	invalid[0] = 0xF0
+
+	t.Run("Valid", func(t *testing.T) {
+		decoded, errs := DecodeHexBatch([]string{asset.Hex(), logic.Hex()[2:], asset.Hex()})
+		require.Nil(t, errs)
+		require.Equal(t, []Identifier{asset.AsIdentifier(), logic.AsIdentifier(), asset.AsIdentifier()}, decoded)
+
+		decoded, errs = DecodeHexBatch(nil)
+		require.Nil(t, errs)
+		require.Empty(t, decoded)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		decoded, errs := DecodeHexBatch([]string{
+			asset.Hex(), "0x1234", "0x" + string(make([]byte, 64)), invalid.Hex(), invalid.Hex(), logic.Hex(),
+		})
+
+		require.Len(t, errs, 6)
+		require.NoError(t, errs[0])
+		require.ErrorIs(t, errs[1], ErrInvalidLength)
+		require.ErrorContains(t, errs[2], "invalid byte")
+		require.ErrorIs(t, errs[3], ErrUnsupportedKind)
+		require.ErrorIs(t, errs[4], ErrUnsupportedKind)
+		require.NoError(t, errs[5])
+
+		require.Equal(t, []Identifier{asset.AsIdentifier(), Nil, Nil, Nil, Nil, logic.AsIdentifier()}, decoded)
+	})
+
+	t.Run("Typed", func(t *testing.T) {
+		participant := RandomParticipantIDv0()
+
+		participants, errs := DecodeParticipantIDHexBatch([]string{participant.Hex(), asset.Hex()})
+		require.Equal(t, []ParticipantID{participant, Nil}, participants)
+		require.NoError(t, errs[0])
+		require.Error(t, errs[1])
+
+		assets, errs := DecodeAssetIDHexBatch([]string{asset.Hex(), logic.Hex()})
+		require.Equal(t, []AssetID{asset, Nil}, assets)
+		require.NoError(t, errs[0])
+		require.Error(t, errs[1])
+
+		logics, errs := DecodeLogicIDHexBatch([]string{logic.Hex()})
+		require.Equal(t, []LogicID{logic}, logics)
+		require.Nil(t, errs)
+	})
+}
+
+func TestDecodeHexBatch_FlagRules(t *testing.T) {
+	require.NoError(t, RegisterFlagRule(KindAsset, FlagRule{
+		Name: "logical-standard", Flag: AssetLogical,
+		Check: func(id Identifier) error {
+			if id.Metadata() == [2]byte{} {
+				return errors.New("asset-logical requires a non-zero standard")
+			}
+
+			return nil
+		},
+	}))
+	t.Cleanup(func() { unregisterFlagRules(t, KindAsset) })
+
+	valid := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1, AssetLogical))
+
+	// The invalid asset has the same tag and flags as the valid asset
+	invalid := valid.AsIdentifier()
+	invalid[2], invalid[3] = 0, 0
+
+	decoded, errs := DecodeHexBatch([]string{valid.Hex(), invalid.Hex(), valid.Hex()})
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], ErrFlagRule)
+	require.NoError(t, errs[2])
+	require.Equal(t, []Identifier{valid.AsIdentifier(), Nil, valid.AsIdentifier()}, decoded)
+}
+
+func TestDecodeHexBatch_MetadataValidators(t *testing.T) {
+	errTooBig := errors.New("standard too big")
+
+	require.NoError(t, RegisterMetadataValidator(KindAsset, func(id Identifier) error {
+		if AssetID(id).Standard() > 100 {
+			return errTooBig
+		}
+
+		return nil
+	}))
+	t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+	// Both assets have the same tag and flags, but only the first has a valid standard
+	valid := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1))
+	invalid := valid.AsIdentifier()
+	invalid[3] = 200
+
+	require.ErrorIs(t, invalid.Validate(), errTooBig)
+
+	decoded, errs := DecodeHexBatch([]string{valid.Hex(), invalid.Hex()})
+	require.Len(t, errs, 2)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], ErrBadMetadata)
+	require.ErrorIs(t, errs[1], errTooBig)
+	require.Equal(t, []Identifier{valid.AsIdentifier(), Nil}, decoded)
+}
+
+func TestDecodeHexBatch_Multisig(t *testing.T) {
+	members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0()}
+	multisig := must(GenerateMultisigParticipantID(members, 2, 0))
+
+	// The invalid participant has the same tag and flags as the multisig participant
+	invalid := multisig.AsIdentifier()
+	invalid[2], invalid[3] = 0, 0
+
+	decoded, errs := DecodeHexBatch([]string{multisig.Hex(), invalid.Hex()})
+	require.Len(t, errs, 2)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], errInvalidMultisigMetadata)
+	require.Equal(t, []Identifier{multisig.AsIdentifier(), Nil}, decoded)
+}
@@ -0,0 +1,254 @@
+package identifiers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Identifiers can be encoded as bech32m strings (BIP-350) with a human-readable prefix (HRP),
+// such as moiasset1... for an AssetID. Bech32m strings are checksummed and case-insensitive,
+// which makes them resistant to typos when identifiers are copied or entered manually.
+//
+// The generic HRP "moi" can be used for identifiers of any kind, while the kind-specific HRPs
+// returned by Bech32HRP (the generic HRP followed by the kind name) can only be used for
+// identifiers of that kind, which is verified by ParseBech32.
+
+const (
+	// bech32Charset is the character set for the data part of bech32 strings
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	// bech32mConst is the constant that the bech32m checksum is XOR-ed with
+	bech32mConst = 0x2bc830a3
+	// bech32MaxLength is the maximum length of a bech32 string
+	bech32MaxLength = 90
+
+	// Bech32GenericHRP is the human-readable prefix for bech32m identifiers of any kind
+	Bech32GenericHRP = "moi"
+)
+
+// Bech32HRP returns the kind-specific human-readable prefix for bech32m identifiers of the given kind.
+// It is the generic HRP followed by the name of the kind, such as "moiasset" for KindAsset.
+func Bech32HRP(kind IdentifierKind) string {
+	return Bech32GenericHRP + strings.ToLower(kind.String())
+}
+
+// Bech32 returns the Identifier as a bech32m string with the given human-readable prefix.
+// Use Bech32HRP to obtain the kind-specific prefix or Bech32GenericHRP for the generic prefix.
+// Returns an error if the prefix is empty, too long or contains invalid characters.
+func (id Identifier) Bech32(hrp string) (string, error) {
+	if err := validateHRP(hrp); err != nil {
+		return "", err
+	}
+
+	data := convertBits(id[:], 8, 5)
+	checksum := bech32mChecksum(hrp, data)
+
+	var builder strings.Builder
+
+	builder.Grow(len(hrp) + 1 + len(data) + len(checksum))
+	builder.WriteString(hrp)
+	builder.WriteByte('1')
+
+	for _, value := range append(data, checksum...) {
+		builder.WriteByte(bech32Charset[value])
+	}
+
+	return builder.String(), nil
+}
+
+// ParseBech32 decodes the given bech32m string into an Identifier and verifies its checksum.
+// If the human-readable prefix is a kind-specific prefix, the kind of the decoded identifier
+// must match it. Identifiers with any other prefix (such as the generic prefix) are accepted
+// regardless of their kind. The decoded identifier is validated with Identifier.Validate.
+func ParseBech32(s string) (Identifier, error) {
+	hrp, data, err := decodeBech32m(s)
+	if err != nil {
+		return Nil, err
+	}
+
+	decoded, ok := revertBits(data)
+	if !ok || len(decoded) != 32 {
+		return Nil, fmt.Errorf("invalid bech32: %w", ErrInvalidLength)
+	}
+
+	id := Identifier(decoded)
+	if err = id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	// Check that a kind-specific prefix matches the kind of the identifier
+	if name, ok := strings.CutPrefix(hrp, Bech32GenericHRP); ok && name != "" {
+		if kind, err := ParseIdentifierKind(name); err == nil && kind != id.Tag().Kind() {
+			return Nil, fmt.Errorf("invalid bech32: prefix %q does not match %v identifier", hrp, id.Tag().Kind())
+		}
+	}
+
+	return id, nil
+}
+
+// validateHRP checks that the given human-readable prefix can be used for a bech32m identifier.
+// Prefixes must consist of 1 to 31 lowercase ASCII characters (excluding the separator '1')
+// so that the encoded identifier fits within the maximum length of a bech32 string.
+func validateHRP(hrp string) error {
+	if len(hrp) == 0 || len(hrp) > bech32MaxLength-1-52-6 {
+		return errors.New("invalid bech32 prefix: must be between 1 and 31 characters")
+	}
+
+	for _, char := range []byte(hrp) {
+		if char < 33 || char > 126 || (char >= 'A' && char <= 'Z') {
+			return fmt.Errorf("invalid bech32 prefix: invalid character %q", char)
+		}
+	}
+
+	return nil
+}
+
+// decodeBech32m decodes the given bech32m string into its human-readable
+// prefix and 5-bit data values (excluding the checksum) and verifies its checksum.
+func decodeBech32m(s string) (string, []byte, error) {
+	if len(s) > bech32MaxLength {
+		return "", nil, fmt.Errorf("invalid bech32: %w", ErrInvalidLength)
+	}
+
+	// Mixed case strings are not allowed
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, errors.New("invalid bech32: mixed case")
+	}
+
+	// The separator is the last '1' in the string, with at least 6 checksum characters after it
+	separator := strings.LastIndexByte(lower, '1')
+	if separator < 1 || separator+7 > len(lower) {
+		return "", nil, errors.New("invalid bech32: missing separator or checksum")
+	}
+
+	hrp := lower[:separator]
+	for _, char := range []byte(hrp) {
+		if char < 33 || char > 126 {
+			return "", nil, fmt.Errorf("invalid bech32: invalid character %q", char)
+		}
+	}
+
+	data := make([]byte, 0, len(lower)-separator-1)
+
+	for _, char := range []byte(lower[separator+1:]) {
+		value := strings.IndexByte(bech32Charset, char)
+		if value < 0 {
+			return "", nil, fmt.Errorf("invalid bech32: invalid character %q", char)
+		}
+
+		data = append(data, byte(value))
+	}
+
+	if bech32Polymod(append(bech32ExpandHRP(hrp), data...)) != bech32mConst {
+		return "", nil, fmt.Errorf("invalid bech32: %w", ErrInvalidChecksum)
+	}
+
+	return hrp, data[:len(data)-6], nil
+}
+
+// bech32Polymod computes the BCH checksum polynomial over the given 5-bit values
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := uint32(1)
+
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+
+	return checksum
+}
+
+// bech32ExpandHRP expands the human-readable prefix into 5-bit values for checksum computation
+func bech32ExpandHRP(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+
+	for _, char := range []byte(hrp) {
+		expanded = append(expanded, char>>5)
+	}
+
+	expanded = append(expanded, 0)
+
+	for _, char := range []byte(hrp) {
+		expanded = append(expanded, char&31)
+	}
+
+	return expanded
+}
+
+// bech32mChecksum computes the 6 checksum values of the bech32m string with the given prefix and data
+func bech32mChecksum(hrp string, data []byte) []byte {
+	values := append(bech32ExpandHRP(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+
+	polymod := bech32Polymod(values) ^ bech32mConst
+	checksum := make([]byte, 6)
+
+	for i := range checksum {
+		checksum[i] = byte((polymod >> (5 * (5 - i))) & 31)
+	}
+
+	return checksum
+}
+
+// convertBits regroups the given values of the given bit width into values of another bit width.
+// Any remaining bits are padded with zeroes into a final value.
+func convertBits(data []byte, from, to uint) []byte {
+	var (
+		accumulator uint
+		bits        uint
+	)
+
+	converted := make([]byte, 0, (len(data)*int(from)+int(to)-1)/int(to))
+	maximum := uint(1)<<to - 1
+
+	for _, value := range data {
+		accumulator = accumulator<<from | uint(value)
+		bits += from
+
+		for bits >= to {
+			bits -= to
+			converted = append(converted, byte((accumulator>>bits)&maximum))
+		}
+	}
+
+	if bits > 0 {
+		converted = append(converted, byte((accumulator<<(to-bits))&maximum))
+	}
+
+	return converted
+}
+
+// revertBits regroups the given 5-bit values into bytes. Returns false if the padding is invalid,
+// which is the case if there are more than 4 bits of padding or if any of the padding bits are set.
+func revertBits(data []byte) ([]byte, bool) {
+	var (
+		accumulator uint
+		bits        uint
+	)
+
+	reverted := make([]byte, 0, len(data)*5/8)
+
+	for _, value := range data {
+		accumulator = accumulator<<5 | uint(value)
+		bits += 5
+
+		if bits >= 8 {
+			bits -= 8
+			reverted = append(reverted, byte(accumulator>>bits))
+		}
+	}
+
+	if bits >= 5 || accumulator&(1<<bits-1) != 0 {
+		return nil, false
+	}
+
+	return reverted, true
+}
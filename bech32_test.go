@@ -0,0 +1,141 @@
+package identifiers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeBech32m encodes the given 5-bit values into a bech32m string with the given prefix
+func encodeBech32m(hrp string, data []byte) string {
+	encoded := hrp + "1"
+	for _, value := range append(data, bech32mChecksum(hrp, data)...) {
+		encoded += string(bech32Charset[value])
+	}
+
+	return encoded
+}
+
+func TestBech32HRP(t *testing.T) {
+	assert.Equal(t, "moiasset", Bech32HRP(KindAsset))
+	assert.Equal(t, "moilogic", Bech32HRP(KindLogic))
+	assert.Equal(t, "moiparticipant", Bech32HRP(KindParticipant))
+}
+
+func TestIdentifier_Bech32(t *testing.T) {
+	asset := RandomAssetIDv0().AsIdentifier()
+	logic := RandomLogicIDv0().AsIdentifier()
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, hrp := range []string{Bech32GenericHRP, Bech32HRP(KindAsset), "custom"} {
+			encoded, err := asset.Bech32(hrp)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(encoded, hrp+"1"))
+			require.Len(t, encoded, len(hrp)+1+52+6)
+
+			decoded, err := ParseBech32(encoded)
+			require.NoError(t, err)
+			require.Equal(t, asset, decoded)
+
+			// Uppercase strings are also accepted
+			decoded, err = ParseBech32(strings.ToUpper(encoded))
+			require.NoError(t, err)
+			require.Equal(t, asset, decoded)
+		}
+	})
+
+	t.Run("InvalidHRP", func(t *testing.T) {
+		_, err := asset.Bech32("")
+		require.EqualError(t, err, "invalid bech32 prefix: must be between 1 and 31 characters")
+
+		_, err = asset.Bech32(strings.Repeat("m", 32))
+		require.EqualError(t, err, "invalid bech32 prefix: must be between 1 and 31 characters")
+
+		_, err = asset.Bech32("Moi")
+		require.EqualError(t, err, "invalid bech32 prefix: invalid character 'M'")
+
+		_, err = asset.Bech32("moi asset")
+		require.EqualError(t, err, "invalid bech32 prefix: invalid character ' '")
+	})
+
+	t.Run("KindMismatch", func(t *testing.T) {
+		encoded, err := logic.Bech32(Bech32HRP(KindAsset))
+		require.NoError(t, err)
+
+		_, err = ParseBech32(encoded)
+		require.EqualError(t, err, "invalid bech32: prefix \"moiasset\" does not match logic identifier")
+
+		// Prefixes without a known kind name are accepted for any kind
+		encoded, err = logic.Bech32("moiunknown")
+		require.NoError(t, err)
+
+		decoded, err := ParseBech32(encoded)
+		require.NoError(t, err)
+		require.Equal(t, logic, decoded)
+	})
+}
+
+func TestParseBech32(t *testing.T) {
+	asset := RandomAssetIDv0().AsIdentifier()
+	encoded, _ := asset.Bech32(Bech32GenericHRP)
+
+	invalidTag := asset
+	invalidTag[0] = 0xF0
+
+	// Replace the last checksum character with one that is guaranteed to be different
+	badChecksum := encoded[:len(encoded)-1] + "q"
+	if encoded[len(encoded)-1] == 'q' {
+		badChecksum = encoded[:len(encoded)-1] + "p"
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"TooLong", "moi1" + strings.Repeat("q", 90), "invalid bech32: invalid length"},
+		{"MixedCase", "Moi" + encoded[3:], "invalid bech32: mixed case"},
+		{"MissingSeparator", "moiqqqqqqqq", "invalid bech32: missing separator or checksum"},
+		{"EmptyHRP", "1" + encoded[4:], "invalid bech32: missing separator or checksum"},
+		{"ShortChecksum", "moi1qqqqq", "invalid bech32: missing separator or checksum"},
+		{"InvalidHRPChar", "mo\x7f" + encoded[3:], "invalid bech32: invalid character '\\x7f'"},
+		{"InvalidDataChar", encoded[:10] + "b" + encoded[11:], "invalid bech32: invalid character 'b'"},
+		{"BadChecksum", badChecksum, "invalid bech32: invalid checksum"},
+		{"ShortData", encodeBech32m("moi", make([]byte, 8)), "invalid bech32: invalid length"},
+		{"ExcessPadding", encodeBech32m("moi", make([]byte, 51)), "invalid bech32: invalid length"},
+		{"NonZeroPadding", encodeBech32m("moi", append(make([]byte, 51), 1)), "invalid bech32: invalid length"},
+		{"InvalidIdentifier", must(invalidTag.Bech32("moi")), "invalid tag: unsupported tag kind"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseBech32(test.input)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestBech32m_Vectors(t *testing.T) {
+	// Valid bech32m test vectors from BIP-350
+	valid := []string{
+		"A1LQFN3A",
+		"a1lqfn3a",
+		"an83characterlonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11sg7hg6",
+		"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx",
+		"11llllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllludsr8",
+		"split1checkupstagehandshakeupstreamerranterredcaperredlc445v",
+		"?1v759aa",
+	}
+
+	for _, vector := range valid {
+		hrp, data, err := decodeBech32m(vector)
+		require.NoError(t, err, vector)
+		require.Equal(t, strings.ToLower(vector), encodeBech32m(hrp, data))
+	}
+
+	// Valid bech32 (not bech32m) strings from BIP-173 must be rejected
+	_, _, err := decodeBech32m("A12UEL5L")
+	require.ErrorIs(t, err, ErrInvalidChecksum)
+}
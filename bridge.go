@@ -0,0 +1,120 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ForeignAsset is an asset on a foreign chain that is bridged into the MOI Protocol.
+//
+// The Chain is a 16-bit chain number that is assigned by the bridge to each foreign chain.
+// It is not the native chain ID of the foreign chain, because it must fit into the metadata
+// of the bridged AssetID. The Contract is the address of the asset contract on the foreign
+// chain in its native textual form, and is used exactly as given (without normalization).
+type ForeignAsset struct {
+	Chain    uint16
+	Contract string
+}
+
+// String returns the ForeignAsset in the form <chain>:<contract>
+func (foreign ForeignAsset) String() string {
+	return fmt.Sprintf("%d:%v", foreign.Chain, foreign.Contract)
+}
+
+// BridgedAssetID returns the AssetID for the given ForeignAsset.
+// The AssetID is derived deterministically as a v0 AssetID with:
+//   - Fingerprint: The SHA-256 hash of the `moi.bridge` domain, followed by the
+//     chain number (big-endian) and the UTF-8 encoded contract address.
+//   - Metadata: The chain number, in place of the standard of the asset.
+//   - Variant & Flags: Always zero.
+//
+// Returns an error if the contract address of the ForeignAsset is empty, or if the AssetID is rejected
+// by the flag rules or metadata validators that are registered for KindAsset.
+func BridgedAssetID(foreign ForeignAsset) (AssetID, error) {
+	if foreign.Contract == "" {
+		return Nil, errors.New("invalid foreign asset: empty contract address")
+	}
+
+	chain := binary.BigEndian.AppendUint16(nil, foreign.Chain)
+	fingerprint := hashFingerprint("moi.bridge", chain, []byte(foreign.Contract))
+
+	return GenerateAssetIDv0(fingerprint, 0, foreign.Chain)
+}
+
+// BridgeRegistry maps foreign assets to their bridged AssetID and back.
+// Mappings are derived with BridgedAssetID, and the registry detects collisions where two
+// different foreign assets would map to the same AssetID. A BridgeRegistry is safe for
+// concurrent use, and must be created with NewBridgeRegistry.
+type BridgeRegistry struct {
+	mutex sync.RWMutex
+
+	forward map[ForeignAsset]AssetID
+	reverse map[AssetID]ForeignAsset
+}
+
+// NewBridgeRegistry creates a new empty BridgeRegistry
+func NewBridgeRegistry() *BridgeRegistry {
+	return &BridgeRegistry{
+		forward: make(map[ForeignAsset]AssetID),
+		reverse: make(map[AssetID]ForeignAsset),
+	}
+}
+
+// Register adds the given ForeignAsset to the registry and returns its bridged AssetID.
+// Registering an already registered ForeignAsset returns its existing AssetID.
+//
+// Returns ErrBridgeCollision if the AssetID is already mapped to a different foreign asset,
+// or an error if the AssetID could not be derived for the ForeignAsset (see BridgedAssetID),
+// in which case no mapping is recorded.
+func (registry *BridgeRegistry) Register(foreign ForeignAsset) (AssetID, error) {
+	asset, err := BridgedAssetID(foreign)
+	if err != nil {
+		return Nil, err
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if existing, ok := registry.reverse[asset]; ok {
+		if existing != foreign {
+			return Nil, fmt.Errorf("%w: %v and %v map to %v", ErrBridgeCollision, existing, foreign, asset)
+		}
+
+		return asset, nil
+	}
+
+	registry.forward[foreign] = asset
+	registry.reverse[asset] = foreign
+
+	return asset, nil
+}
+
+// Lookup returns the bridged AssetID for the given ForeignAsset, if it is registered.
+func (registry *BridgeRegistry) Lookup(foreign ForeignAsset) (AssetID, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	asset, ok := registry.forward[foreign]
+
+	return asset, ok
+}
+
+// ReverseLookup returns the ForeignAsset for the given bridged AssetID, if it is registered.
+func (registry *BridgeRegistry) ReverseLookup(asset AssetID) (ForeignAsset, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	foreign, ok := registry.reverse[asset]
+
+	return foreign, ok
+}
+
+// Len returns the number of foreign assets in the registry
+func (registry *BridgeRegistry) Len() int {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	return len(registry.forward)
+}
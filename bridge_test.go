@@ -0,0 +1,148 @@
+package identifiers
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgedAssetID(t *testing.T) {
+	foreign := ForeignAsset{Chain: 1, Contract: "0xdAC17F958D2ee523a2206206994597C13D831ec7"}
+	assert.Equal(t, "1:0xdAC17F958D2ee523a2206206994597C13D831ec7", foreign.String())
+
+	asset, err := BridgedAssetID(foreign)
+	require.NoError(t, err)
+	require.NoError(t, asset.Validate())
+
+	assert.Equal(t, TagAssetV0, asset.Tag())
+	assert.Equal(t, uint16(1), asset.Standard())
+	assert.Equal(t, uint32(0), asset.Variant())
+	assert.Equal(t, byte(0), asset.Flags())
+
+	// Derivation is deterministic
+	assert.Equal(t, asset, must(BridgedAssetID(foreign)))
+
+	// Different chains and contracts produce different identifiers
+	other := ForeignAsset{Chain: 2, Contract: foreign.Contract}
+	assert.NotEqual(t, asset.Fingerprint(), must(BridgedAssetID(other)).Fingerprint())
+
+	other = ForeignAsset{Chain: 1, Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7"}
+	assert.NotEqual(t, asset, must(BridgedAssetID(other)))
+
+	_, err = BridgedAssetID(ForeignAsset{Chain: 1})
+	require.EqualError(t, err, "invalid foreign asset: empty contract address")
+
+	t.Run("MetadataValidators", func(t *testing.T) {
+		errStandard := errors.New("standards are not allowed")
+
+		require.NoError(t, RegisterMetadataValidator(KindAsset, func(id Identifier) error {
+			if AssetID(id).Standard() != 0 {
+				return errStandard
+			}
+
+			return nil
+		}))
+		t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+		// Test that the error of the generator is returned instead of Nil
+		asset, err := BridgedAssetID(ForeignAsset{Chain: 7, Contract: foreign.Contract})
+		require.ErrorIs(t, err, errStandard)
+		require.Equal(t, AssetID(Nil), asset)
+	})
+}
+
+func TestBridgeRegistry(t *testing.T) {
+	registry := NewBridgeRegistry()
+
+	usdt := ForeignAsset{Chain: 1, Contract: "0xdAC17F958D2ee523a2206206994597C13D831ec7"}
+	usdc := ForeignAsset{Chain: 1, Contract: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"}
+
+	t.Run("Register", func(t *testing.T) {
+		asset, err := registry.Register(usdt)
+		require.NoError(t, err)
+		assert.Equal(t, must(BridgedAssetID(usdt)), asset)
+
+		// Registering again is idempotent
+		again, err := registry.Register(usdt)
+		require.NoError(t, err)
+		assert.Equal(t, asset, again)
+		assert.Equal(t, 1, registry.Len())
+
+		_, err = registry.Register(ForeignAsset{Chain: 1})
+		require.EqualError(t, err, "invalid foreign asset: empty contract address")
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		asset, ok := registry.Lookup(usdt)
+		require.True(t, ok)
+
+		foreign, ok := registry.ReverseLookup(asset)
+		require.True(t, ok)
+		assert.Equal(t, usdt, foreign)
+
+		_, ok = registry.Lookup(usdc)
+		assert.False(t, ok)
+
+		_, ok = registry.ReverseLookup(RandomAssetIDv0())
+		assert.False(t, ok)
+	})
+
+	t.Run("Collision", func(t *testing.T) {
+		asset := must(BridgedAssetID(usdc))
+
+		// Simulate a collision by mapping the AssetID to another foreign asset
+		registry.mutex.Lock()
+		registry.reverse[asset] = ForeignAsset{Chain: 9, Contract: "colliding"}
+		registry.mutex.Unlock()
+
+		_, err := registry.Register(usdc)
+		require.ErrorIs(t, err, ErrBridgeCollision)
+		require.EqualError(t, err,
+			"bridge collision: 9:colliding and 1:0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48 map to "+asset.Hex(),
+		)
+
+		_, ok := registry.Lookup(usdc)
+		assert.False(t, ok)
+	})
+
+	t.Run("MetadataValidators", func(t *testing.T) {
+		errRejected := errors.New("rejected")
+
+		require.NoError(t, RegisterMetadataValidator(KindAsset, func(Identifier) error { return errRejected }))
+		t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+		// Test that no mapping is recorded if the AssetID cannot be derived
+		registry := NewBridgeRegistry()
+
+		asset, err := registry.Register(usdc)
+		require.ErrorIs(t, err, errRejected)
+		require.Equal(t, AssetID(Nil), asset)
+		require.Zero(t, registry.Len())
+
+		_, ok := registry.ReverseLookup(AssetID(Nil))
+		assert.False(t, ok)
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		registry := NewBridgeRegistry()
+
+		var wg sync.WaitGroup
+
+		for chain := uint16(0); chain < 16; chain++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := registry.Register(ForeignAsset{Chain: chain, Contract: "0x01"})
+				assert.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, 16, registry.Len())
+	})
+}
@@ -0,0 +1,150 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The identifiers in this package are persisted in BSON as 32-byte binary values (BinData) with
+// the generic binary subtype. The BSON methods follow the signatures of the bson.ValueMarshaler and
+// bson.ValueUnmarshaler interfaces of the MongoDB Go Driver (v2), which represent the BSON type
+// with a plain byte. This allows the identifiers to be used with the driver without this package
+// depending on it. Decoded values are validated like with UnmarshalBinary. The zero value of
+// a type (such as an unset field) is encoded as a BSON null, which decodes into the zero value.
+
+const (
+	// bsonTypeBinary is the BSON type for binary data
+	bsonTypeBinary byte = 0x05
+	// bsonTypeNull is the BSON type for a null value
+	bsonTypeNull byte = 0x0A
+	// bsonSubtypeGeneric is the generic BSON binary subtype
+	bsonSubtypeGeneric byte = 0x00
+)
+
+// marshalBSON encodes the given 32 bytes into a BSON binary value with the generic subtype.
+// The value is laid out as [length:4 (little-endian)][subtype:1][data:32]. The 32 zero bytes
+// of Nil are encoded as a BSON null instead, so that the zero value of all types round-trips.
+func marshalBSON(data [32]byte) (byte, []byte, error) {
+	if data == Nil {
+		return bsonTypeNull, nil, nil
+	}
+
+	buffer := make([]byte, 0, 4+1+32)
+	buffer = binary.LittleEndian.AppendUint32(buffer, 32)
+	buffer = append(buffer, bsonSubtypeGeneric)
+	buffer = append(buffer, data[:]...)
+
+	return bsonTypeBinary, buffer, nil
+}
+
+// unmarshalBSON decodes the given BSON value into its binary data, which is passed to the given
+// decode function. The decode function is called with nil data if the BSON value is null.
+func unmarshalBSON(typ byte, data []byte, decode func([]byte) error) error {
+	switch typ {
+	case bsonTypeNull:
+		return decode(nil)
+	case bsonTypeBinary:
+	default:
+		return fmt.Errorf("unsupported bson type 0x%02x for identifier", typ)
+	}
+
+	// Check that the value has the length prefix and subtype
+	if len(data) < 5 {
+		return fmt.Errorf("invalid bson binary: %w", ErrInvalidLength)
+	}
+
+	if length := binary.LittleEndian.Uint32(data[:4]); int(length) != len(data)-5 {
+		return fmt.Errorf("invalid bson binary: %w", ErrInvalidLength)
+	}
+
+	if subtype := data[4]; subtype != bsonSubtypeGeneric {
+		return fmt.Errorf("unsupported bson binary subtype 0x%02x for identifier", subtype)
+	}
+
+	return decode(data[5:])
+}
+
+// MarshalBSONValue encodes the Identifier as a BSON binary value
+func (id Identifier) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSON(id)
+}
+
+// UnmarshalBSONValue decodes a BSON binary value into the Identifier, which must be valid
+func (id *Identifier) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(typ, data, func(raw []byte) error {
+		if raw == nil {
+			*id = Nil
+			return nil
+		}
+
+		return id.UnmarshalBinary(raw)
+	})
+}
+
+// MarshalBSONValue encodes the ParticipantID as a BSON binary value
+func (participant ParticipantID) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSON(participant)
+}
+
+// UnmarshalBSONValue decodes a BSON binary value into the ParticipantID, which must be valid
+func (participant *ParticipantID) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(typ, data, func(raw []byte) error {
+		if raw == nil {
+			*participant = Nil
+			return nil
+		}
+
+		return participant.UnmarshalBinary(raw)
+	})
+}
+
+// MarshalBSONValue encodes the AssetID as a BSON binary value
+func (asset AssetID) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSON(asset)
+}
+
+// UnmarshalBSONValue decodes a BSON binary value into the AssetID, which must be valid
+func (asset *AssetID) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(typ, data, func(raw []byte) error {
+		if raw == nil {
+			*asset = Nil
+			return nil
+		}
+
+		return asset.UnmarshalBinary(raw)
+	})
+}
+
+// MarshalBSONValue encodes the LogicID as a BSON binary value
+func (logic LogicID) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSON(logic)
+}
+
+// UnmarshalBSONValue decodes a BSON binary value into the LogicID, which must be valid
+func (logic *LogicID) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(typ, data, func(raw []byte) error {
+		if raw == nil {
+			*logic = Nil
+			return nil
+		}
+
+		return logic.UnmarshalBinary(raw)
+	})
+}
+
+// MarshalBSONValue encodes the Address as a BSON binary value
+func (address Address) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSON(address)
+}
+
+// UnmarshalBSONValue decodes a BSON binary value into the Address
+func (address *Address) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(typ, data, func(raw []byte) error {
+		if raw == nil {
+			*address = Nil
+			return nil
+		}
+
+		return address.UnmarshalBinary(raw)
+	})
+}
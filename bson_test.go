@@ -0,0 +1,91 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bsonValue is the common interface of all identifiers with BSON support
+type bsonValue interface {
+	MarshalBSONValue() (byte, []byte, error)
+	UnmarshalBSONValue(byte, []byte) error
+}
+
+func TestBSON(t *testing.T) {
+	participant := RandomParticipantIDv0()
+	asset := RandomAssetIDv0()
+	logic := RandomLogicIDv0()
+	id := RandomTopicIDv0().AsIdentifier()
+	address := Address(RandomAssetIDv1())
+
+	tests := []struct {
+		name      string
+		value     bsonValue
+		empty     func() bsonValue
+		validated bool
+	}{
+		{"Identifier", &id, func() bsonValue { return new(Identifier) }, true},
+		{"ParticipantID", &participant, func() bsonValue { return new(ParticipantID) }, true},
+		{"AssetID", &asset, func() bsonValue { return new(AssetID) }, true},
+		{"LogicID", &logic, func() bsonValue { return new(LogicID) }, true},
+		{"Address", &address, func() bsonValue { return new(Address) }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, data, err := tt.value.MarshalBSONValue()
+			require.NoError(t, err)
+			require.Equal(t, byte(0x05), typ)
+			require.Len(t, data, 37)
+			require.Equal(t, []byte{32, 0, 0, 0, 0}, data[:5])
+
+			decoded := tt.empty()
+			require.NoError(t, decoded.UnmarshalBSONValue(typ, data))
+			assert.Equal(t, tt.value, decoded)
+
+			// Null values decode into the zero value
+			require.NoError(t, decoded.UnmarshalBSONValue(0x0A, nil))
+			assert.Equal(t, tt.empty(), decoded)
+
+			require.EqualError(t,
+				decoded.UnmarshalBSONValue(0x02, data),
+				"unsupported bson type 0x02 for identifier",
+			)
+			require.EqualError(t,
+				decoded.UnmarshalBSONValue(0x05, data[:3]),
+				"invalid bson binary: invalid length",
+			)
+			require.EqualError(t,
+				decoded.UnmarshalBSONValue(0x05, data[:36]),
+				"invalid bson binary: invalid length",
+			)
+			require.EqualError(t,
+				decoded.UnmarshalBSONValue(0x05, append([]byte{32, 0, 0, 0, 0x04}, data[5:]...)),
+				"unsupported bson binary subtype 0x04 for identifier",
+			)
+			require.ErrorContains(t,
+				decoded.UnmarshalBSONValue(0x05, []byte{2, 0, 0, 0, 0, 0xAA, 0xBB}),
+				"invalid length",
+			)
+
+			// Decoded values must be valid
+			if tt.validated {
+				invalid := append([]byte{32, 0, 0, 0, 0, 0xF0}, make([]byte, 31)...)
+				require.EqualError(t, decoded.UnmarshalBSONValue(0x05, invalid), "invalid tag: unsupported tag kind")
+			}
+
+			// The zero value (such as an unset field) is encoded as null and round-trips
+			require.NoError(t, decoded.UnmarshalBSONValue(0x05, data))
+
+			typ, data, err = tt.empty().MarshalBSONValue()
+			require.NoError(t, err)
+			require.Equal(t, byte(0x0A), typ)
+			require.Nil(t, data)
+
+			require.NoError(t, decoded.UnmarshalBSONValue(typ, data))
+			assert.Equal(t, tt.empty(), decoded)
+		})
+	}
+}
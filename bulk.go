@@ -0,0 +1,172 @@
+package identifiers
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// bulkChunkSize is the number of identifiers validated by a worker at a time in ValidateAll
+const bulkChunkSize = 1024
+
+// ValidationError is an error for an identifier at a specific position in a bulk validation
+type ValidationError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface for ValidationError
+func (err ValidationError) Error() string {
+	return fmt.Sprintf("identifier %d: %v", err.Index, err.Err)
+}
+
+// Unwrap returns the underlying error of the ValidationError
+func (err ValidationError) Unwrap() error { return err.Err }
+
+// ValidationReport is the result of a bulk validation with ValidateAll
+type ValidationReport struct {
+	// Total is the number of identifiers that were given for validation
+	Total int
+	// Validated is the number of identifiers that were validated before completion or abort
+	Validated int
+	// Errors are the errors of the invalid identifiers, in ascending order of their index
+	Errors []ValidationError
+	// Aborted is true if the validation stopped before all identifiers were validated,
+	// either because the context was canceled or the maximum number of errors was reached
+	Aborted bool
+	// Err is the error of the context if it was canceled during validation
+	Err error
+}
+
+// Valid returns whether all identifiers were validated without any errors
+func (report ValidationReport) Valid() bool {
+	return !report.Aborted && len(report.Errors) == 0
+}
+
+// validateConfig is the configuration for ValidateAll
+type validateConfig struct {
+	workers   int
+	maxErrors int
+	validator func(Identifier) error
+	progress  func(validated, total int)
+}
+
+// ValidateOption is an option for ValidateAll
+type ValidateOption func(*validateConfig)
+
+// WithWorkers sets the number of workers that validate identifiers concurrently.
+// Defaults to the number of CPUs (GOMAXPROCS), and values less than 1 are ignored.
+func WithWorkers(workers int) ValidateOption {
+	return func(config *validateConfig) {
+		if workers > 0 {
+			config.workers = workers
+		}
+	}
+}
+
+// WithMaxErrors aborts the validation once the given number of errors has been collected.
+// Since identifiers are validated concurrently, the collected errors are not necessarily the
+// first errors by index. Defaults to collecting all errors, and values less than 1 are ignored.
+func WithMaxErrors(maxErrors int) ValidateOption {
+	return func(config *validateConfig) {
+		if maxErrors > 0 {
+			config.maxErrors = maxErrors
+		}
+	}
+}
+
+// WithValidator sets the function used to validate each identifier.
+// Defaults to Identifier.Validate, which only performs checks that apply to all kinds.
+func WithValidator(validator func(Identifier) error) ValidateOption {
+	return func(config *validateConfig) { config.validator = validator }
+}
+
+// WithProgress sets a function that is called with the number of validated identifiers after each
+// chunk of identifiers is validated. Calls to the function are serialized, but can happen from any worker.
+func WithProgress(progress func(validated, total int)) ValidateOption {
+	return func(config *validateConfig) { config.progress = progress }
+}
+
+// ValidateAll validates the given identifiers concurrently with a pool of workers, and collects the
+// errors of all invalid identifiers with their index. The validation is aborted if the context is
+// canceled or if the maximum number of errors is reached (see WithMaxErrors).
+func ValidateAll(ctx context.Context, ids []Identifier, opts ...ValidateOption) ValidationReport {
+	config := validateConfig{workers: runtime.GOMAXPROCS(0), validator: Identifier.Validate}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	var (
+		mutex sync.Mutex
+		group sync.WaitGroup
+
+		report  = ValidationReport{Total: len(ids)}
+		next    atomic.Int64
+		aborted atomic.Bool
+	)
+
+	chunks := (len(ids) + bulkChunkSize - 1) / bulkChunkSize
+
+	for worker := 0; worker < min(config.workers, chunks); worker++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= chunks || aborted.Load() {
+					return
+				}
+
+				if ctx.Err() != nil {
+					aborted.Store(true)
+					return
+				}
+
+				start, end := chunk*bulkChunkSize, min((chunk+1)*bulkChunkSize, len(ids))
+
+				var errs []ValidationError
+
+				for index := start; index < end; index++ {
+					if err := config.validator(ids[index]); err != nil {
+						errs = append(errs, ValidationError{Index: index, Err: err})
+					}
+				}
+
+				mutex.Lock()
+
+				report.Validated += end - start
+				report.Errors = append(report.Errors, errs...)
+
+				if config.maxErrors > 0 && len(report.Errors) >= config.maxErrors {
+					aborted.Store(true)
+				}
+
+				if config.progress != nil {
+					config.progress(report.Validated, report.Total)
+				}
+
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	group.Wait()
+
+	slices.SortFunc(report.Errors, func(a, b ValidationError) int { return a.Index - b.Index })
+
+	if config.maxErrors > 0 && len(report.Errors) > config.maxErrors {
+		report.Errors = report.Errors[:config.maxErrors]
+	}
+
+	report.Aborted = aborted.Load() && report.Validated < report.Total
+	if report.Aborted {
+		report.Err = ctx.Err()
+	}
+
+	return report
+}
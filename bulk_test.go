@@ -0,0 +1,108 @@
+package identifiers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAll(t *testing.T) {
+	invalid := RandomAssetIDv0().AsIdentifier()
+	invalid[0] = 0xF0
+
+	// Create a slice of identifiers that spans multiple chunks with a few invalid identifiers
+	ids := make([]Identifier, 3*bulkChunkSize+10)
+	for index := range ids {
+		ids[index] = RandomLogicIDv0().AsIdentifier()
+	}
+
+	ids[5], ids[bulkChunkSize+1], ids[len(ids)-1] = invalid, invalid, invalid
+
+	t.Run("Valid", func(t *testing.T) {
+		valid := []Identifier{RandomParticipantIDv0().AsIdentifier(), RandomAssetIDv0().AsIdentifier()}
+
+		report := ValidateAll(context.Background(), valid)
+		require.True(t, report.Valid())
+		require.Equal(t, ValidationReport{Total: 2, Validated: 2}, report)
+
+		report = ValidateAll(context.Background(), nil)
+		require.True(t, report.Valid())
+		require.Equal(t, ValidationReport{}, report)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		report := ValidateAll(context.Background(), ids, WithWorkers(4), WithWorkers(0))
+		require.False(t, report.Valid())
+		require.False(t, report.Aborted)
+		require.NoError(t, report.Err)
+		require.Equal(t, len(ids), report.Total)
+		require.Equal(t, len(ids), report.Validated)
+
+		require.Len(t, report.Errors, 3)
+		require.Equal(t, []int{5, bulkChunkSize + 1, len(ids) - 1}, []int{
+			report.Errors[0].Index, report.Errors[1].Index, report.Errors[2].Index,
+		})
+
+		require.ErrorIs(t, report.Errors[0], ErrUnsupportedKind)
+		require.EqualError(t, report.Errors[0], "identifier 5: invalid tag: unsupported tag kind")
+	})
+
+	t.Run("MaxErrors", func(t *testing.T) {
+		report := ValidateAll(context.Background(), ids, WithWorkers(1), WithMaxErrors(1), WithMaxErrors(-1))
+		require.False(t, report.Valid())
+		require.True(t, report.Aborted)
+		require.NoError(t, report.Err)
+		require.Equal(t, bulkChunkSize, report.Validated)
+		require.Len(t, report.Errors, 1)
+		require.Equal(t, 5, report.Errors[0].Index)
+
+		// Errors beyond the maximum collected by a chunk are discarded
+		report = ValidateAll(context.Background(), []Identifier{invalid, invalid, invalid}, WithMaxErrors(2))
+		require.False(t, report.Aborted)
+		require.Len(t, report.Errors, 2)
+	})
+
+	t.Run("Validator", func(t *testing.T) {
+		report := ValidateAll(context.Background(), ids, WithValidator(func(id Identifier) error {
+			_, err := NewAssetID(id)
+			return err
+		}))
+
+		require.Len(t, report.Errors, len(ids))
+		require.ErrorContains(t, report.Errors[0], "invalid tag: not an asset id")
+	})
+
+	t.Run("Progress", func(t *testing.T) {
+		var progress []int
+
+		report := ValidateAll(context.Background(), ids, WithProgress(func(validated, total int) {
+			require.Equal(t, len(ids), total)
+			progress = append(progress, validated)
+		}))
+
+		require.Equal(t, []int{bulkChunkSize, 2 * bulkChunkSize, 3 * bulkChunkSize, len(ids)}, progress)
+		require.Equal(t, len(ids), report.Validated)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report := ValidateAll(ctx, ids)
+		require.False(t, report.Valid())
+		require.True(t, report.Aborted)
+		require.True(t, errors.Is(report.Err, context.Canceled))
+		require.Zero(t, report.Validated)
+
+		// Cancel the validation after the first chunk
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+
+		report = ValidateAll(ctx, ids, WithWorkers(1), WithProgress(func(int, int) { cancel() }))
+		require.True(t, report.Aborted)
+		require.ErrorIs(t, report.Err, context.Canceled)
+		require.Equal(t, bulkChunkSize, report.Validated)
+	})
+}
@@ -0,0 +1,266 @@
+package identifiers
+
+import (
+	"container/list"
+	"hash/maphash"
+	"sync"
+	"sync/atomic"
+)
+
+// ParseCache is a least-recently-used (LRU) cache of hex strings mapped to their parsed and validated
+// identifiers. It speeds up applications that repeatedly parse the same identifiers, such as RPC
+// gateways. Hex strings are cached with the 0x prefix trimmed, and only strings that decode into
+// 32 bytes that pass Identifier.Validate are cached.
+//
+// Identifiers are validated against the registry of kinds, flags and rules, so the cache is emptied
+// whenever the registry is modified (such as with RegisterKind or RegisterFlagRule), after which
+// identifiers are validated again when they are parsed.
+//
+// A ParseCache can be used directly with ParseCache.Parse, or installed as the process-wide cache with
+// SetParseCache, which is then used by the NewXFromHex constructors and the UnmarshalText methods of all
+// identifier types (except Identifier). Identifiers served from the cache are not validated again by these,
+// which only check their kind, and identifiers they decode on a cache miss are cached if valid. A ParseCache
+// is safe for concurrent use, and must be created with NewParseCache. To reduce lock contention, its
+// capacity is divided among multiple shards, each of which evicts its own least recently used identifiers.
+type ParseCache struct {
+	seed   maphash.Seed
+	shards []*parseShard
+}
+
+// parseShard is a shard of a ParseCache with its own lock and LRU order
+type parseShard struct {
+	mutex    sync.Mutex
+	capacity int
+
+	// tables is the registry snapshot that the cached identifiers were validated against
+	tables *registryTables
+
+	order   *list.List // list of *parseEntry, with the most recently used at the front
+	entries map[string]*list.Element
+}
+
+// parseEntry is an entry in the ParseCache
+type parseEntry struct {
+	key string
+	id  Identifier
+}
+
+const (
+	// parseCacheShards is the maximum number of shards of a ParseCache
+	parseCacheShards = 16
+	// parseShardMinCapacity is the minimum capacity of each shard of a ParseCache,
+	// so that small caches are not divided into shards that only hold a few identifiers
+	parseShardMinCapacity = 64
+)
+
+// NewParseCache creates a new empty ParseCache that holds up to the given number of identifiers.
+// The least recently used identifier is evicted when the cache is full. Panics if capacity is not positive.
+func NewParseCache(capacity int) *ParseCache {
+	if capacity <= 0 {
+		panic("parse cache capacity must be positive")
+	}
+
+	count := min(parseCacheShards, max(1, capacity/parseShardMinCapacity))
+	cache := &ParseCache{seed: maphash.MakeSeed(), shards: make([]*parseShard, count)}
+
+	for idx := range cache.shards {
+		// Divide the capacity among the shards, with the remainder going to the first shards
+		shardCapacity := capacity / count
+		if idx < capacity%count {
+			shardCapacity++
+		}
+
+		cache.shards[idx] = &parseShard{
+			capacity: shardCapacity,
+			order:    list.New(),
+			entries:  make(map[string]*list.Element, shardCapacity),
+		}
+	}
+
+	return cache
+}
+
+// parseCache is the process-wide ParseCache, which is disabled (nil) by default
+var parseCache atomic.Pointer[ParseCache]
+
+// SetParseCache installs the given ParseCache as the process-wide cache,
+// which is used when parsing hex strings into identifiers. A nil cache disables caching.
+func SetParseCache(cache *ParseCache) { parseCache.Store(cache) }
+
+// Parse decodes the given hex string (0x prefix is optional) into an Identifier,
+// which must be valid (see Identifier.Validate). The result is served from the cache if available.
+func (cache *ParseCache) Parse(data string) (Identifier, error) {
+	key := trim0xPrefixString(data)
+	lookup := &parseLookup{shard: cache.shard(key), key: key}
+
+	// Load the registry snapshot before validating, so that the identifier
+	// is cached against the snapshot it was (at the latest) validated with
+	lookup.tables = registry.Load()
+	if id, ok := lookup.shard.get(key, lookup.tables); ok {
+		return id, nil
+	}
+
+	decoded, err := decodeHexString(key)
+	if err != nil {
+		return Nil, err
+	}
+
+	if len(decoded) != 32 {
+		return Nil, ErrInvalidLength
+	}
+
+	id := Identifier(decoded)
+	if err = id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	lookup.store(id)
+
+	return id, nil
+}
+
+// Len returns the number of identifiers in the cache
+func (cache *ParseCache) Len() int {
+	var length int
+
+	for _, shard := range cache.shards {
+		shard.mutex.Lock()
+		length += shard.order.Len()
+		shard.mutex.Unlock()
+	}
+
+	return length
+}
+
+// Purge removes all identifiers from the cache
+func (cache *ParseCache) Purge() {
+	for _, shard := range cache.shards {
+		shard.mutex.Lock()
+		shard.purge()
+		shard.mutex.Unlock()
+	}
+}
+
+// shard returns the shard of the cache for the given key
+func (cache *ParseCache) shard(key string) *parseShard {
+	if len(cache.shards) == 1 {
+		return cache.shards[0]
+	}
+
+	return cache.shards[maphash.String(cache.seed, key)%uint64(len(cache.shards))]
+}
+
+// get returns the Identifier for the given key and marks it as recently used.
+// The shard is emptied if its identifiers were validated against a different registry snapshot.
+func (shard *parseShard) get(key string, tables *registryTables) (Identifier, bool) {
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	if shard.tables != tables {
+		shard.purge()
+		shard.tables = tables
+
+		return Nil, false
+	}
+
+	element, ok := shard.entries[key]
+	if !ok {
+		return Nil, false
+	}
+
+	shard.order.MoveToFront(element)
+
+	return element.Value.(*parseEntry).id, true //nolint:forcetypeassert // list only holds *parseEntry
+}
+
+// put adds the Identifier for the given key that was validated against the given registry snapshot,
+// evicting the least recently used identifier if the shard is full
+func (shard *parseShard) put(key string, id Identifier, tables *registryTables) {
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	// The registry was modified since the shard was last used, so its identifiers are stale
+	if shard.tables != tables {
+		shard.purge()
+		shard.tables = tables
+	}
+
+	if element, ok := shard.entries[key]; ok {
+		shard.order.MoveToFront(element)
+		return
+	}
+
+	if shard.order.Len() >= shard.capacity {
+		oldest := shard.order.Back()
+		shard.order.Remove(oldest)
+		delete(shard.entries, oldest.Value.(*parseEntry).key) //nolint:forcetypeassert // list only holds *parseEntry
+	}
+
+	shard.entries[key] = shard.order.PushFront(&parseEntry{key: key, id: id})
+}
+
+// purge removes all identifiers from the shard. The mutex of the shard must be held.
+func (shard *parseShard) purge() {
+	shard.order.Init()
+	clear(shard.entries)
+}
+
+// parseLookup is a lookup of a hex string in the process-wide ParseCache, with which
+// the identifier decoded on a cache miss is stored against the snapshot of the lookup
+type parseLookup struct {
+	shard  *parseShard
+	key    string
+	tables *registryTables
+}
+
+// lookupParseCache looks up the given hex string (0x prefix is optional) in the process-wide ParseCache,
+// and returns the valid Identifier if it is cached. Otherwise, the returned lookup is used to store the
+// identifier once it is decoded, so that a cache miss decodes and validates the string only once.
+// The lookup is nil (and stores nothing) if no cache is installed with SetParseCache.
+func lookupParseCache(data string) (Identifier, *parseLookup, bool) {
+	cache := parseCache.Load()
+	if cache == nil {
+		return Nil, nil, false
+	}
+
+	key := trim0xPrefixString(data)
+	lookup := &parseLookup{shard: cache.shard(key), key: key, tables: registry.Load()}
+
+	id, ok := lookup.shard.get(key, lookup.tables)
+
+	return id, lookup, ok
+}
+
+// store adds the valid Identifier that was decoded after a cache miss to the ParseCache
+func (lookup *parseLookup) store(id Identifier) {
+	if lookup != nil {
+		lookup.shard.put(lookup.key, id, lookup.tables)
+	}
+}
+
+// unmarshalCached decodes the text encoding of an identifier of the given kind (see unmarshal32).
+// Hex strings are served from the process-wide ParseCache (if installed) if they are cached with
+// the kind, and are otherwise cached after they are decoded if they are valid identifiers.
+// Identifiers are not validated by the text encoding, so invalid identifiers are decoded but not cached.
+func unmarshalCached(data []byte, kind IdentifierKind) ([32]byte, error) {
+	// Base64 strings are not cached, as they are not accepted by ParseCache.Parse
+	if !has0xPrefixBytes(data) {
+		return unmarshal32(data)
+	}
+
+	id, lookup, ok := lookupParseCache(string(data))
+	if ok && id.Tag().Kind() == kind {
+		return id, nil
+	}
+
+	decoded, err := unmarshal32(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	if lookup != nil && Identifier(decoded).Validate() == nil {
+		lookup.store(decoded)
+	}
+
+	return decoded, nil
+}
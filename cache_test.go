@@ -0,0 +1,275 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCache(t *testing.T) {
+	cache := NewParseCache(2)
+
+	a := RandomAssetIDv0().AsIdentifier()
+	b := RandomLogicIDv0().AsIdentifier()
+	c := RandomParticipantIDv0().AsIdentifier()
+
+	for _, id := range []Identifier{a, b} {
+		parsed, err := cache.Parse(id.Hex())
+		require.NoError(t, err)
+		require.Equal(t, id, parsed)
+	}
+
+	require.Equal(t, 2, cache.Len())
+
+	// Hex strings with and without the 0x prefix share the same entry
+	parsed, err := cache.Parse(a.Hex()[2:])
+	require.NoError(t, err)
+	require.Equal(t, a, parsed)
+	require.Equal(t, 2, cache.Len())
+
+	// Adding another identifier evicts the least recently used one (b)
+	_, err = cache.Parse(c.Hex())
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+
+	tables := registry.Load()
+
+	_, ok := cache.shard(b.Hex()[2:]).get(b.Hex()[2:], tables)
+	require.False(t, ok)
+
+	cached, ok := cache.shard(a.Hex()[2:]).get(a.Hex()[2:], tables)
+	require.True(t, ok)
+	require.Equal(t, a, cached)
+
+	// Adding an existing key does not create a new entry
+	cache.shard(c.Hex()[2:]).put(c.Hex()[2:], c, tables)
+	require.Equal(t, 2, cache.Len())
+
+	cache.Purge()
+	require.Equal(t, 0, cache.Len())
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := cache.Parse("0xzz")
+		require.ErrorContains(t, err, "invalid byte")
+
+		_, err = cache.Parse("0x1234")
+		require.ErrorIs(t, err, ErrInvalidLength)
+
+		invalid := a
+		invalid[0] = 0xF0
+
+		_, err = cache.Parse(invalid.Hex())
+		require.ErrorContains(t, err, "unsupported tag kind")
+
+		// Failed parses are not cached
+		require.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		require.PanicsWithValue(t, "parse cache capacity must be positive", func() { NewParseCache(0) })
+	})
+
+	t.Run("Shards", func(t *testing.T) {
+		require.Len(t, NewParseCache(100).shards, 1)
+		require.Len(t, NewParseCache(10_000).shards, parseCacheShards)
+
+		// The capacity is divided among the shards
+		sharded := NewParseCache(130)
+		require.Len(t, sharded.shards, 2)
+		require.Equal(t, 65, sharded.shards[0].capacity)
+		require.Equal(t, 65, sharded.shards[1].capacity)
+
+		sharded = NewParseCache(1000)
+		capacity := 0
+
+		for _, shard := range sharded.shards {
+			capacity += shard.capacity
+		}
+
+		require.Equal(t, 1000, capacity)
+
+		ids := make([]Identifier, 200)
+		for idx := range ids {
+			ids[idx] = RandomAssetIDv0().AsIdentifier()
+
+			parsed, err := sharded.Parse(ids[idx].Hex())
+			require.NoError(t, err)
+			require.Equal(t, ids[idx], parsed)
+		}
+
+		require.Equal(t, 200, sharded.Len())
+
+		sharded.Purge()
+		require.Zero(t, sharded.Len())
+	})
+}
+
+func TestParseCache_RegistryChange(t *testing.T) {
+	cache := NewParseCache(16)
+
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1))
+	invalid := must(GenerateAssetIDv0(RandomFingerprint(), 0, 200))
+
+	for _, id := range []AssetID{asset, invalid} {
+		_, err := cache.Parse(id.Hex())
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 2, cache.Len())
+
+	// Modifying the registry invalidates the cached identifiers
+	require.NoError(t, RegisterMetadataValidator(KindAsset, func(id Identifier) error {
+		if AssetID(id).Standard() > 100 {
+			return errors.New("standard too big")
+		}
+
+		return nil
+	}))
+	t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+	_, err := cache.Parse(invalid.Hex())
+	require.ErrorIs(t, err, ErrBadMetadata)
+	require.Zero(t, cache.Len())
+
+	parsed, err := cache.Parse(asset.Hex())
+	require.NoError(t, err)
+	require.Equal(t, asset.AsIdentifier(), parsed)
+	require.Equal(t, 1, cache.Len())
+
+	// Identifiers validated against a stale snapshot empty the shard when they are added
+	shard := cache.shard(asset.Hex()[2:])
+	shard.put(invalid.Hex()[2:], invalid.AsIdentifier(), &registryTables{})
+	require.Equal(t, 1, cache.Len())
+
+	_, err = cache.Parse(invalid.Hex())
+	require.ErrorIs(t, err, ErrBadMetadata)
+	require.Zero(t, cache.Len())
+}
+
+func TestSetParseCache(t *testing.T) {
+	cache := NewParseCache(16)
+
+	SetParseCache(cache)
+	t.Cleanup(func() { SetParseCache(nil) })
+
+	asset := RandomAssetIDv0()
+
+	parsed, err := NewAssetIDFromHex(asset.Hex())
+	require.NoError(t, err)
+	require.Equal(t, asset, parsed)
+	require.Equal(t, 1, cache.Len())
+
+	// Subsequent parses are served from the cache
+	parsed, err = NewAssetIDFromHex(asset.Hex())
+	require.NoError(t, err)
+	require.Equal(t, asset, parsed)
+	require.Equal(t, 1, cache.Len())
+
+	// UnmarshalText is served from the cache, and caches the valid identifiers it decodes
+	var decoded AssetID
+
+	require.NoError(t, json.Unmarshal([]byte(`"`+asset.Hex()+`"`), &decoded))
+	require.Equal(t, asset, decoded)
+	require.Equal(t, 1, cache.Len())
+
+	unmarshaled := RandomAssetIDv0()
+
+	require.NoError(t, json.Unmarshal([]byte(`"`+unmarshaled.Hex()+`"`), &decoded))
+	require.Equal(t, unmarshaled, decoded)
+	require.Equal(t, 2, cache.Len())
+
+	parsed, err = NewAssetIDFromHex(unmarshaled.Hex())
+	require.NoError(t, err)
+	require.Equal(t, unmarshaled, parsed)
+	require.Equal(t, 2, cache.Len())
+
+	// UnmarshalText does not validate, so invalid identifiers are decoded but not cached
+	invalid := AssetID{byte(TagAssetV0), 0xFF}
+	require.NoError(t, decoded.UnmarshalText([]byte(invalid.Hex())))
+	require.Equal(t, invalid, decoded)
+	require.Equal(t, 2, cache.Len())
+
+	// Identifiers of other kinds are decoded as before, and malformed or base64 strings are not cached
+	var logic LogicID
+
+	require.NoError(t, logic.UnmarshalText([]byte(asset.Hex())))
+	require.Equal(t, LogicID(asset), logic)
+	require.ErrorIs(t, logic.UnmarshalText([]byte("0x1234")), ErrInvalidLength)
+
+	encoded, err := Base64[AssetID]{ID: unmarshaled}.MarshalText()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalText(encoded))
+	require.Equal(t, unmarshaled, decoded)
+	require.Equal(t, 2, cache.Len())
+
+	// The kind is still checked for cached identifiers, with the same error as without the cache
+	_, err = NewLogicIDFromHex(asset.Hex())
+	require.ErrorIs(t, err, ErrNotLogicID)
+
+	// Invalid identifiers are not cached, and are rejected with the same error as without the cache
+	_, err = NewAssetIDFromHex(invalid.Hex())
+	require.ErrorIs(t, err, ErrBadFlags)
+	require.Equal(t, 2, cache.Len())
+
+	// Caching is disabled with a nil cache
+	SetParseCache(nil)
+
+	parsed, err = NewAssetIDFromHex(RandomAssetIDv0().Hex())
+	require.NoError(t, err)
+	require.NotEqual(t, asset, parsed)
+	require.Equal(t, 2, cache.Len())
+
+	require.NoError(t, decoded.UnmarshalText([]byte(RandomAssetIDv0().Hex())))
+	require.Equal(t, 2, cache.Len())
+}
+
+func TestSetParseCache_Constructors(t *testing.T) {
+	cache := NewParseCache(64)
+
+	SetParseCache(cache)
+	t.Cleanup(func() { SetParseCache(nil) })
+
+	constructors := []struct {
+		id    TaggedIdentifier
+		parse func(string) (TaggedIdentifier, error)
+	}{
+		{RandomParticipantIDv1(), func(data string) (TaggedIdentifier, error) {
+			return tagged(NewParticipantIDFromHex(data))
+		}},
+		{RandomAssetIDv1(), func(data string) (TaggedIdentifier, error) { return tagged(NewAssetIDFromHex(data)) }},
+		{RandomLogicIDv1(), func(data string) (TaggedIdentifier, error) { return tagged(NewLogicIDFromHex(data)) }},
+		{RandomInteractionIDv0(), func(data string) (TaggedIdentifier, error) {
+			return tagged(NewInteractionIDFromHex(data))
+		}},
+		{RandomTesseractIDv0(), func(data string) (TaggedIdentifier, error) {
+			return tagged(NewTesseractIDFromHex(data))
+		}},
+		{RandomGroupIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewGroupIDFromHex(data)) }},
+		{RandomFileIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewFileIDFromHex(data)) }},
+		{RandomReceiptIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewReceiptIDFromHex(data)) }},
+		{RandomTopicIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewTopicIDFromHex(data)) }},
+		{RandomKeyIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewKeyIDFromHex(data)) }},
+		{RandomDomainIDv0(), func(data string) (TaggedIdentifier, error) { return tagged(NewDomainIDFromHex(data)) }},
+	}
+
+	for _, constructor := range constructors {
+		t.Run(constructor.id.Tag().Kind().String(), func(t *testing.T) {
+			// The first parse caches the identifier and the second is served from the cache
+			for range 2 {
+				parsed, err := constructor.parse(constructor.id.Hex())
+				require.NoError(t, err)
+				require.Equal(t, constructor.id, parsed)
+			}
+
+			// Identifiers of other kinds are rejected
+			_, err := constructor.parse(RandomAssetIDv0().Hex())
+			if constructor.id.Tag().Kind() == KindAsset {
+				_, err = constructor.parse(RandomLogicIDv0().Hex())
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
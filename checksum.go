@@ -0,0 +1,173 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+// Identifiers can be encoded as checksummed hex strings, similar to the EIP-55 encoding of Ethereum
+// addresses. The checksum is encoded in the case of the hex letters: a letter is uppercase if the
+// corresponding nibble of the SHA-256 hash of the lowercase hex string (without the 0x prefix) is 8
+// or greater. Unlike EIP-55, SHA-256 is used instead of Keccak-256 to avoid an extra dependency.
+
+// Identifiers can also be encoded in a checksum-suffixed hex form 0x<64 hex><8 hex CRC32>, where
+// the suffix is the CRC-32 (IEEE) checksum of the 32 bytes of the identifier in big-endian order.
+// Unlike checksummed hex strings, this form detects corruption regardless of the case of the string.
+
+// crcHex returns the given 32 bytes as a hex string with the 0x prefix, suffixed with their CRC-32 checksum
+func crcHex(data [32]byte) string {
+	buffer := binary.BigEndian.AppendUint32(data[:], crc32.ChecksumIEEE(data[:]))
+	return prefix0xString + hex.EncodeToString(buffer)
+}
+
+// ParseChecked decodes the given checksum-suffixed hex string into an Identifier
+// (see Identifier.HexWithChecksum). The 0x prefix is optional, and ErrInvalidChecksum
+// is returned if the CRC-32 checksum does not match. The decoded Identifier must be valid.
+func ParseChecked(data string) (Identifier, error) {
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Check length of the data
+	if len(decoded) != 32+4 {
+		return Nil, errors.New("invalid length: checked identifier must be 36 bytes")
+	}
+
+	if crc32.ChecksumIEEE(decoded[:32]) != binary.BigEndian.Uint32(decoded[32:]) {
+		return Nil, fmt.Errorf("invalid checked hex: %w", ErrInvalidChecksum)
+	}
+
+	id := Identifier(decoded[:32])
+	if err = id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return id, nil
+}
+
+// HexWithChecksum returns the Identifier as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (id Identifier) HexWithChecksum() string { return crcHex(id) }
+
+// checksumHex returns the given 32 bytes as a checksummed hex string with the 0x prefix
+func checksumHex(data [32]byte) string {
+	encoded := []byte(hex.EncodeToString(data[:]))
+	hash := sha256.Sum256(encoded)
+
+	for i, char := range encoded {
+		if char < 'a' {
+			continue
+		}
+
+		// Get the nibble of the hash for the character
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+
+		if nibble&0x0F >= 8 {
+			encoded[i] = char - 'a' + 'A'
+		}
+	}
+
+	return prefix0xString + string(encoded)
+}
+
+// ParseHexChecksummed decodes the given hex string (0x prefix is optional) into an Identifier.
+// If the hex string contains both uppercase and lowercase letters, it must be a checksummed
+// hex string (see Identifier.HexChecksummed) and ErrInvalidChecksum is returned if the checksum
+// does not match. Hex strings in a single case are accepted without checksum verification.
+// The decoded Identifier must be valid, see Identifier.Validate
+func ParseHexChecksummed(data string) (Identifier, error) {
+	id, err := NewIdentifierFromHex(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	if err = id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	// Verify the checksum if the hex string is in mixed case
+	if trimmed := trim0xPrefixString(data); trimmed != strings.ToLower(trimmed) && trimmed != strings.ToUpper(trimmed) {
+		if trimmed != trim0xPrefixString(checksumHex(id)) {
+			return Nil, fmt.Errorf("invalid hex: %w", ErrInvalidChecksum)
+		}
+	}
+
+	return id, nil
+}
+
+// HexChecksummed returns the Identifier as a checksummed hex string with the 0x prefix
+func (id Identifier) HexChecksummed() string { return checksumHex(id) }
+
+// HexChecksummed returns the ParticipantID as a checksummed hex string with the 0x prefix
+func (participant ParticipantID) HexChecksummed() string { return checksumHex(participant) }
+
+// HexChecksummed returns the AssetID as a checksummed hex string with the 0x prefix
+func (asset AssetID) HexChecksummed() string { return checksumHex(asset) }
+
+// HexChecksummed returns the LogicID as a checksummed hex string with the 0x prefix
+func (logic LogicID) HexChecksummed() string { return checksumHex(logic) }
+
+// HexChecksummed returns the InteractionID as a checksummed hex string with the 0x prefix
+func (interaction InteractionID) HexChecksummed() string { return checksumHex(interaction) }
+
+// HexChecksummed returns the TesseractID as a checksummed hex string with the 0x prefix
+func (tesseract TesseractID) HexChecksummed() string { return checksumHex(tesseract) }
+
+// HexChecksummed returns the GroupID as a checksummed hex string with the 0x prefix
+func (group GroupID) HexChecksummed() string { return checksumHex(group) }
+
+// HexChecksummed returns the FileID as a checksummed hex string with the 0x prefix
+func (file FileID) HexChecksummed() string { return checksumHex(file) }
+
+// HexChecksummed returns the ReceiptID as a checksummed hex string with the 0x prefix
+func (receipt ReceiptID) HexChecksummed() string { return checksumHex(receipt) }
+
+// HexChecksummed returns the TopicID as a checksummed hex string with the 0x prefix
+func (topic TopicID) HexChecksummed() string { return checksumHex(topic) }
+
+// HexChecksummed returns the KeyID as a checksummed hex string with the 0x prefix
+func (key KeyID) HexChecksummed() string { return checksumHex(key) }
+
+// HexChecksummed returns the DomainID as a checksummed hex string with the 0x prefix
+func (domain DomainID) HexChecksummed() string { return checksumHex(domain) }
+
+// HexWithChecksum returns the ParticipantID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (participant ParticipantID) HexWithChecksum() string { return crcHex(participant) }
+
+// HexWithChecksum returns the AssetID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (asset AssetID) HexWithChecksum() string { return crcHex(asset) }
+
+// HexWithChecksum returns the LogicID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (logic LogicID) HexWithChecksum() string { return crcHex(logic) }
+
+// HexWithChecksum returns the InteractionID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (interaction InteractionID) HexWithChecksum() string { return crcHex(interaction) }
+
+// HexWithChecksum returns the TesseractID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (tesseract TesseractID) HexWithChecksum() string { return crcHex(tesseract) }
+
+// HexWithChecksum returns the GroupID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (group GroupID) HexWithChecksum() string { return crcHex(group) }
+
+// HexWithChecksum returns the FileID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (file FileID) HexWithChecksum() string { return crcHex(file) }
+
+// HexWithChecksum returns the ReceiptID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (receipt ReceiptID) HexWithChecksum() string { return crcHex(receipt) }
+
+// HexWithChecksum returns the TopicID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (topic TopicID) HexWithChecksum() string { return crcHex(topic) }
+
+// HexWithChecksum returns the KeyID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (key KeyID) HexWithChecksum() string { return crcHex(key) }
+
+// HexWithChecksum returns the DomainID as a hex string with the 0x prefix, suffixed with its CRC-32 checksum
+func (domain DomainID) HexWithChecksum() string { return crcHex(domain) }
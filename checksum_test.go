@@ -0,0 +1,137 @@
+package identifiers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_HexChecksummed(t *testing.T) {
+	id := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000abcdef")
+
+	encoded := id.HexChecksummed()
+	require.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000aBcdeF", encoded)
+	require.True(t, strings.EqualFold(id.Hex(), encoded))
+
+	decoded, err := ParseHexChecksummed(encoded)
+	require.NoError(t, err)
+	require.Equal(t, id, decoded)
+}
+
+func TestHexChecksummed_Types(t *testing.T) {
+	tests := []TaggedIdentifier{
+		RandomParticipantIDv0(), RandomAssetIDv0(), RandomLogicIDv0(), RandomInteractionIDv0(),
+		RandomTesseractIDv0(), RandomGroupIDv0(), RandomFileIDv0(), RandomReceiptIDv0(),
+		RandomTopicIDv0(), RandomKeyIDv0(), RandomDomainIDv0(),
+	}
+
+	for _, test := range tests {
+		checksummed, ok := test.(interface{ HexChecksummed() string })
+		require.True(t, ok)
+
+		assert.Equal(t, Identifier(test.Bytes()).HexChecksummed(), checksummed.HexChecksummed())
+	}
+}
+
+func TestParseHexChecksummed(t *testing.T) {
+	id := RandomAssetIDv0().AsIdentifier()
+	encoded := id.HexChecksummed()
+
+	t.Run("SingleCase", func(t *testing.T) {
+		decoded, err := ParseHexChecksummed(strings.ToLower(encoded))
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+
+		decoded, err = ParseHexChecksummed("0x" + strings.ToUpper(encoded[2:]))
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+
+		decoded, err = ParseHexChecksummed(encoded[2:])
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+	})
+
+	t.Run("BadChecksum", func(t *testing.T) {
+		// The checksummed form of this identifier ends with aBcdeF
+		_, err := ParseHexChecksummed("0x0100000000000000000000000000000000000000000000000000000000abcdeF")
+		require.ErrorIs(t, err, ErrInvalidChecksum)
+		require.EqualError(t, err, "invalid hex: invalid checksum")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseHexChecksummed("0x1234")
+		require.EqualError(t, err, "invalid length: identifier must be 32 bytes")
+
+		invalid := id
+		invalid[0] = 0xF0
+
+		_, err = ParseHexChecksummed(invalid.HexChecksummed())
+		require.ErrorContains(t, err, "invalid tag: unsupported tag kind")
+	})
+}
+
+func TestIdentifier_HexWithChecksum(t *testing.T) {
+	id := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000abcdef")
+
+	encoded := id.HexWithChecksum()
+	require.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000abcdef731cb46c", encoded)
+
+	decoded, err := ParseChecked(encoded)
+	require.NoError(t, err)
+	require.Equal(t, id, decoded)
+}
+
+func TestHexWithChecksum_Types(t *testing.T) {
+	tests := []TaggedIdentifier{
+		RandomParticipantIDv0(), RandomAssetIDv0(), RandomLogicIDv0(), RandomInteractionIDv0(),
+		RandomTesseractIDv0(), RandomGroupIDv0(), RandomFileIDv0(), RandomReceiptIDv0(),
+		RandomTopicIDv0(), RandomKeyIDv0(), RandomDomainIDv0(),
+	}
+
+	for _, test := range tests {
+		checked, ok := test.(interface{ HexWithChecksum() string })
+		require.True(t, ok)
+
+		assert.Equal(t, Identifier(test.Bytes()).HexWithChecksum(), checked.HexWithChecksum())
+	}
+}
+
+func TestParseChecked(t *testing.T) {
+	id := RandomLogicIDv0().AsIdentifier()
+	encoded := id.HexWithChecksum()
+
+	t.Run("CaseAndPrefix", func(t *testing.T) {
+		decoded, err := ParseChecked(strings.ToUpper(encoded[2:]))
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		corrupted := []byte(encoded)
+		if corrupted[40] == '0' {
+			corrupted[40] = '1'
+		} else {
+			corrupted[40] = '0'
+		}
+
+		_, err := ParseChecked(string(corrupted))
+		require.ErrorIs(t, err, ErrInvalidChecksum)
+		require.EqualError(t, err, "invalid checked hex: invalid checksum")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseChecked("0xzz")
+		require.ErrorContains(t, err, "invalid byte")
+
+		_, err = ParseChecked(id.Hex())
+		require.EqualError(t, err, "invalid length: checked identifier must be 36 bytes")
+
+		invalid := id
+		invalid[0] = 0xF0
+
+		_, err = ParseChecked(invalid.HexWithChecksum())
+		require.ErrorContains(t, err, "invalid tag: unsupported tag kind")
+	})
+}
@@ -0,0 +1,101 @@
+package identifiers
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Codec represents an encoding format for identifiers.
+// Codecs can be registered with RegisterCodec and retrieved by name with LookupCodec,
+// allowing generic pipelines to encode and decode identifiers in any registered format.
+type Codec interface {
+	// Encode encodes the given Identifier into its encoded form
+	Encode(Identifier) ([]byte, error)
+	// Decode decodes the given data into an Identifier
+	Decode([]byte) (Identifier, error)
+}
+
+// HexCodec is a Codec for the 0x-prefixed hexadecimal encoding of identifiers.
+// It is identical to the text encoding of Identifier and is registered as "hex".
+type HexCodec struct{}
+
+// Encode implements the Codec interface for HexCodec
+func (HexCodec) Encode(id Identifier) ([]byte, error) { return marshal32(id) }
+
+// Decode implements the Codec interface for HexCodec
+func (HexCodec) Decode(data []byte) (Identifier, error) { return unmarshal32(data) }
+
+// RawCodec is a Codec for the raw 32-byte encoding of identifiers.
+// It is registered as "raw".
+type RawCodec struct{}
+
+// Encode implements the Codec interface for RawCodec
+func (RawCodec) Encode(id Identifier) ([]byte, error) { return id.Bytes(), nil }
+
+// Decode implements the Codec interface for RawCodec
+func (RawCodec) Decode(data []byte) (Identifier, error) {
+	if len(data) != 32 {
+		return Nil, ErrInvalidLength
+	}
+
+	return Identifier(data), nil
+}
+
+var (
+	// codecsLock guards access to codecs
+	codecsLock sync.RWMutex
+	// codecs is a map of codec names to registered Codec implementations
+	codecs = map[string]Codec{
+		"hex": HexCodec{},
+		"raw": RawCodec{},
+	}
+)
+
+// RegisterCodec registers the given Codec with the given name.
+// Returns an error if the name is empty, the codec is nil or a codec is already registered with the name.
+func RegisterCodec(name string, codec Codec) error {
+	if name == "" || codec == nil {
+		return fmt.Errorf("%w: name and codec must be non-empty", ErrInvalidCodec)
+	}
+
+	codecsLock.Lock()
+	defer codecsLock.Unlock()
+
+	if _, exists := codecs[name]; exists {
+		return fmt.Errorf("%w: %q", ErrCodecExists, name)
+	}
+
+	codecs[name] = codec
+
+	return nil
+}
+
+// LookupCodec returns the Codec registered with the given name.
+// Returns ErrUnknownCodec if no codec is registered with the name.
+func LookupCodec(name string) (Codec, error) {
+	codecsLock.RLock()
+	defer codecsLock.RUnlock()
+
+	codec, exists := codecs[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCodec, name)
+	}
+
+	return codec, nil
+}
+
+// Codecs returns the names of all registered codecs in sorted order.
+func Codecs() []string {
+	codecsLock.RLock()
+	defer codecsLock.RUnlock()
+
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
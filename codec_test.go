@@ -0,0 +1,96 @@
+package identifiers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upperHexCodec is a test Codec that encodes identifiers as uppercase hex without a prefix
+type upperHexCodec struct{}
+
+func (upperHexCodec) Encode(id Identifier) ([]byte, error) {
+	return []byte(strings.ToUpper(trim0xPrefixString(id.Hex()))), nil
+}
+
+func (upperHexCodec) Decode(data []byte) (Identifier, error) {
+	return NewIdentifierFromHex(string(data))
+}
+
+func TestCodec_Builtin(t *testing.T) {
+	identifier := RandomAssetIDv0().AsIdentifier()
+
+	t.Run("Hex", func(t *testing.T) {
+		codec, err := LookupCodec("hex")
+		require.NoError(t, err)
+
+		encoded, err := codec.Encode(identifier)
+		require.NoError(t, err)
+		assert.Equal(t, identifier.Hex(), string(encoded))
+
+		decoded, err := codec.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, identifier, decoded)
+
+		_, err = codec.Decode([]byte("0xffabcd"))
+		require.Equal(t, ErrInvalidLength, err)
+	})
+
+	t.Run("Raw", func(t *testing.T) {
+		codec, err := LookupCodec("raw")
+		require.NoError(t, err)
+
+		encoded, err := codec.Encode(identifier)
+		require.NoError(t, err)
+		assert.Equal(t, identifier.Bytes(), encoded)
+
+		decoded, err := codec.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, identifier, decoded)
+
+		_, err = codec.Decode([]byte{0xFF})
+		require.Equal(t, ErrInvalidLength, err)
+	})
+}
+
+func TestCodec_Registry(t *testing.T) {
+	require.NoError(t, RegisterCodec("test-upper-hex", upperHexCodec{}))
+	t.Cleanup(func() {
+		codecsLock.Lock()
+		delete(codecs, "test-upper-hex")
+		codecsLock.Unlock()
+	})
+
+	codec, err := LookupCodec("test-upper-hex")
+	require.NoError(t, err)
+
+	identifier := RandomLogicIDv0().AsIdentifier()
+	encoded, err := codec.Encode(identifier)
+	require.NoError(t, err)
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, identifier, decoded)
+
+	assert.Contains(t, Codecs(), "test-upper-hex")
+	assert.Subset(t, Codecs(), []string{"hex", "raw"})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := RegisterCodec("hex", upperHexCodec{})
+		require.EqualError(t, err, `codec already registered: "hex"`)
+		require.ErrorIs(t, err, ErrCodecExists)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.ErrorIs(t, RegisterCodec("", upperHexCodec{}), ErrInvalidCodec)
+		require.ErrorIs(t, RegisterCodec("nil-codec", nil), ErrInvalidCodec)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := LookupCodec("unknown")
+		require.EqualError(t, err, `unknown codec: "unknown"`)
+		require.ErrorIs(t, err, ErrUnknownCodec)
+	})
+}
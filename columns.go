@@ -0,0 +1,276 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"slices"
+)
+
+// IdentifierColumns stores identifiers in a columnar (struct of arrays) layout, where the tags, flags,
+// metadata, account IDs and variant IDs of all identifiers are stored in separate contiguous arrays.
+//
+// This allows large sets of identifiers to be filtered by scanning only the columns that are relevant
+// to a query, such as all systemic assets of a particular standard. Filters produce a Selection of
+// rows, which can be combined and then gathered back into identifiers. The zero value is an empty
+// set of columns that is ready to use. IdentifierColumns is not safe for concurrent use.
+type IdentifierColumns struct {
+	tags     []IdentifierTag
+	flags    []byte
+	metadata [][2]byte
+	accounts [][24]byte
+	variants []uint32
+}
+
+// NewIdentifierColumns creates a new IdentifierColumns from the given identifiers
+func NewIdentifierColumns(ids []Identifier) *IdentifierColumns {
+	columns := &IdentifierColumns{
+		tags:     make([]IdentifierTag, 0, len(ids)),
+		flags:    make([]byte, 0, len(ids)),
+		metadata: make([][2]byte, 0, len(ids)),
+		accounts: make([][24]byte, 0, len(ids)),
+		variants: make([]uint32, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		columns.Append(id)
+	}
+
+	return columns
+}
+
+// Append adds the given identifier as a new row at the end of the columns
+func (columns *IdentifierColumns) Append(id TaggedIdentifier) {
+	columns.tags = append(columns.tags, id.Tag())
+	columns.flags = append(columns.flags, id.Flags())
+	columns.metadata = append(columns.metadata, [2]byte(id.Bytes()[2:4]))
+	columns.accounts = append(columns.accounts, id.AccountID())
+	columns.variants = append(columns.variants, id.Variant())
+}
+
+// Len returns the number of rows (identifiers) in the columns
+func (columns *IdentifierColumns) Len() int { return len(columns.tags) }
+
+// At returns the identifier at the given row. Panics if the row is out of range.
+func (columns *IdentifierColumns) At(row int) Identifier {
+	var id Identifier
+
+	id[0], id[1] = byte(columns.tags[row]), columns.flags[row]
+	copy(id[2:4], columns.metadata[row][:])
+	copy(id[4:28], columns.accounts[row][:])
+	binary.BigEndian.PutUint32(id[28:], columns.variants[row])
+
+	return id
+}
+
+// Identifiers returns all identifiers in the columns in order of their rows
+func (columns *IdentifierColumns) Identifiers() []Identifier {
+	ids := make([]Identifier, columns.Len())
+	for row := range ids {
+		ids[row] = columns.At(row)
+	}
+
+	return ids
+}
+
+// Gather returns the identifiers in the rows of the given Selection in ascending order of their rows
+func (columns *IdentifierColumns) Gather(selection Selection) []Identifier {
+	ids := make([]Identifier, 0, selection.Count())
+	for _, row := range selection.Rows() {
+		ids = append(ids, columns.At(row))
+	}
+
+	return ids
+}
+
+// Tags returns the column of identifier tags. The returned slice must not be modified.
+func (columns *IdentifierColumns) Tags() []IdentifierTag { return columns.tags }
+
+// Flags returns the column of identifier flags. The returned slice must not be modified.
+func (columns *IdentifierColumns) Flags() []byte { return columns.flags }
+
+// Metadata returns the column of identifier metadata. The returned slice must not be modified.
+func (columns *IdentifierColumns) Metadata() [][2]byte { return columns.metadata }
+
+// Accounts returns the column of account IDs. The returned slice must not be modified.
+func (columns *IdentifierColumns) Accounts() [][24]byte { return columns.accounts }
+
+// Variants returns the column of variant IDs. The returned slice must not be modified.
+func (columns *IdentifierColumns) Variants() []uint32 { return columns.variants }
+
+// All returns a Selection of all rows
+func (columns *IdentifierColumns) All() Selection {
+	selection := newSelection(columns.Len())
+	for row := range columns.tags {
+		selection.set(row)
+	}
+
+	return selection
+}
+
+// ByKind returns a Selection of the rows with identifiers of the given kind
+func (columns *IdentifierColumns) ByKind(kind IdentifierKind) Selection {
+	selection := newSelection(columns.Len())
+	for row, tag := range columns.tags {
+		if tag.Kind() == kind {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// ByTag returns a Selection of the rows with identifiers of the given tag
+func (columns *IdentifierColumns) ByTag(tag IdentifierTag) Selection {
+	selection := newSelection(columns.Len())
+	for row, value := range columns.tags {
+		if value == tag {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// ByFlag returns a Selection of the rows with identifiers that have the given Flag set.
+// Like the Flag methods of identifiers, the flag is only considered set if it is supported by the tag.
+func (columns *IdentifierColumns) ByFlag(flag Flag) Selection {
+	// Determine the support of the flag for each tag once
+	var support [256]int8
+
+	selection := newSelection(columns.Len())
+
+	for row, flags := range columns.flags {
+		if !getFlag(flags, flag.index) {
+			continue
+		}
+
+		tag := columns.tags[row]
+		if support[tag] == 0 {
+			support[tag] = -1
+			if flag.Supports(tag) {
+				support[tag] = 1
+			}
+		}
+
+		if support[tag] == 1 {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// ByMetadata returns a Selection of the rows with identifiers that have the given metadata,
+// such as the standard of an AssetID (which is encoded as a big-endian 16-bit integer)
+func (columns *IdentifierColumns) ByMetadata(metadata [2]byte) Selection {
+	selection := newSelection(columns.Len())
+	for row, value := range columns.metadata {
+		if value == metadata {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// ByAccount returns a Selection of the rows with identifiers that have the given account ID
+func (columns *IdentifierColumns) ByAccount(account [24]byte) Selection {
+	selection := newSelection(columns.Len())
+	for row := range columns.accounts {
+		if columns.accounts[row] == account {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// ByVariant returns a Selection of the rows with identifiers that have the given variant ID
+func (columns *IdentifierColumns) ByVariant(variant uint32) Selection {
+	selection := newSelection(columns.Len())
+	for row, value := range columns.variants {
+		if value == variant {
+			selection.set(row)
+		}
+	}
+
+	return selection
+}
+
+// Selection is a set of rows in an IdentifierColumns, stored as a bitmap.
+// Selections from the same columns can be combined with And, Or and AndNot.
+type Selection []uint64
+
+// newSelection creates an empty Selection for the given number of rows
+func newSelection(rows int) Selection {
+	return make(Selection, (rows+63)/64)
+}
+
+// set adds the given row to the Selection
+func (selection Selection) set(row int) {
+	selection[row/64] |= 1 << (row % 64)
+}
+
+// Contains returns whether the given row is in the Selection
+func (selection Selection) Contains(row int) bool {
+	return row >= 0 && row/64 < len(selection) && selection[row/64]&(1<<(row%64)) != 0
+}
+
+// Count returns the number of rows in the Selection
+func (selection Selection) Count() int {
+	count := 0
+	for _, word := range selection {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+// Rows returns the rows in the Selection in ascending order
+func (selection Selection) Rows() []int {
+	rows := make([]int, 0, selection.Count())
+
+	for index, word := range selection {
+		for word != 0 {
+			rows = append(rows, index*64+bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+
+	return rows
+}
+
+// And returns a new Selection with the rows that are in both selections
+func (selection Selection) And(other Selection) Selection {
+	return selection.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Or returns a new Selection with the rows that are in either selection
+func (selection Selection) Or(other Selection) Selection {
+	return selection.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// AndNot returns a new Selection with the rows that are in this selection but not in the other selection
+func (selection Selection) AndNot(other Selection) Selection {
+	return selection.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// combine returns a new Selection by combining both selections word by word with the given operation.
+// Selections of different lengths are combined as if the shorter selection is padded with empty words.
+func (selection Selection) combine(other Selection, operation func(a, b uint64) uint64) Selection {
+	left, right := slices.Clone(selection), other
+	if len(left) < len(right) {
+		left = append(left, make(Selection, len(right)-len(left))...)
+	}
+
+	for index := range left {
+		var word uint64
+		if index < len(right) {
+			word = right[index]
+		}
+
+		left[index] = operation(left[index], word)
+	}
+
+	return left
+}
@@ -0,0 +1,97 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifierColumns(t *testing.T) {
+	account := RandomFingerprint()
+
+	ids := []Identifier{
+		must(GenerateAssetIDv0(account, 0, 20, Systemic)).AsIdentifier(),
+		must(GenerateAssetIDv0(account, 1, 20)).AsIdentifier(),
+		must(GenerateAssetIDv0(RandomFingerprint(), 0, 21, Systemic)).AsIdentifier(),
+		must(GenerateLogicIDv0(account, 5, 0, Systemic)).AsIdentifier(),
+		must(GenerateParticipantIDv0(RandomFingerprint(), 5)).AsIdentifier(),
+	}
+
+	// Set an unsupported flag that must not be selected
+	unsupported := ids[4]
+	unsupported[1] |= 0b00000010
+	ids = append(ids, unsupported)
+
+	columns := NewIdentifierColumns(ids)
+	require.Equal(t, len(ids), columns.Len())
+	require.Equal(t, ids, columns.Identifiers())
+	require.Equal(t, ids[3], columns.At(3))
+
+	t.Run("Columns", func(t *testing.T) {
+		assert.Equal(t, []IdentifierTag{TagAssetV0, TagAssetV0, TagAssetV0, TagLogicV0, TagParticipantV0, TagParticipantV0},
+			columns.Tags())
+		assert.Equal(t, []byte{0x80, 0, 0x80, 0x80, 0, 0b10}, columns.Flags())
+		assert.Equal(t, [2]byte{0, 20}, columns.Metadata()[0])
+		assert.Equal(t, account, columns.Accounts()[3])
+		assert.Equal(t, []uint32{0, 1, 0, 5, 5, 5}, columns.Variants())
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, columns.All().Rows())
+		assert.Equal(t, []int{0, 1, 2}, columns.ByKind(KindAsset).Rows())
+		assert.Equal(t, []int{4, 5}, columns.ByTag(TagParticipantV0).Rows())
+		assert.Equal(t, []int{0, 2, 3}, columns.ByFlag(Systemic).Rows())
+		assert.Equal(t, []int{0, 1}, columns.ByMetadata([2]byte{0, 20}).Rows())
+		assert.Equal(t, []int{0, 1, 3}, columns.ByAccount(account).Rows())
+		assert.Equal(t, []int{3, 4, 5}, columns.ByVariant(5).Rows())
+
+		// The flag of an unsupported tag is not selected
+		assert.Empty(t, columns.ByFlag(AssetLogical).Rows())
+
+		// All systemic assets of standard 20
+		selection := columns.ByKind(KindAsset).And(columns.ByFlag(Systemic)).And(columns.ByMetadata([2]byte{0, 20}))
+		assert.Equal(t, []Identifier{ids[0]}, columns.Gather(selection))
+	})
+
+	t.Run("Append", func(t *testing.T) {
+		var columns IdentifierColumns
+
+		asset := RandomAssetIDv1()
+		columns.Append(asset)
+
+		require.Equal(t, 1, columns.Len())
+		require.Equal(t, asset.AsIdentifier(), columns.At(0))
+		require.Equal(t, []int{0}, columns.All().Rows())
+	})
+}
+
+func TestSelection(t *testing.T) {
+	ids := make([]Identifier, 130)
+	for row := range ids {
+		ids[row] = RandomLogicIDv0().AsIdentifier()
+		if row%2 == 0 {
+			ids[row] = RandomAssetIDv0().AsIdentifier()
+		}
+	}
+
+	columns := NewIdentifierColumns(ids)
+	assets, logics := columns.ByKind(KindAsset), columns.ByKind(KindLogic)
+
+	require.Equal(t, 65, assets.Count())
+	require.True(t, assets.Contains(128))
+	require.False(t, assets.Contains(129))
+	require.False(t, assets.Contains(-1))
+	require.False(t, assets.Contains(1000))
+
+	require.Zero(t, assets.And(logics).Count())
+	require.Equal(t, 130, assets.Or(logics).Count())
+	require.Equal(t, assets, assets.AndNot(logics))
+	require.Equal(t, assets, columns.All().AndNot(logics))
+
+	// Selections of different lengths
+	short := NewIdentifierColumns(ids[:3]).All()
+	require.Equal(t, []int{0, 2}, short.And(assets).Rows())
+	require.Equal(t, []int{0, 2}, assets.And(short).Rows())
+	require.Equal(t, 66, short.Or(assets).Count())
+}
@@ -0,0 +1,97 @@
+package identifiers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/sarvalabs/go-moi-identifiers/internal/keccak"
+)
+
+// commitmentDomain is the hashing domain for identifier commitments (see Identifier.HashWithDomain)
+const commitmentDomain = "moi.commitment"
+
+// Commitment is a 32-byte hiding commitment to an identifier, which allows protocols (such as sealed-bid
+// flows) to commit to an identifier without revealing it. The commitment is opened by revealing the
+// identifier and its salt, which are checked with Commitment.Verify.
+type Commitment [32]byte
+
+var (
+	// Ensure Commitment implements text marshaling interfaces
+	_ encoding.TextMarshaler   = (*Commitment)(nil)
+	_ encoding.TextUnmarshaler = (*Commitment)(nil)
+
+	// Ensure Commitment implements binary marshaling interfaces
+	_ encoding.BinaryMarshaler   = (*Commitment)(nil)
+	_ encoding.BinaryUnmarshaler = (*Commitment)(nil)
+)
+
+// RandomSalt generates a random 32-byte salt, for use with Commit
+func RandomSalt() (salt [32]byte) {
+	_, _ = rand.Read(salt[:])
+	return salt
+}
+
+// Commit returns the Commitment to the given identifier with the given salt.
+// The salt must be random and kept secret until the commitment is opened, otherwise
+// the identifier can be recovered by committing to candidate identifiers (see RandomSalt).
+//
+// The commitment is the Keccak-256 digest of the "moi.identifier:" prefix, followed by
+// the moi.commitment domain, the 32-byte salt and the 32 bytes of the identifier.
+func Commit(id Identifier, salt [32]byte) Commitment {
+	return keccak.Sum256([]byte(domainHashPrefix), []byte(commitmentDomain), salt[:], id[:])
+}
+
+// Verify returns whether the Commitment is a commitment to the given identifier with the given salt.
+// The comparison is performed in constant time, so it does not leak how much of the commitment matched.
+func (commitment Commitment) Verify(id Identifier, salt [32]byte) bool {
+	expected := Commit(id, salt)
+	return subtle.ConstantTimeCompare(commitment[:], expected[:]) == 1
+}
+
+// Bytes returns the Commitment as a []byte
+func (commitment Commitment) Bytes() []byte { return commitment[:] }
+
+// String returns the Commitment as a hex encoded string.
+// This is the same as Commitment.Hex()
+func (commitment Commitment) String() string { return commitment.Hex() }
+
+// Hex returns the Commitment as a hex encoded string with the 0x prefix
+func (commitment Commitment) Hex() string {
+	return prefix0xString + hex.EncodeToString(commitment[:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Commitment
+func (commitment Commitment) MarshalText() ([]byte, error) {
+	return marshal32(commitment)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Commitment
+func (commitment *Commitment) UnmarshalText(data []byte) error {
+	decoded, err := unmarshal32(data)
+	if err != nil {
+		return fmt.Errorf("invalid commitment: %w", err)
+	}
+
+	*commitment = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Commitment.
+// The Commitment is encoded as its raw 32 bytes.
+func (commitment Commitment) MarshalBinary() ([]byte, error) {
+	return commitment.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Commitment.
+// The data must be 32 bytes long.
+func (commitment *Commitment) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return fmt.Errorf("invalid commitment: %w", ErrInvalidLength)
+	}
+
+	*commitment = Commitment(data)
+	return nil
+}
@@ -0,0 +1,73 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sarvalabs/go-moi-identifiers/internal/keccak"
+)
+
+func TestCommit(t *testing.T) {
+	id := RandomAssetIDv0().AsIdentifier()
+	salt := RandomSalt()
+
+	commitment := Commit(id, salt)
+	assert.Equal(t, commitment, Commit(id, salt))
+	assert.Equal(t, Commitment(keccak.Sum256([]byte("moi.identifier:moi.commitment"), salt[:], id[:])), commitment)
+
+	// Test that the commitment is verified only for the same identifier and salt
+	assert.True(t, commitment.Verify(id, salt))
+	assert.False(t, commitment.Verify(RandomAssetIDv0().AsIdentifier(), salt))
+	assert.False(t, commitment.Verify(id, RandomSalt()))
+
+	// Test that the commitment hides the identifier
+	assert.NotEqual(t, Commitment(id.HashWithDomain(commitmentDomain)), commitment)
+	assert.NotEqual(t, commitment, Commit(id, RandomSalt()))
+}
+
+func TestRandomSalt(t *testing.T) {
+	assert.NotEqual(t, RandomSalt(), RandomSalt())
+	assert.NotEqual(t, [32]byte{}, RandomSalt())
+}
+
+func TestCommitment_Hex(t *testing.T) {
+	commitment := Commitment{0x01, 0xAB}
+
+	assert.Equal(t, "0x01ab000000000000000000000000000000000000000000000000000000000000", commitment.Hex())
+	assert.Equal(t, commitment.Hex(), commitment.String())
+	assert.Equal(t, commitment[:], commitment.Bytes())
+}
+
+func TestCommitment_TextMarshal(t *testing.T) {
+	commitment := Commit(RandomParticipantIDv0().AsIdentifier(), RandomSalt())
+
+	encoded, err := json.Marshal(commitment)
+	require.NoError(t, err)
+	require.Equal(t, `"`+commitment.Hex()+`"`, string(encoded))
+
+	var decoded Commitment
+
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, commitment, decoded)
+
+	require.EqualError(t, decoded.UnmarshalText([]byte("0xffabcd")), "invalid commitment: invalid length")
+	require.ErrorIs(t, decoded.UnmarshalText([]byte("ffabcd")), ErrMissingHexPrefix)
+}
+
+func TestCommitment_BinaryMarshal(t *testing.T) {
+	commitment := Commit(RandomParticipantIDv0().AsIdentifier(), RandomSalt())
+
+	encoded, err := commitment.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, commitment.Bytes(), encoded)
+
+	var decoded Commitment
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, commitment, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid commitment: invalid length")
+}
@@ -3,6 +3,8 @@ package identifiers
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"strings"
@@ -12,6 +14,23 @@ import (
 // Can be used to represent any nil identifier.
 var Nil [32]byte
 
+// Typed nil values of the Identifier and each identifier type, which can be compared
+// against directly instead of converting Nil. The IsNil methods of the types check against them.
+var (
+	NilIdentifier    Identifier
+	NilParticipantID ParticipantID
+	NilAssetID       AssetID
+	NilLogicID       LogicID
+	NilInteractionID InteractionID
+	NilTesseractID   TesseractID
+	NilGroupID       GroupID
+	NilFileID        FileID
+	NilReceiptID     ReceiptID
+	NilTopicID       TopicID
+	NilKeyID         KeyID
+	NilDomainID      DomainID
+)
+
 // RandomFingerprint generates a random 24-byte fingerprint
 func RandomFingerprint() (fingerprint [24]byte) {
 	_, _ = rand.Read(fingerprint[:])
@@ -26,10 +45,44 @@ var (
 var (
 	ErrMissingHexPrefix = errors.New("missing '0x' prefix")
 	ErrInvalidLength    = errors.New("invalid length")
+	ErrInvalidChecksum  = errors.New("invalid checksum")
 
 	ErrUnsupportedFlag    = errors.New("unsupported flag")
 	ErrUnsupportedVersion = errors.New("unsupported tag version")
 	ErrUnsupportedKind    = errors.New("unsupported tag kind")
+	ErrInvalidTagFormat   = errors.New("invalid tag format")
+
+	ErrInvalidKindSpec = errors.New("invalid kind spec")
+	ErrKindExists      = errors.New("kind already registered")
+	ErrUnhandledKind   = errors.New("unhandled kind")
+
+	ErrInvalidFlagSpec = errors.New("invalid flag spec")
+	ErrFlagExists      = errors.New("flag already registered")
+	ErrInvalidFlagRule = errors.New("invalid flag rule")
+	ErrFlagRuleExists  = errors.New("flag rule already registered")
+	ErrRequiredFlag    = errors.New("required flag not set")
+	ErrExcludedFlag    = errors.New("excluded flag set")
+
+	ErrInvalidMetadataValidator = errors.New("invalid metadata validator")
+
+	ErrInvalidAssetStandard = errors.New("invalid asset standard")
+	ErrUnknownAssetStandard = errors.New("unknown asset standard")
+	ErrAssetStandardExists  = errors.New("asset standard already registered")
+
+	ErrUnknownNetwork      = errors.New("unknown network")
+	ErrNetworkMismatch     = errors.New("network mismatch")
+	ErrMissingNetworkScope = errors.New("missing network scope")
+
+	ErrInvalidCodec = errors.New("invalid codec")
+	ErrUnknownCodec = errors.New("unknown codec")
+	ErrCodecExists  = errors.New("codec already registered")
+
+	ErrBridgeCollision = errors.New("bridge collision")
+
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+	ErrVariantsExhausted     = errors.New("variants exhausted")
+	ErrReservedVariant       = errors.New("reserved variant")
+	ErrStoreLocked           = errors.New("store locked by another process")
 )
 
 // trim0xPrefixString trims the 0x prefix from the given string (if it exists).
@@ -66,11 +119,28 @@ func trimFingerprint(bytes [32]byte) [24]byte {
 	return [24]byte(bytes[4:28])
 }
 
+// hashFingerprint derives a 24-byte fingerprint by hashing the given domain and data parts with SHA-256.
+// The domain separates fingerprints derived for different purposes from the same input data.
+// The fingerprint is the first 24 bytes of the resulting digest.
+func hashFingerprint(domain string, parts ...[]byte) [24]byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(domain))
+
+	for _, part := range parts {
+		hasher.Write(part)
+	}
+
+	return [24]byte(hasher.Sum(nil)[:24])
+}
+
 // trimVariant returns the 4 least-significant bytes of the given 32-byte array.
 func trimVariant(bytes [32]byte) [4]byte {
 	return [4]byte(bytes[28:])
 }
 
+// base64Length32 is the length of 32 bytes encoded in the standard base64 encoding
+var base64Length32 = base64.StdEncoding.EncodedLen(32)
+
 // marshal32 is a generic marshal function for 32-byte identifiers.
 // To be used in conjunction with MarshalText
 func marshal32(data [32]byte) ([]byte, error) {
@@ -85,8 +155,25 @@ func marshal32(data [32]byte) ([]byte, error) {
 }
 
 // unmarshal32 is generic unmarshal function for 32-byte identifiers.
-// To be used in conjunction with UnmarshalText
+// To be used in conjunction with UnmarshalText. The data can either be
+// 0x-prefixed hex or standard base64, which is detected by its length.
 func unmarshal32(data []byte) ([32]byte, error) {
+	// Decode the base64-encoded data
+	if len(data) == base64Length32 {
+		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+
+		n, err := base64.StdEncoding.Decode(decoded, data)
+		if err != nil {
+			return Nil, err
+		}
+
+		if n != 32 {
+			return Nil, ErrInvalidLength
+		}
+
+		return [32]byte(decoded[:n]), nil
+	}
+
 	// Assert that the 0x prefix exists
 	if !has0xPrefixBytes(data) {
 		return Nil, ErrMissingHexPrefix
@@ -109,6 +196,12 @@ func unmarshal32(data []byte) ([32]byte, error) {
 	return [32]byte(decoded), nil
 }
 
+// isNilBytes returns whether the given data is the 32 zero bytes of Nil,
+// which is the binary encoding of the zero value of all identifiers
+func isNilBytes(data []byte) bool {
+	return bytes.Equal(data, Nil[:])
+}
+
 // must is correctness enforcer for error handling.
 // For use in functions that should never return an error.
 // Panics if an error is encountered.
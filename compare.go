@@ -0,0 +1,126 @@
+package identifiers
+
+import (
+	"bytes"
+)
+
+// Compare compares two identifiers of the same type lexicographically by their bytes, which matches
+// the order in which they are stored by key-value databases. Returns -1 if a < b, 0 if a == b and +1 if a > b.
+// It can be used with generic sorting and searching helpers, such as slices.SortFunc(ids, Compare[AssetID]).
+func Compare[T ~[32]byte](a, b T) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// Compare compares the Identifier with another lexicographically by their bytes.
+// Returns -1 if id < other, 0 if id == other and +1 if id > other.
+// The method expression Identifier.Compare can be used with slices.SortFunc.
+func (id Identifier) Compare(other Identifier) int { return Compare(id, other) }
+
+// Less returns if the Identifier is ordered before another, as described in Identifier.Compare
+func (id Identifier) Less(other Identifier) bool { return Compare(id, other) < 0 }
+
+// Equal returns if the Identifier is equal to another
+func (id Identifier) Equal(other Identifier) bool { return id == other }
+
+// Compare compares the ParticipantID with another lexicographically by their bytes, as described in Compare
+func (participant ParticipantID) Compare(other ParticipantID) int { return Compare(participant, other) }
+
+// Less returns if the ParticipantID is ordered before another, as described in ParticipantID.Compare
+func (participant ParticipantID) Less(other ParticipantID) bool {
+	return Compare(participant, other) < 0
+}
+
+// Equal returns if the ParticipantID is equal to another
+func (participant ParticipantID) Equal(other ParticipantID) bool { return participant == other }
+
+// Compare compares the AssetID with another lexicographically by their bytes, as described in Compare
+func (asset AssetID) Compare(other AssetID) int { return Compare(asset, other) }
+
+// Less returns if the AssetID is ordered before another, as described in AssetID.Compare
+func (asset AssetID) Less(other AssetID) bool { return Compare(asset, other) < 0 }
+
+// Equal returns if the AssetID is equal to another
+func (asset AssetID) Equal(other AssetID) bool { return asset == other }
+
+// Compare compares the LogicID with another lexicographically by their bytes, as described in Compare
+func (logic LogicID) Compare(other LogicID) int { return Compare(logic, other) }
+
+// Less returns if the LogicID is ordered before another, as described in LogicID.Compare
+func (logic LogicID) Less(other LogicID) bool { return Compare(logic, other) < 0 }
+
+// Equal returns if the LogicID is equal to another
+func (logic LogicID) Equal(other LogicID) bool { return logic == other }
+
+// Compare compares the InteractionID with another lexicographically by their bytes, as described in Compare
+func (interaction InteractionID) Compare(other InteractionID) int { return Compare(interaction, other) }
+
+// Less returns if the InteractionID is ordered before another, as described in InteractionID.Compare
+func (interaction InteractionID) Less(other InteractionID) bool {
+	return Compare(interaction, other) < 0
+}
+
+// Equal returns if the InteractionID is equal to another
+func (interaction InteractionID) Equal(other InteractionID) bool { return interaction == other }
+
+// Compare compares the TesseractID with another lexicographically by their bytes, as described in Compare
+func (tesseract TesseractID) Compare(other TesseractID) int { return Compare(tesseract, other) }
+
+// Less returns if the TesseractID is ordered before another, as described in TesseractID.Compare
+func (tesseract TesseractID) Less(other TesseractID) bool { return Compare(tesseract, other) < 0 }
+
+// Equal returns if the TesseractID is equal to another
+func (tesseract TesseractID) Equal(other TesseractID) bool { return tesseract == other }
+
+// Compare compares the GroupID with another lexicographically by their bytes, as described in Compare
+func (group GroupID) Compare(other GroupID) int { return Compare(group, other) }
+
+// Less returns if the GroupID is ordered before another, as described in GroupID.Compare
+func (group GroupID) Less(other GroupID) bool { return Compare(group, other) < 0 }
+
+// Equal returns if the GroupID is equal to another
+func (group GroupID) Equal(other GroupID) bool { return group == other }
+
+// Compare compares the FileID with another lexicographically by their bytes, as described in Compare
+func (file FileID) Compare(other FileID) int { return Compare(file, other) }
+
+// Less returns if the FileID is ordered before another, as described in FileID.Compare
+func (file FileID) Less(other FileID) bool { return Compare(file, other) < 0 }
+
+// Equal returns if the FileID is equal to another
+func (file FileID) Equal(other FileID) bool { return file == other }
+
+// Compare compares the ReceiptID with another lexicographically by their bytes, as described in Compare
+func (receipt ReceiptID) Compare(other ReceiptID) int { return Compare(receipt, other) }
+
+// Less returns if the ReceiptID is ordered before another, as described in ReceiptID.Compare
+func (receipt ReceiptID) Less(other ReceiptID) bool { return Compare(receipt, other) < 0 }
+
+// Equal returns if the ReceiptID is equal to another
+func (receipt ReceiptID) Equal(other ReceiptID) bool { return receipt == other }
+
+// Compare compares the TopicID with another lexicographically by their bytes, as described in Compare
+func (topic TopicID) Compare(other TopicID) int { return Compare(topic, other) }
+
+// Less returns if the TopicID is ordered before another, as described in TopicID.Compare
+func (topic TopicID) Less(other TopicID) bool { return Compare(topic, other) < 0 }
+
+// Equal returns if the TopicID is equal to another
+func (topic TopicID) Equal(other TopicID) bool { return topic == other }
+
+// Compare compares the KeyID with another lexicographically by their bytes, as described in Compare
+func (key KeyID) Compare(other KeyID) int { return Compare(key, other) }
+
+// Less returns if the KeyID is ordered before another, as described in KeyID.Compare
+func (key KeyID) Less(other KeyID) bool { return Compare(key, other) < 0 }
+
+// Equal returns if the KeyID is equal to another
+func (key KeyID) Equal(other KeyID) bool { return key == other }
+
+// Compare compares the DomainID with another lexicographically by their bytes, as described in Compare
+func (domain DomainID) Compare(other DomainID) int { return Compare(domain, other) }
+
+// Less returns if the DomainID is ordered before another, as described in DomainID.Compare
+func (domain DomainID) Less(other DomainID) bool { return Compare(domain, other) < 0 }
+
+// Equal returns if the DomainID is equal to another
+func (domain DomainID) Equal(other DomainID) bool { return domain == other }
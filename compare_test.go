@@ -0,0 +1,122 @@
+package identifiers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	low, high := Identifier{0x01, 31: 0xFF}, Identifier{0x01, 30: 0x01}
+
+	assert.Equal(t, -1, Compare(low, high))
+	assert.Equal(t, 1, Compare(high, low))
+	assert.Equal(t, 0, Compare(low, low))
+
+	t.Run("SortFunc", func(t *testing.T) {
+		assets := []AssetID{AssetID(high), AssetID(low), AssetID(Nil)}
+
+		slices.SortFunc(assets, Compare[AssetID])
+		assert.Equal(t, []AssetID{AssetID(Nil), AssetID(low), AssetID(high)}, assets)
+
+		slices.SortFunc(assets, func(a, b AssetID) int { return b.Compare(a) })
+		assert.Equal(t, []AssetID{AssetID(high), AssetID(low), AssetID(Nil)}, assets)
+
+		assert.True(t, slices.IsSortedFunc([]Identifier{low, high}, Identifier.Compare))
+	})
+}
+
+func TestCompareMethods(t *testing.T) {
+	low, high := Identifier{0x01, 31: 0x01}, Identifier{0x01, 31: 0x02}
+
+	tests := []struct {
+		name    string
+		compare func(a, b Identifier) int
+		less    func(a, b Identifier) bool
+		equal   func(a, b Identifier) bool
+	}{
+		{"Identifier", Identifier.Compare, Identifier.Less, Identifier.Equal},
+		{
+			"ParticipantID",
+			func(a, b Identifier) int { return ParticipantID(a).Compare(ParticipantID(b)) },
+			func(a, b Identifier) bool { return ParticipantID(a).Less(ParticipantID(b)) },
+			func(a, b Identifier) bool { return ParticipantID(a).Equal(ParticipantID(b)) },
+		},
+		{
+			"AssetID",
+			func(a, b Identifier) int { return AssetID(a).Compare(AssetID(b)) },
+			func(a, b Identifier) bool { return AssetID(a).Less(AssetID(b)) },
+			func(a, b Identifier) bool { return AssetID(a).Equal(AssetID(b)) },
+		},
+		{
+			"LogicID",
+			func(a, b Identifier) int { return LogicID(a).Compare(LogicID(b)) },
+			func(a, b Identifier) bool { return LogicID(a).Less(LogicID(b)) },
+			func(a, b Identifier) bool { return LogicID(a).Equal(LogicID(b)) },
+		},
+		{
+			"InteractionID",
+			func(a, b Identifier) int { return InteractionID(a).Compare(InteractionID(b)) },
+			func(a, b Identifier) bool { return InteractionID(a).Less(InteractionID(b)) },
+			func(a, b Identifier) bool { return InteractionID(a).Equal(InteractionID(b)) },
+		},
+		{
+			"TesseractID",
+			func(a, b Identifier) int { return TesseractID(a).Compare(TesseractID(b)) },
+			func(a, b Identifier) bool { return TesseractID(a).Less(TesseractID(b)) },
+			func(a, b Identifier) bool { return TesseractID(a).Equal(TesseractID(b)) },
+		},
+		{
+			"GroupID",
+			func(a, b Identifier) int { return GroupID(a).Compare(GroupID(b)) },
+			func(a, b Identifier) bool { return GroupID(a).Less(GroupID(b)) },
+			func(a, b Identifier) bool { return GroupID(a).Equal(GroupID(b)) },
+		},
+		{
+			"FileID",
+			func(a, b Identifier) int { return FileID(a).Compare(FileID(b)) },
+			func(a, b Identifier) bool { return FileID(a).Less(FileID(b)) },
+			func(a, b Identifier) bool { return FileID(a).Equal(FileID(b)) },
+		},
+		{
+			"ReceiptID",
+			func(a, b Identifier) int { return ReceiptID(a).Compare(ReceiptID(b)) },
+			func(a, b Identifier) bool { return ReceiptID(a).Less(ReceiptID(b)) },
+			func(a, b Identifier) bool { return ReceiptID(a).Equal(ReceiptID(b)) },
+		},
+		{
+			"TopicID",
+			func(a, b Identifier) int { return TopicID(a).Compare(TopicID(b)) },
+			func(a, b Identifier) bool { return TopicID(a).Less(TopicID(b)) },
+			func(a, b Identifier) bool { return TopicID(a).Equal(TopicID(b)) },
+		},
+		{
+			"KeyID",
+			func(a, b Identifier) int { return KeyID(a).Compare(KeyID(b)) },
+			func(a, b Identifier) bool { return KeyID(a).Less(KeyID(b)) },
+			func(a, b Identifier) bool { return KeyID(a).Equal(KeyID(b)) },
+		},
+		{
+			"DomainID",
+			func(a, b Identifier) int { return DomainID(a).Compare(DomainID(b)) },
+			func(a, b Identifier) bool { return DomainID(a).Less(DomainID(b)) },
+			func(a, b Identifier) bool { return DomainID(a).Equal(DomainID(b)) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, -1, tt.compare(low, high))
+			assert.Equal(t, 1, tt.compare(high, low))
+			assert.Equal(t, 0, tt.compare(low, low))
+
+			assert.True(t, tt.less(low, high))
+			assert.False(t, tt.less(high, low))
+			assert.False(t, tt.less(low, low))
+
+			assert.True(t, tt.equal(low, low))
+			assert.False(t, tt.equal(low, high))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package identifiers
+
+import (
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Identifiers can be encoded with Crockford's Base32 for support workflows where identifiers are read
+// aloud or typed manually. The alphabet excludes the ambiguous letters I, L, O and U, and decoding is
+// case-insensitive and treats I and L as 1 and O as 0. Encoded identifiers can optionally be split into
+// groups separated by hyphens, which are ignored when decoding.
+
+// crockfordEncoding is the Crockford Base32 encoding without padding
+var crockfordEncoding = base32.NewEncoding("0123456789ABCDEFGHJKMNPQRSTVWXYZ").WithPadding(base32.NoPadding)
+
+// crockfordNormalizer normalizes a Crockford Base32 string for decoding
+var crockfordNormalizer = strings.NewReplacer("-", "", "I", "1", "L", "1", "O", "0")
+
+// Crockford returns the Identifier as a Crockford Base32 string of 52 characters
+func (id Identifier) Crockford() string { return crockfordEncoding.EncodeToString(id[:]) }
+
+// CrockfordGrouped returns the Identifier as a Crockford Base32 string,
+// split into groups of the given size that are separated by hyphens.
+// The last group is shorter if the size does not divide the length of 52 characters.
+func (id Identifier) CrockfordGrouped(size int) string {
+	encoded := id.Crockford()
+	if size <= 0 || size >= len(encoded) {
+		return encoded
+	}
+
+	var builder strings.Builder
+
+	builder.Grow(len(encoded) + len(encoded)/size)
+
+	for i := 0; i < len(encoded); i += size {
+		if i > 0 {
+			builder.WriteByte('-')
+		}
+
+		builder.WriteString(encoded[i:min(i+size, len(encoded))])
+	}
+
+	return builder.String()
+}
+
+// ParseCrockford decodes the given Crockford Base32 string into an Identifier.
+// Decoding is case-insensitive, ignores hyphens and treats I and L as 1 and O as 0.
+// The decoded Identifier must be valid, see Identifier.Validate
+func ParseCrockford(data string) (Identifier, error) {
+	normalized := crockfordNormalizer.Replace(strings.ToUpper(data))
+
+	decoded, err := crockfordEncoding.DecodeString(normalized)
+	if err != nil {
+		return Nil, fmt.Errorf("invalid crockford base32: %w", err)
+	}
+
+	// Check length of the data
+	if len(decoded) != 32 {
+		return Nil, errors.New("invalid length: identifier must be 32 bytes")
+	}
+
+	id := Identifier(decoded)
+
+	// Reject non-canonical encodings with non-zero padding bits
+	if id.Crockford() != normalized {
+		return Nil, errors.New("invalid crockford base32: non-zero padding bits")
+	}
+
+	if err = id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,77 @@
+package identifiers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_Crockford(t *testing.T) {
+	id := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000abcdef")
+
+	encoded := id.Crockford()
+	require.Equal(t, "04000000000000000000000000000000000000000000005BSQQG", encoded)
+
+	decoded, err := ParseCrockford(encoded)
+	require.NoError(t, err)
+	require.Equal(t, id, decoded)
+
+	t.Run("Grouped", func(t *testing.T) {
+		grouped := id.CrockfordGrouped(8)
+		require.Equal(t, "04000000-00000000-00000000-00000000-00000000-0000005B-SQQG", grouped)
+
+		decoded, err := ParseCrockford(grouped)
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+
+		require.Equal(t, encoded, id.CrockfordGrouped(0))
+		require.Equal(t, encoded, id.CrockfordGrouped(52))
+		require.Len(t, id.CrockfordGrouped(4), 52+12)
+	})
+}
+
+func TestParseCrockford(t *testing.T) {
+	id := RandomAssetIDv0().AsIdentifier()
+	encoded := id.CrockfordGrouped(4)
+
+	t.Run("Lenient", func(t *testing.T) {
+		decoded, err := ParseCrockford(strings.ToLower(encoded))
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+
+		// Ambiguous characters are mapped to their digits
+		expected := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000abcdef")
+
+		decoded, err = ParseCrockford("o4OOOOOO-OOOOOOOO-OOOOOOOO-OOOOOOOO-OOOOOOOO-OOOOOO5B-SQQG")
+		require.NoError(t, err)
+		require.Equal(t, expected, decoded)
+
+		expected[3] = 0x04
+
+		decoded, err = ParseCrockford("04000i00000000000000000000000000000000000000005BSQQG")
+		require.NoError(t, err)
+		require.Equal(t, expected, decoded)
+
+		decoded, err = ParseCrockford("04000L00000000000000000000000000000000000000005BSQQG")
+		require.NoError(t, err)
+		require.Equal(t, expected, decoded)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseCrockford("04U0")
+		require.ErrorContains(t, err, "invalid crockford base32: illegal base32 data")
+
+		_, err = ParseCrockford("0400")
+		require.EqualError(t, err, "invalid length: identifier must be 32 bytes")
+
+		_, err = ParseCrockford("04000000000000000000000000000000000000000000005BSQQH")
+		require.EqualError(t, err, "invalid crockford base32: non-zero padding bits")
+
+		invalid := id
+		invalid[0] = 0xF0
+
+		_, err = ParseCrockford(invalid.Crockford())
+		require.ErrorContains(t, err, "invalid tag: unsupported tag kind")
+	})
+}
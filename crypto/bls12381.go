@@ -0,0 +1,225 @@
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+const (
+	// BLSCompressedLength is the length of a compressed BLS12-381 public key (G1 point)
+	BLSCompressedLength = 48
+	// BLSUncompressedLength is the length of an uncompressed BLS12-381 public key (G1 point)
+	BLSUncompressedLength = 96
+
+	// blsDomain is the domain for fingerprints derived from BLS12-381 public keys
+	blsDomain = "moi.bls12381"
+
+	// The flags in the most-significant bits of an encoded point
+	blsFlagCompressed = 0x80
+	blsFlagInfinity   = 0x40
+	blsFlagSign       = 0x20
+	blsFlagMask       = blsFlagCompressed | blsFlagInfinity | blsFlagSign
+)
+
+var (
+	// blsP is the prime of the field of the BLS12-381 curve
+	blsP, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf"+
+		"6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	// blsR is the order of the G1 subgroup of the BLS12-381 curve
+	blsR, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	// blsSqrtExp is the exponent for square roots in the field of the curve, (p + 1) / 4
+	blsSqrtExp = new(big.Int).Rsh(new(big.Int).Add(blsP, big.NewInt(1)), 2)
+	// blsHalfP is (p - 1) / 2, which separates the lexicographically smaller and larger y-coordinates
+	blsHalfP = new(big.Int).Rsh(blsP, 1)
+)
+
+// blsPoint is an affine point on the G1 curve of BLS12-381. A nil point is the point at infinity.
+type blsPoint struct {
+	x, y *big.Int
+}
+
+// BLSFingerprint derives the account fingerprint from the given BLS12-381 public key, as used by
+// validator and guardian identities. The key is a G1 point in the compressed (48 bytes) or uncompressed
+// (96 bytes) ZCash serialization format, as found in validator keystores. Both encodings of the same key
+// produce the same fingerprint, which is derived from its compressed encoding. Returns ErrInvalidPublicKey
+// if the key is malformed, the point at infinity, or not a point in the G1 subgroup.
+func BLSFingerprint(key []byte) ([24]byte, error) {
+	compressed, err := CompressBLS(key)
+	if err != nil {
+		return [24]byte{}, err
+	}
+
+	return hashFingerprint(blsDomain, compressed[:]), nil
+}
+
+// BLSFingerprints derives the account fingerprints of the given BLS12-381 public keys (see BLSFingerprint).
+// Returns the fingerprints and their errors at the same positions as the keys,
+// with a nil error slice if all fingerprints were derived successfully.
+func BLSFingerprints(keys [][]byte) ([][24]byte, []error) {
+	var (
+		fingerprints = make([][24]byte, len(keys))
+		errs         []error
+	)
+
+	for position, key := range keys {
+		fingerprint, err := BLSFingerprint(key)
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(keys))
+			}
+
+			errs[position] = err
+
+			continue
+		}
+
+		fingerprints[position] = fingerprint
+	}
+
+	return fingerprints, errs
+}
+
+// BLSParticipantID derives the v0 ParticipantID with a zero variant for the given BLS12-381
+// public key (see BLSFingerprint), with the given flags set.
+func BLSParticipantID(key []byte, flags ...identifiers.Flag) (identifiers.ParticipantID, error) {
+	fingerprint, err := BLSFingerprint(key)
+	if err != nil {
+		return identifiers.Nil, err
+	}
+
+	return identifiers.GenerateParticipantIDv0(fingerprint, 0, flags...)
+}
+
+// CompressBLS returns the compressed encoding of the given BLS12-381 public key, which can be
+// in any of the encodings accepted by BLSFingerprint. Returns ErrInvalidPublicKey if the key
+// is malformed, the point at infinity, or not a point in the G1 subgroup.
+func CompressBLS(key []byte) ([BLSCompressedLength]byte, error) {
+	var compressed [BLSCompressedLength]byte
+
+	point, err := decodeBLSPoint(key)
+	if err != nil {
+		return compressed, err
+	}
+
+	// Check that the point is in the G1 subgroup
+	if point.mul(blsR) != nil {
+		return compressed, fmt.Errorf("%w: point is not in the G1 subgroup", ErrInvalidPublicKey)
+	}
+
+	point.x.FillBytes(compressed[:])
+	compressed[0] |= blsFlagCompressed
+
+	if point.y.Cmp(blsHalfP) > 0 {
+		compressed[0] |= blsFlagSign
+	}
+
+	return compressed, nil
+}
+
+// decodeBLSPoint decodes a G1 point from its compressed or uncompressed encoding
+// and checks that it is on the curve. The point at infinity is rejected.
+func decodeBLSPoint(key []byte) (*blsPoint, error) {
+	if len(key) != BLSCompressedLength && len(key) != BLSUncompressedLength {
+		return nil, fmt.Errorf("%w: malformed bls key of %d bytes", ErrInvalidPublicKey, len(key))
+	}
+
+	flags := key[0] & blsFlagMask
+	if flags&blsFlagInfinity != 0 {
+		return nil, fmt.Errorf("%w: point at infinity", ErrInvalidPublicKey)
+	}
+
+	// The compression flag must match the length, and uncompressed points have no sign
+	if (len(key) == BLSCompressedLength) != (flags&blsFlagCompressed != 0) ||
+		(len(key) == BLSUncompressedLength && flags&blsFlagSign != 0) {
+		return nil, fmt.Errorf("%w: invalid bls encoding flags", ErrInvalidPublicKey)
+	}
+
+	x := new(big.Int).SetBytes(append([]byte{key[0] &^ blsFlagMask}, key[1:BLSCompressedLength]...))
+	if x.Cmp(blsP) >= 0 {
+		return nil, fmt.Errorf("%w: coordinate out of range", ErrInvalidPublicKey)
+	}
+
+	var y *big.Int
+
+	if len(key) == BLSCompressedLength {
+		// Recover the y-coordinate and select the root indicated by the sign flag
+		y = new(big.Int).Exp(blsCurve(x), blsSqrtExp, blsP)
+		if (y.Cmp(blsHalfP) > 0) != (flags&blsFlagSign != 0) {
+			y.Sub(blsP, y)
+		}
+	} else {
+		y = new(big.Int).SetBytes(key[BLSCompressedLength:])
+		if y.Cmp(blsP) >= 0 {
+			return nil, fmt.Errorf("%w: coordinate out of range", ErrInvalidPublicKey)
+		}
+	}
+
+	if new(big.Int).Exp(y, big.NewInt(2), blsP).Cmp(blsCurve(x)) != 0 {
+		return nil, fmt.Errorf("%w: point is not on the bls12-381 curve", ErrInvalidPublicKey)
+	}
+
+	return &blsPoint{x: x, y: y}, nil
+}
+
+// blsCurve returns x³ + 4 mod p, which is y² for a point on the G1 curve of BLS12-381
+func blsCurve(x *big.Int) *big.Int {
+	result := new(big.Int).Exp(x, big.NewInt(3), blsP)
+	result.Add(result, big.NewInt(4))
+
+	return result.Mod(result, blsP)
+}
+
+// add returns the sum of the point and the other point
+func (point *blsPoint) add(other *blsPoint) *blsPoint {
+	switch {
+	case point == nil:
+		return other
+	case other == nil:
+		return point
+	}
+
+	slope := new(big.Int)
+
+	if point.x.Cmp(other.x) == 0 {
+		// The sum of a point and its negation is the point at infinity
+		if sum := new(big.Int).Add(point.y, other.y); sum.Mod(sum, blsP).Sign() == 0 {
+			return nil
+		}
+
+		// Doubling: slope = 3x² / 2y
+		slope.Mul(point.x, point.x).Mul(slope, big.NewInt(3))
+		slope.Mul(slope, new(big.Int).ModInverse(new(big.Int).Lsh(point.y, 1), blsP))
+	} else {
+		// Addition: slope = (y2 - y1) / (x2 - x1)
+		denominator := new(big.Int).Sub(other.x, point.x)
+		denominator.Mod(denominator, blsP)
+
+		slope.Sub(other.y, point.y).Mul(slope, denominator.ModInverse(denominator, blsP))
+	}
+
+	slope.Mod(slope, blsP)
+
+	x := new(big.Int).Mul(slope, slope)
+	x.Sub(x, point.x).Sub(x, other.x).Mod(x, blsP)
+
+	y := new(big.Int).Sub(point.x, x)
+	y.Mul(y, slope).Sub(y, point.y).Mod(y, blsP)
+
+	return &blsPoint{x: x, y: y}
+}
+
+// mul returns the product of the point and the given scalar, with the double-and-add method
+func (point *blsPoint) mul(scalar *big.Int) *blsPoint {
+	var result *blsPoint
+
+	for index := scalar.BitLen() - 1; index >= 0; index-- {
+		result = result.add(result)
+		if scalar.Bit(index) == 1 {
+			result = result.add(point)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,164 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// blsVectors are test vectors of BLS12-381 public keys (multiples of the G1 generator) and their fingerprints
+var blsVectors = []struct {
+	compressed   string
+	uncompressed string
+	fingerprint  string
+}{
+	{
+		// Generator point G
+		compressed: "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		uncompressed: "17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb" +
+			"08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1",
+		fingerprint: "444bc255c63d647a26ae252f83105f31723977c579f3930e",
+	},
+	{
+		// Point 2G
+		compressed: "a572cbea904d67468808c8eb50a9450c9721db309128012543902d0ac358a62ae28f75bb8f1c7c42c39a8c5529bf0f4e",
+		uncompressed: "0572cbea904d67468808c8eb50a9450c9721db309128012543902d0ac358a62ae28f75bb8f1c7c42c39a8c5529bf0f4e" +
+			"166a9d8cabc673a322fda673779d8e3822ba3ecb8670e461f73bb9021d5fd76a4c56d9d4cd16bd1bba86881979749d28",
+		fingerprint: "f08246b741ed860a47719c2f366c5c9a76d23233665ed434",
+	},
+	{
+		// Point 12345G
+		compressed: "8530c1bdc4cd6b1408be0933c4a41ac3513350eef36850b804708e1f338932ce01b655a163344a4500b281c8750c461f",
+		uncompressed: "0530c1bdc4cd6b1408be0933c4a41ac3513350eef36850b804708e1f338932ce01b655a163344a4500b281c8750c461f" +
+			"0038e76f31b5aef9d7c8f1616d2446fb1f0380aca586b9268a115e8d191c5e47ed04c4b77b72394740025706845c9cb7",
+		fingerprint: "c10fa88bb6049f7102963788372d38a95a1ddb866ed4ef03",
+	},
+}
+
+func TestBLSFingerprint(t *testing.T) {
+	for _, vector := range blsVectors {
+		compressed, _ := hex.DecodeString(vector.compressed)
+		uncompressed, _ := hex.DecodeString(vector.uncompressed)
+
+		// Both encodings of the key must produce the same fingerprint
+		for _, key := range [][]byte{compressed, uncompressed} {
+			fingerprint, err := BLSFingerprint(key)
+			require.NoError(t, err)
+			assert.Equal(t, vector.fingerprint, hex.EncodeToString(fingerprint[:]))
+
+			converted, err := CompressBLS(key)
+			require.NoError(t, err)
+			assert.Equal(t, compressed, converted[:])
+		}
+	}
+}
+
+func TestBLSFingerprint_Invalid(t *testing.T) {
+	decode := func(data string) []byte {
+		decoded, _ := hex.DecodeString(data)
+		return decoded
+	}
+
+	generator := decode(blsVectors[0].uncompressed)
+
+	// modify returns a copy of the uncompressed generator with the given byte replaced
+	modify := func(index int, value byte) []byte {
+		modified := append([]byte(nil), generator...)
+		modified[index] = value
+
+		return modified
+	}
+
+	// Encodings of the field prime, which is out of range as a coordinate
+	prime := blsP.FillBytes(make([]byte, 48))
+	compressedPrime := append([]byte{prime[0] | blsFlagCompressed}, prime[1:]...)
+
+	tests := []struct {
+		key []byte
+		err string
+	}{
+		{nil, "invalid public key: malformed bls key of 0 bytes"},
+		{decode("c0" + blsVectors[0].compressed[2:]), "invalid public key: point at infinity"},
+		{generator[:48], "invalid public key: invalid bls encoding flags"},
+		{modify(0, 0x97), "invalid public key: invalid bls encoding flags"},
+		{modify(0, 0x37), "invalid public key: invalid bls encoding flags"},
+		{compressedPrime, "invalid public key: coordinate out of range"},
+		{append(generator[:48:48], prime...), "invalid public key: coordinate out of range"},
+		{modify(95, 0xe2), "invalid public key: point is not on the bls12-381 curve"},
+		{decode("80" + "0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001"),
+			"invalid public key: point is not on the bls12-381 curve"},
+		{decode("80" + "0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004"),
+			"invalid public key: point is not in the G1 subgroup"},
+	}
+
+	for _, test := range tests {
+		_, err := BLSFingerprint(test.key)
+		require.ErrorIs(t, err, ErrInvalidPublicKey)
+		require.EqualError(t, err, test.err)
+	}
+}
+
+func TestBLSFingerprints(t *testing.T) {
+	keys := make([][]byte, len(blsVectors))
+	for index, vector := range blsVectors {
+		keys[index], _ = hex.DecodeString(vector.compressed)
+	}
+
+	fingerprints, errs := BLSFingerprints(keys)
+	require.Nil(t, errs)
+
+	for index, vector := range blsVectors {
+		assert.Equal(t, vector.fingerprint, hex.EncodeToString(fingerprints[index][:]))
+	}
+
+	fingerprints, errs = BLSFingerprints([][]byte{keys[0], nil, keys[1]})
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], ErrInvalidPublicKey)
+	require.NoError(t, errs[2])
+	assert.Equal(t, [24]byte{}, fingerprints[1])
+}
+
+func TestBLSParticipantID(t *testing.T) {
+	key, _ := hex.DecodeString(blsVectors[0].compressed)
+
+	participant, err := BLSParticipantID(key)
+	require.NoError(t, err)
+	require.NoError(t, participant.Validate())
+
+	fingerprint, _ := BLSFingerprint(key)
+	assert.Equal(t, fingerprint, participant.Fingerprint())
+
+	_, err = BLSParticipantID(nil, identifiers.Systemic)
+	require.ErrorIs(t, err, ErrInvalidPublicKey)
+}
+
+func TestBLSPoint(t *testing.T) {
+	generator, err := decodeBLSPoint(must(hex.DecodeString(blsVectors[0].uncompressed)))
+	require.NoError(t, err)
+
+	// The sum of a point and its negation is the point at infinity
+	negation := &blsPoint{x: generator.x, y: new(big.Int).Sub(blsP, generator.y)}
+	assert.Nil(t, generator.add(negation))
+	assert.Nil(t, generator.mul(blsR))
+	assert.Equal(t, generator, generator.add(nil))
+
+	// Doubling and addition must agree with scalar multiplication
+	double := generator.add(generator)
+	assert.Equal(t, generator.mul(big.NewInt(3)), double.add(generator))
+	assert.Equal(t, double, generator.mul(big.NewInt(2)))
+}
+
+// must panics if the given error is not nil, and returns the value otherwise
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
@@ -0,0 +1,19 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"errors"
+)
+
+// ErrInvalidPublicKey is returned when a public key cannot be parsed or is not a valid point on its curve
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// hashFingerprint derives a 24-byte fingerprint by hashing the given domain and public key with SHA-256.
+// The fingerprint is the first 24 bytes of the resulting digest.
+func hashFingerprint(domain string, key []byte) [24]byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(domain))
+	hasher.Write(key)
+
+	return [24]byte(hasher.Sum(nil)[:24])
+}
@@ -0,0 +1,7 @@
+// Package crypto implements the derivation of account fingerprints from public keys, so that wallets
+// and other tooling can compute the identifiers of their accounts deterministically from their keys.
+//
+// Fingerprints are derived by hashing a domain string for the key type, followed by the canonical
+// encoding of the public key, with SHA-256. The fingerprint is the first 24 bytes of the digest.
+// Public keys are checked to be valid points on their curve before they are hashed.
+package crypto
@@ -0,0 +1,101 @@
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+const (
+	// Secp256k1CompressedLength is the length of a compressed secp256k1 public key
+	Secp256k1CompressedLength = 33
+	// Secp256k1UncompressedLength is the length of an uncompressed secp256k1 public key
+	Secp256k1UncompressedLength = 65
+
+	// secp256k1Domain is the domain for fingerprints derived from secp256k1 public keys
+	secp256k1Domain = "moi.secp256k1"
+)
+
+var (
+	// secp256k1P is the prime of the field of the secp256k1 curve
+	secp256k1P, _ = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 16)
+	// secp256k1SqrtExp is the exponent for square roots in the field of the curve, (p + 1) / 4
+	secp256k1SqrtExp = new(big.Int).Rsh(new(big.Int).Add(secp256k1P, big.NewInt(1)), 2)
+)
+
+// Secp256k1Fingerprint derives the account fingerprint from the given secp256k1 public key, as used by
+// EVM-compatible wallets. The key can be compressed (33 bytes), uncompressed (65 bytes) or uncompressed
+// without its 0x04 prefix (64 bytes). All encodings of the same key produce the same fingerprint, which
+// is derived from its compressed encoding. Returns ErrInvalidPublicKey if the key is not a valid point.
+func Secp256k1Fingerprint(key []byte) ([24]byte, error) {
+	compressed, err := CompressSecp256k1(key)
+	if err != nil {
+		return [24]byte{}, err
+	}
+
+	return hashFingerprint(secp256k1Domain, compressed[:]), nil
+}
+
+// Secp256k1ParticipantID derives the v0 ParticipantID with a zero variant for the given secp256k1
+// public key (see Secp256k1Fingerprint), with the given flags set.
+func Secp256k1ParticipantID(key []byte, flags ...identifiers.Flag) (identifiers.ParticipantID, error) {
+	fingerprint, err := Secp256k1Fingerprint(key)
+	if err != nil {
+		return identifiers.Nil, err
+	}
+
+	return identifiers.GenerateParticipantIDv0(fingerprint, 0, flags...)
+}
+
+// CompressSecp256k1 returns the compressed encoding of the given secp256k1 public key,
+// which can be in any of the encodings accepted by Secp256k1Fingerprint.
+// Returns ErrInvalidPublicKey if the key is not a valid point on the curve.
+func CompressSecp256k1(key []byte) ([Secp256k1CompressedLength]byte, error) {
+	var compressed [Secp256k1CompressedLength]byte
+
+	switch {
+	case len(key) == Secp256k1CompressedLength && (key[0] == 0x02 || key[0] == 0x03):
+		// Decompress the key to check that it is on the curve
+		x := new(big.Int).SetBytes(key[1:])
+		if x.Cmp(secp256k1P) >= 0 {
+			return compressed, fmt.Errorf("%w: coordinate out of range", ErrInvalidPublicKey)
+		}
+
+		y := new(big.Int).Exp(secp256k1Curve(x), secp256k1SqrtExp, secp256k1P)
+		if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(secp256k1Curve(x)) != 0 {
+			return compressed, fmt.Errorf("%w: point is not on the secp256k1 curve", ErrInvalidPublicKey)
+		}
+
+		return [Secp256k1CompressedLength]byte(key), nil
+
+	case len(key) == Secp256k1UncompressedLength && key[0] == 0x04:
+		key = key[1:]
+
+	case len(key) != Secp256k1UncompressedLength-1:
+		return compressed, fmt.Errorf("%w: malformed secp256k1 key of %d bytes", ErrInvalidPublicKey, len(key))
+	}
+
+	x, y := new(big.Int).SetBytes(key[:32]), new(big.Int).SetBytes(key[32:])
+	if x.Cmp(secp256k1P) >= 0 || y.Cmp(secp256k1P) >= 0 {
+		return compressed, fmt.Errorf("%w: coordinate out of range", ErrInvalidPublicKey)
+	}
+
+	if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(secp256k1Curve(x)) != 0 {
+		return compressed, fmt.Errorf("%w: point is not on the secp256k1 curve", ErrInvalidPublicKey)
+	}
+
+	// The prefix of the compressed key encodes the parity of the y-coordinate
+	compressed[0] = 0x02 | byte(y.Bit(0))
+	copy(compressed[1:], key[:32])
+
+	return compressed, nil
+}
+
+// secp256k1Curve returns x³ + 7 mod p, which is y² for a point on the secp256k1 curve
+func secp256k1Curve(x *big.Int) *big.Int {
+	result := new(big.Int).Exp(x, big.NewInt(3), secp256k1P)
+	result.Add(result, big.NewInt(7))
+
+	return result.Mod(result, secp256k1P)
+}
@@ -0,0 +1,98 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+func TestSecp256k1Fingerprint(t *testing.T) {
+	tests := []struct {
+		compressed   string
+		uncompressed string
+		fingerprint  string
+	}{
+		{
+			// Generator point G
+			compressed: "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			uncompressed: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+				"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+			fingerprint: "58cf4d75d630bde7f0bc5f7f9e41233932c3aa45cdac10be",
+		},
+		{
+			// Point 2G
+			compressed: "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+			uncompressed: "04c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5" +
+				"1ae168fea63dc339a3c58419466ceaeef7f632653266d0e1236431a950cfe52a",
+			fingerprint: "45d3a55e516d4ef044d0b7aa3bb64be844474f1b9def1e76",
+		},
+	}
+
+	for _, test := range tests {
+		compressed, _ := hex.DecodeString(test.compressed)
+		uncompressed, _ := hex.DecodeString(test.uncompressed)
+
+		// All encodings of the key must produce the same fingerprint
+		for _, key := range [][]byte{compressed, uncompressed, uncompressed[1:]} {
+			fingerprint, err := Secp256k1Fingerprint(key)
+			require.NoError(t, err)
+			assert.Equal(t, test.fingerprint, hex.EncodeToString(fingerprint[:]))
+
+			converted, err := CompressSecp256k1(key)
+			require.NoError(t, err)
+			assert.Equal(t, compressed, converted[:])
+		}
+	}
+}
+
+func TestSecp256k1Fingerprint_Invalid(t *testing.T) {
+	decode := func(data string) []byte {
+		decoded, _ := hex.DecodeString(data)
+		return decoded
+	}
+
+	tests := []struct {
+		key []byte
+		err string
+	}{
+		{nil, "invalid public key: malformed secp256k1 key of 0 bytes"},
+		{decode("0579be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+			"invalid public key: malformed secp256k1 key of 33 bytes"},
+		{decode("020000000000000000000000000000000000000000000000000000000000000005"),
+			"invalid public key: point is not on the secp256k1 curve"},
+		{decode("02fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+			"invalid public key: coordinate out of range"},
+		{decode("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+			"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b9"),
+			"invalid public key: point is not on the secp256k1 curve"},
+		{decode("04fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f" +
+			"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+			"invalid public key: coordinate out of range"},
+	}
+
+	for _, test := range tests {
+		_, err := Secp256k1Fingerprint(test.key)
+		require.ErrorIs(t, err, ErrInvalidPublicKey)
+		require.EqualError(t, err, test.err)
+	}
+}
+
+func TestSecp256k1ParticipantID(t *testing.T) {
+	key, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	participant, err := Secp256k1ParticipantID(key, identifiers.Systemic)
+	require.NoError(t, err)
+	require.NoError(t, participant.Validate())
+
+	fingerprint, _ := Secp256k1Fingerprint(key)
+	assert.Equal(t, fingerprint, participant.Fingerprint())
+	assert.Equal(t, uint32(0), participant.Variant())
+	assert.True(t, participant.Flag(identifiers.Systemic))
+
+	_, err = Secp256k1ParticipantID(nil)
+	require.ErrorIs(t, err, ErrInvalidPublicKey)
+}
@@ -0,0 +1,177 @@
+package identifiers
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamFormat is the format of a stream of identifiers read by a Decoder
+type StreamFormat int
+
+const (
+	// StreamHex is a stream of newline-delimited hex encoded identifiers (0x prefix is optional).
+	// Surrounding whitespace is trimmed from each line, and empty lines are skipped.
+	StreamHex StreamFormat = iota
+	// StreamRaw is a stream of concatenated raw 32-byte identifiers
+	StreamRaw
+)
+
+// DecoderOption is an option for NewDecoder
+type DecoderOption func(*Decoder)
+
+// WithStreamFormat sets the format of the stream read by the Decoder. Defaults to StreamHex.
+func WithStreamFormat(format StreamFormat) DecoderOption {
+	return func(decoder *Decoder) { decoder.format = format }
+}
+
+// WithKindFilter restricts the Decoder to identifiers of the given kinds.
+// Valid identifiers of other kinds are silently skipped.
+func WithKindFilter(kinds ...IdentifierKind) DecoderOption {
+	return func(decoder *Decoder) {
+		for _, kind := range kinds {
+			decoder.kinds |= 1 << (kind & 0x0F)
+		}
+	}
+}
+
+// WithErrorHandler sets a function that is called with a ValidationError for every record that cannot be
+// decoded or is invalid, where the index is the position of the record (or line) in the stream. The
+// record is skipped if the function returns true, otherwise decoding stops with the error. Errors
+// from the underlying reader are never passed to the function. Defaults to stopping at the first error.
+func WithErrorHandler(handler func(err ValidationError) bool) DecoderOption {
+	return func(decoder *Decoder) { decoder.handler = handler }
+}
+
+// Decoder reads validated identifiers from a stream of raw records or newline-delimited hex strings,
+// such as a large export piped through a command line tool. A Decoder is not safe for concurrent use,
+// and must be created with NewDecoder.
+type Decoder struct {
+	format  StreamFormat
+	kinds   uint16
+	handler func(err ValidationError) bool
+
+	reader  *bufio.Reader
+	scanner *bufio.Scanner
+	index   int
+	err     error
+}
+
+// NewDecoder creates a new Decoder that reads identifiers from the given reader
+func NewDecoder(reader io.Reader, opts ...DecoderOption) *Decoder {
+	decoder := &Decoder{}
+	for _, opt := range opts {
+		opt(decoder)
+	}
+
+	if decoder.format == StreamRaw {
+		decoder.reader = bufio.NewReader(reader)
+	} else {
+		decoder.scanner = bufio.NewScanner(reader)
+	}
+
+	return decoder
+}
+
+// Decode returns the next valid identifier in the stream that is allowed by the kind filter.
+// Returns io.EOF at the end of the stream, or a ValidationError for a record that cannot be decoded
+// or is invalid (unless it is skipped by the error handler). Once an error is returned, all
+// subsequent calls return the same error.
+func (decoder *Decoder) Decode() (Identifier, error) {
+	for decoder.err == nil {
+		id, err := decoder.next()
+		if err != nil {
+			if invalid := (ValidationError{}); errors.As(err, &invalid) {
+				if decoder.handler != nil && decoder.handler(invalid) {
+					continue
+				}
+			}
+
+			decoder.err = err
+
+			break
+		}
+
+		if decoder.kinds == 0 || decoder.kinds&(1<<id.Tag().Kind()) != 0 {
+			return id, nil
+		}
+	}
+
+	return Nil, decoder.err
+}
+
+// DecodeAll returns all remaining valid identifiers in the stream that are allowed by the kind filter.
+// Returns the identifiers decoded so far along with the error if decoding stops early.
+func (decoder *Decoder) DecodeAll() ([]Identifier, error) {
+	var ids []Identifier
+
+	for {
+		id, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			return ids, nil
+		}
+
+		if err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, id)
+	}
+}
+
+// next reads and validates the next record in the stream. Decoding and validation
+// errors are returned as a ValidationError, while errors from the reader are returned as is.
+func (decoder *Decoder) next() (Identifier, error) {
+	var (
+		id      Identifier
+		decoded error
+	)
+
+	if decoder.format == StreamRaw {
+		if _, err := io.ReadFull(decoder.reader, id[:]); err != nil {
+			return Nil, err
+		}
+	} else {
+		line, err := decoder.line()
+		if err != nil {
+			return Nil, err
+		}
+
+		if id, decoded = NewIdentifierFromHex(string(line)); decoded != nil {
+			decoded = fmt.Errorf("invalid hex: %w", decoded)
+		}
+	}
+
+	index := decoder.index
+	decoder.index++
+
+	if decoded == nil {
+		decoded = id.Validate()
+	}
+
+	if decoded != nil {
+		return Nil, ValidationError{Index: index, Err: decoded}
+	}
+
+	return id, nil
+}
+
+// line returns the next non-empty line in a hex stream with surrounding whitespace trimmed.
+// The index of the decoder is advanced for every skipped empty line.
+func (decoder *Decoder) line() ([]byte, error) {
+	for decoder.scanner.Scan() {
+		if line := bytes.TrimSpace(decoder.scanner.Bytes()); len(line) > 0 {
+			return line, nil
+		}
+
+		decoder.index++
+	}
+
+	if err := decoder.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,117 @@
+package identifiers
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder(t *testing.T) {
+	asset := RandomAssetIDv0().AsIdentifier()
+	logic := RandomLogicIDv0().AsIdentifier()
+
+	invalid := asset
+	invalid[0] = 0xF0
+
+	t.Run("Hex", func(t *testing.T) {
+		stream := strings.Join([]string{asset.Hex(), "", "  " + logic.Hex()[2:] + "\r", asset.Hex()}, "\n")
+
+		ids, err := NewDecoder(strings.NewReader(stream)).DecodeAll()
+		require.NoError(t, err)
+		assert.Equal(t, []Identifier{asset, logic, asset}, ids)
+
+		ids, err = NewDecoder(strings.NewReader("")).DecodeAll()
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("Raw", func(t *testing.T) {
+		stream := bytes.Join([][]byte{asset[:], logic[:], asset[:]}, nil)
+
+		ids, err := NewDecoder(bytes.NewReader(stream), WithStreamFormat(StreamRaw)).DecodeAll()
+		require.NoError(t, err)
+		assert.Equal(t, []Identifier{asset, logic, asset}, ids)
+
+		// Partial records
+		ids, err = NewDecoder(bytes.NewReader(stream[:40]), WithStreamFormat(StreamRaw)).DecodeAll()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Equal(t, []Identifier{asset}, ids)
+	})
+
+	t.Run("KindFilter", func(t *testing.T) {
+		stream := strings.Join([]string{asset.Hex(), logic.Hex(), asset.Hex()}, "\n")
+
+		ids, err := NewDecoder(strings.NewReader(stream), WithKindFilter(KindLogic, KindParticipant)).DecodeAll()
+		require.NoError(t, err)
+		assert.Equal(t, []Identifier{logic}, ids)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		stream := strings.Join([]string{asset.Hex(), "0x1234", "", invalid.Hex(), logic.Hex()}, "\n")
+
+		decoder := NewDecoder(strings.NewReader(stream))
+
+		id, err := decoder.Decode()
+		require.NoError(t, err)
+		require.Equal(t, asset, id)
+
+		_, err = decoder.Decode()
+		require.EqualError(t, err, "identifier 1: invalid hex: invalid length: identifier must be 32 bytes")
+
+		// Errors are sticky
+		_, err = decoder.Decode()
+		require.EqualError(t, err, "identifier 1: invalid hex: invalid length: identifier must be 32 bytes")
+
+		// Recover from invalid records
+		var skipped []ValidationError
+
+		ids, err := NewDecoder(strings.NewReader(stream), WithErrorHandler(func(err ValidationError) bool {
+			skipped = append(skipped, err)
+			return true
+		})).DecodeAll()
+
+		require.NoError(t, err)
+		assert.Equal(t, []Identifier{asset, logic}, ids)
+
+		require.Len(t, skipped, 2)
+		assert.Equal(t, 1, skipped[0].Index)
+		assert.Equal(t, 3, skipped[1].Index)
+		assert.ErrorIs(t, skipped[1], ErrUnsupportedKind)
+
+		// Stop at the second invalid record
+		ids, err = NewDecoder(strings.NewReader(stream), WithErrorHandler(func(err ValidationError) bool {
+			return err.Index < 2
+		})).DecodeAll()
+
+		require.EqualError(t, err, "identifier 3: invalid tag: unsupported tag kind")
+		assert.Equal(t, []Identifier{asset}, ids)
+
+		// Invalid raw records
+		ids, err = NewDecoder(bytes.NewReader(invalid[:]), WithStreamFormat(StreamRaw)).DecodeAll()
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("ReaderErrors", func(t *testing.T) {
+		failure := errors.New("failure")
+		handler := WithErrorHandler(func(ValidationError) bool { return true })
+
+		// Errors from the reader are not passed to the error handler
+		_, err := NewDecoder(iotest.ErrReader(failure), handler).Decode()
+		require.ErrorIs(t, err, failure)
+
+		_, err = NewDecoder(iotest.ErrReader(failure), handler, WithStreamFormat(StreamRaw)).Decode()
+		require.ErrorIs(t, err, failure)
+
+		// Lines that exceed the maximum size of the scanner
+		_, err = NewDecoder(strings.NewReader(strings.Repeat("0", 1<<17)), handler).Decode()
+		require.ErrorIs(t, err, bufio.ErrTooLong)
+	})
+}
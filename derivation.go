@@ -0,0 +1,116 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// derivationDomain is the domain for variant IDs derived along a DerivationPath
+const derivationDomain = "moi.variant"
+
+// DerivationPath is a hierarchical path of child indices, used to organize the sub-identifiers in the
+// variant space of an identifier in the same way as the derivation paths of HD wallets organize accounts.
+// A path is written as m/<index>/<index>/..., such as m/2/15, where m is the root identifier with a zero
+// variant ID and each index selects a child of the previous identifier (see Identifier.DerivePath).
+type DerivationPath []uint32
+
+// ParseDerivationPath parses the given path of the form m/<index>/<index>/... into a DerivationPath.
+// The path is normalized while parsing, so the root can be m or M, indices can have leading zeros, and
+// surrounding whitespace is ignored. Each index must be a decimal number that fits in 32 bits.
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" && segments[0] != "M" {
+		return nil, fmt.Errorf("%w: path must start with m", ErrInvalidDerivationPath)
+	}
+
+	parsed := make(DerivationPath, 0, len(segments)-1)
+
+	for _, segment := range segments[1:] {
+		// Only allow plain decimal digits, without signs or whitespace
+		if segment == "" || strings.TrimLeft(segment, "0123456789") != "" {
+			return nil, fmt.Errorf("%w: invalid index %q", ErrInvalidDerivationPath, segment)
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: index %s is out of range", ErrInvalidDerivationPath, segment)
+		}
+
+		parsed = append(parsed, uint32(index))
+	}
+
+	return parsed, nil
+}
+
+// String returns the normalized form of the DerivationPath, such as m/2/15
+func (path DerivationPath) String() string {
+	var builder strings.Builder
+
+	builder.WriteString("m")
+
+	for _, index := range path {
+		builder.WriteString("/")
+		builder.WriteString(strconv.FormatUint(uint64(index), 10))
+	}
+
+	return builder.String()
+}
+
+// Child returns a new DerivationPath for the child with the given index of the path
+func (path DerivationPath) Child(index uint32) DerivationPath {
+	return append(path[:len(path):len(path)], index)
+}
+
+// Derive returns the identifier at the DerivationPath from the given root identifier.
+// Returns an error if the root identifier is a variant (see Identifier.IsVariant).
+//
+// The variant ID of each child is derived from the SHA-256 hash of the moi.variant domain, followed by
+// the account ID, the variant ID of its parent and its index (4 bytes, big-endian). The variant ID of the
+// child is the first non-zero 4-byte word of the digest (big-endian), so that every child is a variant.
+// All other parts of the identifier are retained, so the derived identifiers share their root's account.
+func (path DerivationPath) Derive(root Identifier) (Identifier, error) {
+	if root.IsVariant() {
+		return Nil, errors.New("invalid derivation root: identifier is a variant")
+	}
+
+	account := root.AccountID()
+	derived := root
+
+	for _, index := range path {
+		buffer := make([]byte, 0, len(derivationDomain)+24+4+4)
+		buffer = append(buffer, derivationDomain...)
+		buffer = append(buffer, account[:]...)
+		buffer = binary.BigEndian.AppendUint32(buffer, derived.Variant())
+		buffer = binary.BigEndian.AppendUint32(buffer, index)
+
+		digest := sha256.Sum256(buffer)
+
+		// Select the first non-zero word of the digest as the variant ID
+		for word := 0; word < len(digest); word += 4 {
+			if variant := binary.BigEndian.Uint32(digest[word:]); variant != 0 {
+				binary.BigEndian.PutUint32(derived[28:], variant)
+				break
+			}
+		}
+	}
+
+	return derived, nil
+}
+
+// DerivePath returns the identifier at the given derivation path of the form m/<index>/<index>/...
+// from the Identifier, which must have a zero variant ID. The path is parsed with ParseDerivationPath
+// and the identifier is derived with DerivationPath.Derive, so the same path always derives the same
+// identifier for the same account. Derived variant IDs are pseudorandom and do not preserve the
+// ordering of their indices. Returns an error if the path is invalid or the Identifier is a variant.
+func (id Identifier) DerivePath(path string) (Identifier, error) {
+	parsed, err := ParseDerivationPath(path)
+	if err != nil {
+		return Nil, err
+	}
+
+	return parsed.Derive(id)
+}
@@ -0,0 +1,125 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		input      string
+		path       DerivationPath
+		normalized string
+		err        string
+	}{
+		{input: "m", path: DerivationPath{}, normalized: "m"},
+		{input: "m/2/15", path: DerivationPath{2, 15}, normalized: "m/2/15"},
+		{input: " M/002/0015 ", path: DerivationPath{2, 15}, normalized: "m/2/15"},
+		{input: "m/0/4294967295", path: DerivationPath{0, 4294967295}, normalized: "m/0/4294967295"},
+
+		{input: "", err: "invalid derivation path: path must start with m"},
+		{input: "2/15", err: "invalid derivation path: path must start with m"},
+		{input: "/m/2", err: "invalid derivation path: path must start with m"},
+		{input: "m/", err: `invalid derivation path: invalid index ""`},
+		{input: "m//2", err: `invalid derivation path: invalid index ""`},
+		{input: "m/2'", err: `invalid derivation path: invalid index "2'"`},
+		{input: "m/+2", err: `invalid derivation path: invalid index "+2"`},
+		{input: "m/-2", err: `invalid derivation path: invalid index "-2"`},
+		{input: "m/ 2", err: `invalid derivation path: invalid index " 2"`},
+		{input: "m/4294967296", err: "invalid derivation path: index 4294967296 is out of range"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			path, err := ParseDerivationPath(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.path, path)
+			require.Equal(t, test.normalized, path.String())
+
+			// Test that the normalized path round-trips
+			reparsed, err := ParseDerivationPath(path.String())
+			require.NoError(t, err)
+			require.Equal(t, path, reparsed)
+		})
+	}
+}
+
+func TestDerivationPath_Child(t *testing.T) {
+	parent := DerivationPath{2}
+
+	first, second := parent.Child(15), parent.Child(16)
+	assert.Equal(t, "m/2/15", first.String())
+	assert.Equal(t, "m/2/16", second.String())
+	assert.Equal(t, "m/2", parent.String())
+}
+
+func TestIdentifier_DerivePath(t *testing.T) {
+	root := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1, AssetStateful)).AsIdentifier()
+
+	t.Run("Root", func(t *testing.T) {
+		derived, err := root.DerivePath("m")
+		require.NoError(t, err)
+		assert.Equal(t, root, derived)
+	})
+
+	t.Run("Hierarchy", func(t *testing.T) {
+		child, err := root.DerivePath("m/2")
+		require.NoError(t, err)
+
+		grandchild, err := root.DerivePath("m/2/15")
+		require.NoError(t, err)
+
+		// Test the derived variant IDs against the derivation scheme
+		account := root.AccountID()
+		expected := func(parent, index uint32) uint32 {
+			buffer := append([]byte("moi.variant"), account[:]...)
+			buffer = binary.BigEndian.AppendUint32(buffer, parent)
+			buffer = binary.BigEndian.AppendUint32(buffer, index)
+
+			digest := sha256.Sum256(buffer)
+
+			return binary.BigEndian.Uint32(digest[:4])
+		}
+
+		assert.Equal(t, expected(0, 2), child.Variant())
+		assert.Equal(t, expected(child.Variant(), 15), grandchild.Variant())
+
+		// Test that only the variant ID differs from the root
+		for _, derived := range []Identifier{child, grandchild} {
+			assert.True(t, derived.IsVariant())
+			assert.Equal(t, root[:28], derived[:28])
+			assert.NoError(t, derived.Validate())
+		}
+
+		// Test deterministic and normalized derivation
+		assert.Equal(t, grandchild, must(root.DerivePath(" M/02/015")))
+		assert.Equal(t, grandchild, must(DerivationPath{2, 15}.Derive(root)))
+
+		// Test that the path, index and account affect the derived variant
+		assert.NotEqual(t, grandchild, must(root.DerivePath("m/15/2")))
+		assert.NotEqual(t, grandchild, must(root.DerivePath("m/2/16")))
+
+		other := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1)).AsIdentifier()
+		assert.NotEqual(t, grandchild.Variant(), must(other.DerivePath("m/2/15")).Variant())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := root.DerivePath("n/2")
+		assert.EqualError(t, err, "invalid derivation path: path must start with m")
+
+		variant := must(root.DeriveVariant(7, nil, nil))
+		_, err = variant.DerivePath("m/2")
+		assert.EqualError(t, err, "invalid derivation root: identifier is a variant")
+	})
+}
@@ -0,0 +1,137 @@
+package identifiers
+
+// deriveConfig is the configuration for Identifier.Derive
+type deriveConfig struct {
+	variant    uint32
+	metadata   [2]byte
+	set, unset []Flag
+}
+
+// DeriveOption is an option for Identifier.Derive
+type DeriveOption func(*deriveConfig)
+
+// WithVariant sets the variant ID of the derived identifier.
+// Defaults to the variant ID of the original identifier.
+func WithVariant(variant uint32) DeriveOption {
+	return func(config *deriveConfig) { config.variant = variant }
+}
+
+// WithMetadata sets the 2-byte metadata of the derived identifier, such as the standard of an AssetID
+// or the multisig threshold and member count of a ParticipantID. Defaults to the metadata of the original identifier.
+func WithMetadata(metadata [2]byte) DeriveOption {
+	return func(config *deriveConfig) { config.metadata = metadata }
+}
+
+// WithFlagsSet sets the given flags on the derived identifier.
+// Flags are set before any flags are unset with WithFlagsUnset.
+func WithFlagsSet(flags ...Flag) DeriveOption {
+	return func(config *deriveConfig) { config.set = append(config.set, flags...) }
+}
+
+// WithFlagsUnset unsets the given flags on the derived identifier.
+func WithFlagsUnset(flags ...Flag) DeriveOption {
+	return func(config *deriveConfig) { config.unset = append(config.unset, flags...) }
+}
+
+// Derive returns a new Identifier derived from the Identifier with the changes of the given options applied.
+// Unlike DeriveVariant, it can also change the metadata of the identifier (see WithMetadata). The derived
+// identifier is validated for its kind, so that the metadata and flags of a ParticipantID are checked like
+// ParticipantID.Validate, while identifiers of other kinds are checked like Identifier.Validate.
+//
+// Returns ErrUnsupportedFlag if the flags to be set or unset are not supported for the Identifier tag,
+// or an error if the derived identifier is invalid.
+func (id Identifier) Derive(opts ...DeriveOption) (Identifier, error) {
+	config := deriveConfig{variant: id.Variant(), metadata: id.Metadata()}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	derived, err := id.DeriveVariant(config.variant, config.set, config.unset)
+	if err != nil {
+		return Nil, err
+	}
+
+	copy(derived[2:4], config.metadata[:])
+
+	if err = validateKind(derived); err != nil {
+		return Nil, err
+	}
+
+	return derived, nil
+}
+
+// Derive returns a new AssetID derived from the AssetID with the changes of the given options applied.
+// Returns an error if the flags are not supported for the AssetID or the derived AssetID is invalid.
+func (asset AssetID) Derive(opts ...DeriveOption) (AssetID, error) {
+	return derive(asset, opts, NewAssetID)
+}
+
+// Derive returns a new LogicID derived from the LogicID with the changes of the given options applied.
+// Returns an error if the flags are not supported for the LogicID or the derived LogicID is invalid.
+func (logic LogicID) Derive(opts ...DeriveOption) (LogicID, error) {
+	return derive(logic, opts, NewLogicID)
+}
+
+// Derive returns a new ParticipantID derived from the ParticipantID with the changes of the given options
+// applied. Returns an error if the flags are not supported for the ParticipantID or if the derived
+// ParticipantID is invalid, such as when the threshold metadata of a multisig participant is invalid.
+func (participant ParticipantID) Derive(opts ...DeriveOption) (ParticipantID, error) {
+	return derive(participant, opts, NewParticipantID)
+}
+
+// WithFlag returns a new Identifier with the given flag set or unset, keeping its variant ID and metadata.
+// Returns ErrUnsupportedFlag if the flag is not supported for the Identifier tag,
+// or an error if the resulting identifier is invalid.
+func (id Identifier) WithFlag(flag Flag, value bool) (Identifier, error) {
+	return id.Derive(withFlag(flag, value))
+}
+
+// WithFlag returns a new AssetID with the given flag set or unset, keeping its variant ID and standard.
+// Returns an error if the flag is not supported for the AssetID or the resulting AssetID is invalid.
+func (asset AssetID) WithFlag(flag Flag, value bool) (AssetID, error) {
+	return asset.Derive(withFlag(flag, value))
+}
+
+// WithFlag returns a new LogicID with the given flag set or unset, keeping its variant ID.
+// Returns an error if the flag is not supported for the LogicID or the resulting LogicID is invalid.
+func (logic LogicID) WithFlag(flag Flag, value bool) (LogicID, error) {
+	return logic.Derive(withFlag(flag, value))
+}
+
+// WithFlag returns a new ParticipantID with the given flag set or unset, keeping its variant ID and metadata.
+// Returns an error if the flag is not supported for the ParticipantID or the resulting ParticipantID is invalid,
+// such as when the multisig flag is set on a participant without valid threshold metadata.
+func (participant ParticipantID) WithFlag(flag Flag, value bool) (ParticipantID, error) {
+	return participant.Derive(withFlag(flag, value))
+}
+
+// withFlag returns a DeriveOption that sets or unsets the given flag
+func withFlag(flag Flag, value bool) DeriveOption {
+	if value {
+		return WithFlagsSet(flag)
+	}
+
+	return WithFlagsUnset(flag)
+}
+
+// derive derives a new identifier of the same type from the given identifier with Identifier.Derive,
+// and converts it with the given function, which validates the derived identifier for its kind.
+func derive[T ~[32]byte](id T, opts []DeriveOption, convert func([32]byte) (T, error)) (T, error) {
+	derived, err := Identifier(id).Derive(opts...)
+	if err != nil {
+		return Nil, err
+	}
+
+	return convert(derived)
+}
+
+// validateKind validates the given identifier for its kind. Participant identifiers are validated
+// with ParticipantID.Validate, which also checks their multisig metadata, and all other identifiers
+// (which have no kind-specific checks) are validated with Identifier.Validate.
+func validateKind(id Identifier) error {
+	if id.Tag().Kind() == KindParticipant {
+		return ParticipantID(id).Validate()
+	}
+
+	return id.Validate()
+}
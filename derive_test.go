@@ -0,0 +1,156 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_Derive(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 3, AssetStateful)).AsIdentifier()
+
+	t.Run("NoOptions", func(t *testing.T) {
+		derived, err := asset.Derive()
+		require.NoError(t, err)
+		assert.Equal(t, asset, derived)
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		derived, err := asset.Derive(
+			WithVariant(42),
+			WithMetadata([2]byte{0x00, 0x02}),
+			WithFlagsSet(AssetLogical, Systemic),
+			WithFlagsUnset(AssetStateful, Systemic),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(42), derived.Variant())
+		assert.Equal(t, [2]byte{0x00, 0x02}, derived.Metadata())
+		assert.Equal(t, byte(0b00000010), derived.Flags())
+		assert.Equal(t, asset.Tag(), derived.Tag())
+		assert.Equal(t, asset.AccountID(), derived.AccountID())
+		assert.Equal(t, uint16(2), must(derived.AsAssetID()).Standard())
+	})
+
+	t.Run("UnsupportedFlag", func(t *testing.T) {
+		_, err := asset.Derive(WithFlagsSet(LogicIntrinsic))
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+
+		_, err = asset.Derive(WithFlagsUnset(ParticipantMultisig))
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := asset
+		invalid[0] = 0xF0
+
+		_, err := invalid.Derive(WithVariant(1))
+		require.EqualError(t, err, "invalid tag: unsupported tag kind")
+	})
+}
+
+func TestAssetID_Derive(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 3))
+
+	derived, err := asset.Derive(WithMetadata([2]byte{0x01, 0x00}), WithFlagsSet(AssetStateful))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(256), derived.Standard())
+	assert.True(t, derived.Flag(AssetStateful))
+
+	_, err = asset.Derive(WithFlagsSet(KeySigning))
+	require.ErrorIs(t, err, ErrUnsupportedFlag)
+
+	// The derived identifier must be of the same kind
+	_, err = AssetID(must(GenerateLogicIDv0(RandomFingerprint(), 0, 0))).Derive()
+	require.ErrorIs(t, err, ErrNotAssetID)
+}
+
+func TestLogicID_Derive(t *testing.T) {
+	logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicIntrinsic))
+
+	derived, err := logic.Derive(WithVariant(7), WithFlagsSet(LogicExtrinsic), WithFlagsUnset(LogicIntrinsic))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), derived.Variant())
+	assert.True(t, derived.Flag(LogicExtrinsic))
+	assert.False(t, derived.Flag(LogicIntrinsic))
+
+	_, err = logic.Derive(WithMetadata([2]byte{0x00, 0x01}))
+	require.NoError(t, err)
+}
+
+func TestParticipantID_Derive(t *testing.T) {
+	participant := must(GenerateParticipantIDv0(RandomFingerprint(), 0))
+
+	multisig, err := participant.Derive(WithMetadata([2]byte{2, 3}), WithFlagsSet(ParticipantMultisig))
+	require.NoError(t, err)
+	assert.True(t, multisig.Flag(ParticipantMultisig))
+	assert.Equal(t, [2]byte{2, 3}, multisig.AsIdentifier().Metadata())
+
+	// The metadata of a multisig participant is re-validated
+	_, err = participant.Derive(WithMetadata([2]byte{4, 3}), WithFlagsSet(ParticipantMultisig))
+	require.ErrorIs(t, err, errInvalidMultisigMetadata)
+
+	_, err = multisig.Derive(WithMetadata([2]byte{0, 3}))
+	require.ErrorIs(t, err, errInvalidMultisigMetadata)
+
+	// Identifier.Derive also validates participants for their kind
+	_, err = multisig.AsIdentifier().Derive(WithMetadata([2]byte{0, 3}))
+	require.ErrorIs(t, err, errInvalidMultisigMetadata)
+
+	_, err = participant.Derive(WithFlagsSet(AssetLogical))
+	require.ErrorIs(t, err, ErrUnsupportedFlag)
+}
+
+func TestWithFlag(t *testing.T) {
+	asset := must(GenerateAssetIDv1(RandomFingerprint(), 0, 7, 0, AssetStateful))
+
+	t.Run("Identifier", func(t *testing.T) {
+		id, err := asset.AsIdentifier().WithFlag(AssetMintable, true)
+		require.NoError(t, err)
+		assert.Equal(t, byte(0b00001001), id.Flags())
+		assert.Equal(t, asset.Variant(), id.Variant())
+
+		_, err = asset.AsIdentifier().WithFlag(LogicIntrinsic, true)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("AssetID", func(t *testing.T) {
+		unset, err := asset.WithFlag(AssetStateful, false)
+		require.NoError(t, err)
+		assert.False(t, unset.Flag(AssetStateful))
+		assert.Equal(t, uint16(7), unset.Standard())
+		assert.Equal(t, asset.Variant(), unset.Variant())
+
+		// The original AssetID is not modified
+		assert.True(t, asset.Flag(AssetStateful))
+
+		_, err = asset.WithFlag(KeySigning, true)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("LogicID", func(t *testing.T) {
+		logic := must(GenerateLogicIDv0(RandomFingerprint(), 3, 0))
+
+		immutable, err := logic.WithFlag(LogicImmutable, true)
+		require.NoError(t, err)
+		assert.True(t, immutable.Flag(LogicImmutable))
+		assert.Equal(t, uint32(3), immutable.Variant())
+
+		_, err = logic.WithFlag(AssetLogical, true)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("ParticipantID", func(t *testing.T) {
+		participant := must(GenerateParticipantIDv1(RandomFingerprint(), 9))
+
+		guardian, err := participant.WithFlag(ParticipantGuardian, true)
+		require.NoError(t, err)
+		assert.True(t, guardian.Flag(ParticipantGuardian))
+		assert.Equal(t, uint32(9), guardian.Variant())
+
+		// A multisig participant must have valid threshold metadata
+		_, err = participant.WithFlag(ParticipantMultisig, true)
+		require.ErrorIs(t, err, errInvalidMultisigMetadata)
+	})
+}
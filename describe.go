@@ -0,0 +1,82 @@
+package identifiers
+
+import (
+	"encoding/hex"
+)
+
+// IdentifierInfo is a structured description of an Identifier, which is returned by Identifier.Describe.
+// It is intended for explorer detail pages and debug logs, and can be marshaled into JSON.
+type IdentifierInfo struct {
+	// Kind is the name of the kind of the identifier, such as "asset"
+	Kind string `json:"kind"`
+	// Version is the version of the identifier kind
+	Version uint8 `json:"version"`
+	// Flags are the names of the flags that are set on the identifier, ordered by their bit index
+	Flags []string `json:"flags"`
+	// Metadata is the interpretation of the metadata of the identifier for its kind and version,
+	// such as the "standard" of an AssetID. It is nil for kinds that do not use their metadata.
+	Metadata map[string]uint32 `json:"metadata,omitempty"`
+	// Account is the 0x-prefixed hex encoding of the 24-byte account ID of the identifier
+	Account string `json:"account"`
+	// Variant is the variant ID of the identifier
+	Variant uint32 `json:"variant"`
+	// Hex is the canonical 0x-prefixed hex encoding of the identifier
+	Hex string `json:"hex"`
+}
+
+// Describe returns an IdentifierInfo that describes the components of the Identifier.
+// The identifier is not validated, and flags that are not supported by its tag are not described.
+func (id Identifier) Describe() IdentifierInfo {
+	account := id.AccountID()
+	flags := id.FlagSet().List()
+
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		names = append(names, flag.String())
+	}
+
+	return IdentifierInfo{
+		Kind:     id.Tag().Kind().String(),
+		Version:  id.Tag().Version(),
+		Flags:    names,
+		Metadata: describeMetadata(id),
+		Account:  prefix0xString + hex.EncodeToString(account[:]),
+		Variant:  id.Variant(),
+		Hex:      id.Hex(),
+	}
+}
+
+// describeMetadata returns the interpretation of the metadata of the given identifier for its kind and version.
+// Returns nil for kinds that do not use their metadata, such as participants that are not multisig.
+func describeMetadata(id Identifier) map[string]uint32 {
+	switch id.Tag().Kind() {
+	case KindParticipant:
+		participant := ParticipantID(id)
+		if !participant.Flag(ParticipantMultisig) {
+			return nil
+		}
+
+		return map[string]uint32{"threshold": uint32(participant.Threshold()), "members": uint32(participant.MemberCount())}
+
+	case KindAsset:
+		asset := AssetID(id)
+		if id.Tag().Version() == 0 {
+			return map[string]uint32{"standard": uint32(asset.Standard())}
+		}
+
+		return map[string]uint32{"standard": uint32(asset.Standard()), "dimension": uint32(asset.Dimension())}
+
+	case KindLogic:
+		logic := LogicID(id)
+		if id.Tag().Version() == 0 {
+			return map[string]uint32{"standard": uint32(logic.Standard())}
+		}
+
+		edition, _ := logic.Edition()
+
+		return map[string]uint32{"edition": edition}
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_Describe(t *testing.T) {
+	fingerprint := [24]byte{0xAA, 23: 0xFF}
+
+	tests := []struct {
+		name     string
+		id       Identifier
+		kind     string
+		version  uint8
+		flags    []string
+		metadata map[string]uint32
+	}{
+		{
+			"AssetV0",
+			must(GenerateAssetIDv0(fingerprint, 5, 20, AssetStateful, Systemic)).AsIdentifier(),
+			"asset", 0, []string{"asset-stateful", "systemic"}, map[string]uint32{"standard": 20},
+		},
+		{
+			"AssetV1",
+			must(GenerateAssetIDv1(fingerprint, 5, 1, 3, AssetFungible)).AsIdentifier(),
+			"asset", 1, []string{"asset-fungible"}, map[string]uint32{"standard": 1, "dimension": 3},
+		},
+		{
+			"LogicV0",
+			must(GenerateLogicIDv0(fingerprint, 5, 2)).AsIdentifier(),
+			"logic", 0, []string{}, map[string]uint32{"standard": 2},
+		},
+		{
+			"LogicV1",
+			must(GenerateLogicIDv1(fingerprint, 9, 5, LogicIntrinsic)).AsIdentifier(),
+			"logic", 1, []string{"logic-intrinsic"}, map[string]uint32{"edition": 9},
+		},
+		{
+			"Participant",
+			must(GenerateParticipantIDv0(fingerprint, 5)).AsIdentifier(),
+			"participant", 0, []string{}, nil,
+		},
+		{
+			"Multisig",
+			Identifier{byte(TagParticipantV0), 0b00000001, 2, 3, 4: 0xAA, 27: 0xFF, 31: 5},
+			"participant", 0, []string{"participant-multisig"}, map[string]uint32{"threshold": 2, "members": 3},
+		},
+		{
+			"Tesseract",
+			must(GenerateTesseractIDv0(fingerprint, 5)).AsIdentifier(),
+			"tesseract", 0, []string{}, nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := tt.id.Describe()
+
+			assert.Equal(t, tt.kind, info.Kind)
+			assert.Equal(t, tt.version, info.Version)
+			assert.Equal(t, tt.flags, info.Flags)
+			assert.Equal(t, tt.metadata, info.Metadata)
+			assert.Equal(t, "0xaa00000000000000000000000000000000000000000000ff", info.Account)
+			assert.Equal(t, uint32(5), info.Variant)
+			assert.Equal(t, tt.id.Hex(), info.Hex)
+		})
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		id := must(GenerateAssetIDv0(fingerprint, 5, 20, AssetStateful)).AsIdentifier()
+
+		encoded, err := json.Marshal(id.Describe())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"kind": "asset",
+			"version": 0,
+			"flags": ["asset-stateful"],
+			"metadata": {"standard": 20},
+			"account": "0xaa00000000000000000000000000000000000000000000ff",
+			"variant": 5,
+			"hex": "`+id.Hex()+`"
+		}`, string(encoded))
+
+		// Metadata is omitted for kinds that do not use it
+		encoded, err = json.Marshal(must(GenerateTesseractIDv0(fingerprint, 5)).AsIdentifier().Describe())
+		require.NoError(t, err)
+		assert.NotContains(t, string(encoded), "metadata")
+	})
+}
@@ -0,0 +1,71 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// FieldDiff describes a component that differs between two identifiers, as returned by Diff.
+// The values of the component in each identifier are rendered in a human-readable form.
+type FieldDiff struct {
+	// Field is the name of the component, which is one of "tag",
+	// "flags", "metadata", "account" or "variant"
+	Field string
+	// A is the rendered value of the component in the first identifier
+	A string
+	// B is the rendered value of the component in the second identifier
+	B string
+}
+
+// String returns the FieldDiff in the form "<field>: <a> -> <b>", such as "variant: 1 -> 2"
+func (diff FieldDiff) String() string {
+	return diff.Field + ": " + diff.A + " -> " + diff.B
+}
+
+// Diff returns the components that differ between the two given identifiers, in the order of their
+// position in the identifier (tag, flags, metadata, account and variant). Returns nil if they are equal.
+// This is useful for investigating identifiers that are almost identical, as it does not require either
+// identifier to be valid. Tags are rendered symbolically, flags and metadata are rendered as raw bits
+// and bytes (as their meaning depends on the tag), the account is rendered as hex and the variant as decimal.
+func Diff(a, b Identifier) []FieldDiff {
+	var diffs []FieldDiff
+
+	if a.Tag() != b.Tag() {
+		diffs = append(diffs, FieldDiff{Field: "tag", A: a.Tag().String(), B: b.Tag().String()})
+	}
+
+	if a.Flags() != b.Flags() {
+		diffs = append(diffs, FieldDiff{
+			Field: "flags",
+			A:     fmt.Sprintf("0b%08b", a.Flags()),
+			B:     fmt.Sprintf("0b%08b", b.Flags()),
+		})
+	}
+
+	if aMetadata, bMetadata := a.Metadata(), b.Metadata(); aMetadata != bMetadata {
+		diffs = append(diffs, FieldDiff{
+			Field: "metadata",
+			A:     prefix0xString + hex.EncodeToString(aMetadata[:]),
+			B:     prefix0xString + hex.EncodeToString(bMetadata[:]),
+		})
+	}
+
+	if aAccount, bAccount := a.AccountID(), b.AccountID(); aAccount != bAccount {
+		diffs = append(diffs, FieldDiff{
+			Field: "account",
+			A:     prefix0xString + hex.EncodeToString(aAccount[:]),
+			B:     prefix0xString + hex.EncodeToString(bAccount[:]),
+		})
+	}
+
+	if a.Variant() != b.Variant() {
+		diffs = append(diffs, FieldDiff{
+			Field: "variant",
+			A:     strconv.FormatUint(uint64(a.Variant()), 10),
+			B:     strconv.FormatUint(uint64(b.Variant()), 10),
+		})
+	}
+
+	return diffs
+}
@@ -0,0 +1,54 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	asset := must(GenerateAssetIDv0([24]byte{0xAA}, 5, 1, AssetStateful)).AsIdentifier()
+
+	t.Run("Equal", func(t *testing.T) {
+		assert.Nil(t, Diff(asset, asset))
+	})
+
+	t.Run("Variant", func(t *testing.T) {
+		diffs := Diff(asset, must(asset.Derive(WithVariant(6))))
+		require.Len(t, diffs, 1)
+		assert.Equal(t, FieldDiff{Field: "variant", A: "5", B: "6"}, diffs[0])
+		assert.Equal(t, "variant: 5 -> 6", diffs[0].String())
+	})
+
+	t.Run("All", func(t *testing.T) {
+		logic := must(GenerateLogicIDv1([24]byte{0xBB}, 2, 7, LogicExtrinsic)).AsIdentifier()
+
+		diffs := Diff(asset, logic)
+		require.Len(t, diffs, 5)
+
+		rendered := make([]string, 0, len(diffs))
+		for _, diff := range diffs {
+			rendered = append(rendered, diff.String())
+		}
+
+		assert.Equal(t, []string{
+			"tag: asset/v0 -> logic/v1",
+			"flags: 0b00000001 -> 0b00000010",
+			"metadata: 0x0001 -> 0x0002",
+			"account: 0xaa0000000000000000000000000000000000000000000000 -> " +
+				"0xbb0000000000000000000000000000000000000000000000",
+			"variant: 5 -> 7",
+		}, rendered)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := asset
+		invalid[0], invalid[1] = 0xF0, 0xFF
+
+		diffs := Diff(asset, invalid)
+		require.Len(t, diffs, 2)
+		assert.Equal(t, "tag: asset/v0 -> kind(15)/v0", diffs[0].String())
+		assert.Equal(t, "flags: 0b00000001 -> 0b11111111", diffs[1].String())
+	})
+}
@@ -0,0 +1,92 @@
+package identifiers
+
+import (
+	"fmt"
+)
+
+// KindHandlers is a set of callbacks for handling identifiers by their kind, which is used with Dispatch.
+// Each callback receives the identifier as the concrete type of its kind. Callbacks that are nil are not
+// called, and identifiers of their kind (and of custom kinds) are handled by Default instead.
+type KindHandlers struct {
+	Participant func(ParticipantID) error
+	Asset       func(AssetID) error
+	Logic       func(LogicID) error
+	Interaction func(InteractionID) error
+	Tesseract   func(TesseractID) error
+	Group       func(GroupID) error
+	File        func(FileID) error
+	Receipt     func(ReceiptID) error
+	Topic       func(TopicID) error
+	Key         func(KeyID) error
+	Domain      func(DomainID) error
+
+	// Default is called for identifiers whose kind does not have a callback, including custom kinds.
+	// If Default is nil, such identifiers are rejected with ErrUnhandledKind.
+	Default func(Identifier) error
+}
+
+// Dispatch validates the given identifier into the concrete type of its kind and calls the callback for
+// its kind from the given KindHandlers, returning its error. This allows heterogeneous streams of identifiers
+// to be handled exhaustively, without a switch on the kind of each identifier.
+//
+// Returns an error if the identifier is not valid, or an error wrapping ErrUnhandledKind
+// if there is no callback for its kind and KindHandlers.Default is nil.
+func Dispatch(id Identifier, handlers KindHandlers) error {
+	typed, err := typedIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	switch typed := typed.(type) {
+	case ParticipantID:
+		if handlers.Participant != nil {
+			return handlers.Participant(typed)
+		}
+	case AssetID:
+		if handlers.Asset != nil {
+			return handlers.Asset(typed)
+		}
+	case LogicID:
+		if handlers.Logic != nil {
+			return handlers.Logic(typed)
+		}
+	case InteractionID:
+		if handlers.Interaction != nil {
+			return handlers.Interaction(typed)
+		}
+	case TesseractID:
+		if handlers.Tesseract != nil {
+			return handlers.Tesseract(typed)
+		}
+	case GroupID:
+		if handlers.Group != nil {
+			return handlers.Group(typed)
+		}
+	case FileID:
+		if handlers.File != nil {
+			return handlers.File(typed)
+		}
+	case ReceiptID:
+		if handlers.Receipt != nil {
+			return handlers.Receipt(typed)
+		}
+	case TopicID:
+		if handlers.Topic != nil {
+			return handlers.Topic(typed)
+		}
+	case KeyID:
+		if handlers.Key != nil {
+			return handlers.Key(typed)
+		}
+	case DomainID:
+		if handlers.Domain != nil {
+			return handlers.Domain(typed)
+		}
+	}
+
+	if handlers.Default != nil {
+		return handlers.Default(id)
+	}
+
+	return fmt.Errorf("%w: %v", ErrUnhandledKind, id.Tag().Kind())
+}
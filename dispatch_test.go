@@ -0,0 +1,111 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatch(t *testing.T) {
+	var handled []string
+
+	// record returns a callback that records the name of the handled kind
+	record := func(name string) func(Identifier) error {
+		return func(Identifier) error {
+			handled = append(handled, name)
+			return nil
+		}
+	}
+
+	handlers := KindHandlers{
+		Participant: func(id ParticipantID) error { return record("participant")(id.AsIdentifier()) },
+		Asset:       func(id AssetID) error { return record("asset")(id.AsIdentifier()) },
+		Logic:       func(id LogicID) error { return record("logic")(id.AsIdentifier()) },
+		Interaction: func(id InteractionID) error { return record("interaction")(id.AsIdentifier()) },
+		Tesseract:   func(id TesseractID) error { return record("tesseract")(id.AsIdentifier()) },
+		Group:       func(id GroupID) error { return record("group")(id.AsIdentifier()) },
+		File:        func(id FileID) error { return record("file")(id.AsIdentifier()) },
+		Receipt:     func(id ReceiptID) error { return record("receipt")(id.AsIdentifier()) },
+		Topic:       func(id TopicID) error { return record("topic")(id.AsIdentifier()) },
+		Key:         func(id KeyID) error { return record("key")(id.AsIdentifier()) },
+		Domain:      func(id DomainID) error { return record("domain")(id.AsIdentifier()) },
+	}
+
+	stream := []Identifier{
+		RandomParticipantIDv0().AsIdentifier(),
+		RandomAssetIDv0().AsIdentifier(),
+		RandomLogicIDv0().AsIdentifier(),
+		RandomInteractionIDv0().AsIdentifier(),
+		RandomTesseractIDv0().AsIdentifier(),
+		RandomGroupIDv0().AsIdentifier(),
+		RandomFileIDv0().AsIdentifier(),
+		RandomReceiptIDv0().AsIdentifier(),
+		RandomTopicIDv0().AsIdentifier(),
+		RandomKeyIDv0().AsIdentifier(),
+		RandomDomainIDv0().AsIdentifier(),
+	}
+
+	for _, id := range stream {
+		require.NoError(t, Dispatch(id, handlers))
+	}
+
+	assert.Equal(t, []string{
+		"participant", "asset", "logic", "interaction", "tesseract",
+		"group", "file", "receipt", "topic", "key", "domain",
+	}, handled)
+
+	t.Run("Default", func(t *testing.T) {
+		handled = nil
+
+		for _, id := range stream {
+			require.NoError(t, Dispatch(id, KindHandlers{Asset: handlers.Asset, Default: record("default")}))
+		}
+
+		assert.Len(t, handled, len(stream))
+		assert.Equal(t, []string{"default", "asset", "default"}, handled[:3])
+	})
+
+	t.Run("Unhandled", func(t *testing.T) {
+		for _, id := range stream {
+			err := Dispatch(id, KindHandlers{})
+			require.ErrorIs(t, err, ErrUnhandledKind)
+			require.EqualError(t, err, "unhandled kind: "+id.Tag().Kind().String())
+		}
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{MaxVersion: 0, FlagMasks: []byte{0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		custom := Identifier{byte(kindCustom << 4)}
+
+		var received Identifier
+
+		err := Dispatch(custom, KindHandlers{Default: func(id Identifier) error {
+			received = id
+			return nil
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, custom, received)
+
+		require.ErrorIs(t, Dispatch(custom, handlers), ErrUnhandledKind)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		errHandler := errors.New("handler failed")
+
+		err := Dispatch(stream[1], KindHandlers{Asset: func(AssetID) error { return errHandler }})
+		require.ErrorIs(t, err, errHandler)
+
+		// Invalid identifiers are not dispatched
+		err = Dispatch(Identifier{byte(TagAssetV0), 0xFF}, handlers)
+		require.ErrorIs(t, err, ErrBadFlags)
+
+		err = Dispatch(Identifier{0xF0}, handlers)
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+	})
+}
@@ -0,0 +1,313 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// DomainID is a unique identifier for a namespace (domain) in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the domain identifier.
+//   - Flags: The second byte contains flags for the domain identifier.
+//   - Metadata: As of v0, DomainID has no metadata.
+//
+// Like all identifiers, the DomainID also contains a Fingerprint and a Variant ID.
+// Flags of a DomainID are specific to a version and are invalid if set in an unsupported version.
+type DomainID [32]byte
+
+// NewDomainID creates a new DomainID from the 32-byte value.
+// It returns an error if the given data is not a valid DomainID.
+func NewDomainID(data [32]byte) (DomainID, error) {
+	// Convert the data into a DomainID
+	domainID := DomainID(data)
+	// Validate the DomainID
+	if err := domainID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return domainID, nil
+}
+
+// NewDomainIDFromBytes creates a new DomainID from the given byte slice.
+// The given value must have a length of 32 and validate into a DomainID.
+func NewDomainIDFromBytes(data []byte) (DomainID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: domain id must be 32 bytes")
+	}
+
+	return NewDomainID([32]byte(data))
+}
+
+// NewDomainIDFromHex creates a new DomainID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a DomainID.
+func NewDomainIDFromHex(data string) (DomainID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindDomain {
+		return DomainID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new DomainID from the decoded value
+	// Length check is performed in NewDomainIDFromBytes
+	converted, err := NewDomainIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustDomainID is an enforced version of NewDomainID.
+// Panics if an error occurs. Use with caution.
+func MustDomainID(data [32]byte) DomainID { return must(NewDomainID(data)) }
+
+// MustDomainIDFromBytes is an enforced version of NewDomainIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustDomainIDFromBytes(data []byte) DomainID { return must(NewDomainIDFromBytes(data)) }
+
+// MustDomainIDFromHex is an enforced version of NewDomainIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustDomainIDFromHex(data string) DomainID { return must(NewDomainIDFromHex(data)) }
+
+// Bytes returns the DomainID as a []byte
+func (domain DomainID) Bytes() []byte { return domain[:] }
+
+// String returns the DomainID as a hex-encoded string.
+// This is identical to DomainID.Hex() but is required for the fmt.Stringer interface
+func (domain DomainID) String() string { return domain.Hex() }
+
+// Hex returns the DomainID as a hex-encoded string with the 0x prefix
+func (domain DomainID) Hex() string {
+	return prefix0xString + hex.EncodeToString(domain[:])
+}
+
+// IsNil returns if the DomainID is nil, i.e., 0x000..000
+func (domain DomainID) IsNil() bool { return domain == DomainID{} }
+
+// AsIdentifier returns the DomainID as an Identifier.
+func (domain DomainID) AsIdentifier() Identifier {
+	return Identifier(domain)
+}
+
+// Tag returns the IdentifierTag for the DomainID.
+func (domain DomainID) Tag() IdentifierTag {
+	return IdentifierTag(domain[0])
+}
+
+// Flags returns the byte of flag bits from the DomainID
+func (domain DomainID) Flags() byte {
+	return domain[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the DomainID.
+func (domain DomainID) Fingerprint() [24]byte {
+	return trimFingerprint(domain)
+}
+
+// AccountID returns the 24-byte account ID from the DomainID.
+// This is identical to DomainID.Fingerprint()
+func (domain DomainID) AccountID() [24]byte {
+	return trimFingerprint(domain)
+}
+
+// Variant returns the 32-bit variant ID from the DomainID.
+func (domain DomainID) Variant() uint32 {
+	variant := trimVariant(domain)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the DomainID has a non-zero variant ID
+func (domain DomainID) IsVariant() bool {
+	variant := trimVariant(domain)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the DomainID.
+//
+// If the specified flag is not supported by the DomainID,
+// it will return False, regardless of the actual flag value.
+func (domain DomainID) Flag(flag Flag) bool {
+	// Check if the flag is supported by DomainID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(domain.Tag()) {
+		return false
+	}
+
+	return getFlag(domain[1], flag.index)
+}
+
+// Validate returns an error if the DomainID is invalid.
+// An error is returned if the DomainID has an invalid tag or contains unsupported flags.
+func (domain DomainID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := domain.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a domain tag
+	if domain.Tag().Kind() != KindDomain {
+		return ErrNotDomainID
+	}
+
+	// Check that there are no unsupported flags set
+	if (domain[1] & domain.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: domain.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(domain)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure DomainID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*DomainID)(nil)
+	_ encoding.TextUnmarshaler   = (*DomainID)(nil)
+	_ encoding.BinaryMarshaler   = (*DomainID)(nil)
+	_ encoding.BinaryUnmarshaler = (*DomainID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for DomainID
+func (domain DomainID) MarshalText() ([]byte, error) {
+	return marshal32(domain)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for DomainID
+func (domain *DomainID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindDomain)
+	if err != nil {
+		return err
+	}
+
+	*domain = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for DomainID.
+// The DomainID is encoded as its raw 32 bytes.
+func (domain DomainID) MarshalBinary() ([]byte, error) {
+	return domain.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for DomainID.
+// The data must be 32 bytes long and validate into a DomainID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (domain *DomainID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*domain = DomainID{}
+		return nil
+	}
+
+	decoded, err := NewDomainIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*domain = decoded
+	return nil
+}
+
+// GenerateDomainIDv0 creates a new DomainID for v0 with the given parameters.
+// The fingerprint is deterministically derived from the hash of the parent DomainID and the label
+// of the domain within that parent. Top-level domains must use the zero value of DomainID as parent.
+// Returns an error if the label is empty, contains a '.' or unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:7}][reserved:2][fingerprint:24][variant:4]
+func GenerateDomainIDv0(parent DomainID, label string, flags ...Flag) (DomainID, error) {
+	// Check that the label is a single non-empty label
+	if label == "" || strings.Contains(label, ".") {
+		return Nil, fmt.Errorf("invalid label: %q must be non-empty and must not contain '.'", label)
+	}
+
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for DomainID v0
+	metadata[0] = byte(TagDomainV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by DomainID v0
+		if !flag.Supports(TagDomainV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Derive the fingerprint from the parent and label
+	fingerprint := hashFingerprint("moi.domain", parent.Bytes(), []byte(label))
+
+	// Order the domain ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant (always zero)
+	buffer = append(buffer, make([]byte, 4)...)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return DomainID(buffer), nil
+}
+
+// GenerateDomainIDv0FromName creates a new DomainID for v0 from a hierarchical domain name.
+// The name is a '.' separated list of labels ordered from the most specific to the least specific,
+// such that "pay.wallet.moi" is the domain "pay" under "wallet" under the top-level domain "moi".
+// The given flags are only applied to the most specific domain, while its parents have no flags set.
+// Returns an error if any of the labels are invalid or unsupported flags are used.
+func GenerateDomainIDv0FromName(name string, flags ...Flag) (DomainID, error) {
+	labels := strings.Split(name, ".")
+
+	var domain DomainID
+
+	// Generate the domains from the top-level label down to the most specific label
+	for idx := len(labels) - 1; idx >= 0; idx-- {
+		var err error
+
+		if idx == 0 {
+			domain, err = GenerateDomainIDv0(domain, labels[idx], flags...)
+		} else {
+			domain, err = GenerateDomainIDv0(domain, labels[idx])
+		}
+
+		if err != nil {
+			return Nil, err
+		}
+	}
+
+	return domain, nil
+}
+
+// RandomDomainIDv0 creates a random v0 DomainID
+// with a random top-level label and no flags.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomDomainIDv0() DomainID {
+	// Safe to ignore error as the label is valid and no flags are used
+	domain, _ := GenerateDomainIDv0(DomainID{}, "domain"+strconv.FormatUint(rand.Uint64(), 10))
+	return domain
+}
@@ -0,0 +1,327 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainID(t *testing.T) {
+	data := [32]byte{
+		byte(TagDomainV0), // Tag
+		0b10000000,        // Flags
+		0x00, 0x00,        // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test DomainID
+	domainID, err := NewDomainID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagDomainV0, domainID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, domainID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), domainID.Variant())
+	// Test IsVariant
+	assert.True(t, domainID.IsVariant())
+
+	// Test Flags
+	assert.True(t, domainID.Flag(Systemic))
+	assert.False(t, domainID.Flag(KeySigning)) // unsupported flag
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, domainID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsDomainID()
+	require.NoError(t, err)
+	require.Equal(t, domainID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], domainID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0xa080000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, domainID.String())
+	assert.Equal(t, expectedHex, domainID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestDomainID_Constructor(t *testing.T) {
+	t.Run("NewDomainID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			domainID, err := NewDomainID([32]byte{
+				byte(TagDomainV0), // Tag
+				0b00000000,        // Flags
+				0x00, 0x01,        // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, domainID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewDomainID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewDomainID([32]byte{byte(TagDomainV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewDomainID([32]byte{byte(TagTopicV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a domain id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewDomainID([32]byte{
+				byte(TagDomainV0), // Tag
+				0b11111111,        // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for domain id")
+		})
+	})
+
+	t.Run("NewDomainIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewDomainIDFromBytes([]byte{byte(TagDomainV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: domain id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			domainID, err := NewDomainIDFromBytes([]byte{
+				byte(TagDomainV0), // Tag
+				0b00000000,        // Flags
+				0x00, 0x01,        // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, domainID.Validate())
+			require.Equal(t, DomainID{
+				byte(TagDomainV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, domainID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewDomainIDFromBytes([]byte{
+				byte(TagDomainV0), // Tag
+				0b00000000,        // Flags
+				0x00, 0x01,        // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: domain id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewDomainIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			domainID, err := NewDomainIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagDomainV0), // Tag
+				0b00000000,        // Flags
+				0x00, 0x01,        // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, domainID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			domainID, err := NewDomainIDFromHex(hex.EncodeToString([]byte{
+				byte(TagDomainV0), // Tag
+				0b00000000,        // Flags
+				0x00, 0x01,        // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, domainID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewDomainIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewDomainIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustDomainID", func(t *testing.T) {
+		t.Run("MustDomainID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustDomainID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustDomainIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustDomainIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustDomainIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustDomainIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestDomainID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		domainID := RandomDomainIDv0()
+
+		encoded, err := json.Marshal(domainID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+domainID.Hex()+`"`, string(encoded))
+
+		var decoded DomainID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, domainID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded DomainID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded DomainID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded DomainID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestDomainID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			root, err := GenerateDomainIDv0(DomainID{}, "moi", Systemic)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagDomainV0, root.Tag())
+			assert.Equal(t, uint32(0), root.Variant())
+			assert.True(t, root.Flag(Systemic))
+
+			child, err := GenerateDomainIDv0(root, "wallet")
+			require.NoError(t, err)
+			assert.False(t, child.Flag(Systemic))
+
+			// Test that the parent affects the fingerprint
+			orphan, err := GenerateDomainIDv0(DomainID{}, "wallet")
+			require.NoError(t, err)
+			assert.NotEqual(t, child.Fingerprint(), orphan.Fingerprint())
+
+			// Test invalid labels
+			_, err = GenerateDomainIDv0(root, "")
+			require.EqualError(t, err, `invalid label: "" must be non-empty and must not contain '.'`)
+
+			_, err = GenerateDomainIDv0(root, "pay.wallet")
+			require.EqualError(t, err, `invalid label: "pay.wallet" must be non-empty and must not contain '.'`)
+
+			// Test unsupported flags
+			_, err = GenerateDomainIDv0(root, "wallet", KeySigning)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("FromName", func(t *testing.T) {
+			root := must(GenerateDomainIDv0(DomainID{}, "moi"))
+			wallet := must(GenerateDomainIDv0(root, "wallet"))
+			pay := must(GenerateDomainIDv0(wallet, "pay", Systemic))
+
+			domainID, err := GenerateDomainIDv0FromName("pay.wallet.moi", Systemic)
+			require.NoError(t, err)
+			assert.Equal(t, pay, domainID)
+
+			domainID, err = GenerateDomainIDv0FromName("moi")
+			require.NoError(t, err)
+			assert.Equal(t, root, domainID)
+
+			_, err = GenerateDomainIDv0FromName("pay..moi")
+			require.EqualError(t, err, `invalid label: "" must be non-empty and must not contain '.'`)
+
+			_, err = GenerateDomainIDv0FromName("pay.wallet.moi", KeySigning)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			domainID := RandomDomainIDv0()
+
+			assert.NoError(t, domainID.Validate())
+			assert.Equal(t, TagDomainV0, domainID.Tag())
+		})
+	})
+}
+
+func TestDomainID_BinaryMarshal(t *testing.T) {
+	domain := RandomDomainIDv0()
+
+	encoded, err := domain.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, domain.Bytes(), encoded)
+
+	var decoded DomainID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, domain, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: domain id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a domain id",
+	)
+}
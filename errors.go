@@ -0,0 +1,137 @@
+package identifiers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The following errors are returned by the Validate methods of kind specific identifiers
+// if the tag of the identifier is of a different kind. They are preallocated, so that the
+// rejection of invalid identifiers does not allocate, and can be matched with errors.Is.
+var (
+	ErrNotParticipantID = errors.New("invalid tag: not a participant id")
+	ErrNotAssetID       = errors.New("invalid tag: not an asset id")
+	ErrNotLogicID       = errors.New("invalid tag: not a logic id")
+	ErrNotInteractionID = errors.New("invalid tag: not an interaction id")
+	ErrNotTesseractID   = errors.New("invalid tag: not a tesseract id")
+	ErrNotGroupID       = errors.New("invalid tag: not a group id")
+	ErrNotFileID        = errors.New("invalid tag: not a file id")
+	ErrNotReceiptID     = errors.New("invalid tag: not a receipt id")
+	ErrNotTopicID       = errors.New("invalid tag: not a topic id")
+	ErrNotKeyID         = errors.New("invalid tag: not a key id")
+	ErrNotDomainID      = errors.New("invalid tag: not a domain id")
+
+	// ErrBadFlags matches any BadFlagsError with errors.Is, regardless of its tag
+	ErrBadFlags = errors.New("invalid flags: unsupported flags")
+
+	// ErrFlagRule matches any FlagRuleError with errors.Is, regardless of its tag and rule
+	ErrFlagRule = errors.New("invalid flags: flag rule violated")
+
+	// ErrBadMetadata matches any BadMetadataError with errors.Is, regardless of its tag
+	ErrBadMetadata = errors.New("invalid metadata: rejected by metadata validator")
+)
+
+var (
+	// errInvalidTagKind and errInvalidTagVersion are the preallocated errors returned
+	// by the Validate methods of all identifiers if the tag of the identifier is invalid
+	errInvalidTagKind    = fmt.Errorf("invalid tag: %w", ErrUnsupportedKind)
+	errInvalidTagVersion = fmt.Errorf("invalid tag: %w", ErrUnsupportedVersion)
+)
+
+// invalidTag returns the preallocated error for an invalid tag,
+// which wraps the given error returned by IdentifierTag.Validate
+func invalidTag(err error) error {
+	switch {
+	case errors.Is(err, ErrUnsupportedKind):
+		return errInvalidTagKind
+	case errors.Is(err, ErrUnsupportedVersion):
+		return errInvalidTagVersion
+	default:
+		return fmt.Errorf("invalid tag: %w", err)
+	}
+}
+
+// BadFlagsError is the error returned by the Validate methods of all identifiers if the identifier
+// has flags set that are not supported by its tag. The error message is only formatted when needed,
+// and the error does not allocate when returned as an error. Matches ErrBadFlags with errors.Is.
+type BadFlagsError struct {
+	Tag IdentifierTag
+}
+
+// Error implements the error interface for BadFlagsError
+func (err BadFlagsError) Error() string {
+	// Custom kinds are described as generic identifiers
+	if int(err.Tag.Kind()) >= len(defaultKindSupport) {
+		return "invalid flags: unsupported flags for identifier"
+	}
+
+	return "invalid flags: unsupported flags for " + err.Tag.Kind().String() + " id"
+}
+
+// Is returns whether the target is ErrBadFlags, which allows
+// any BadFlagsError to be matched with errors.Is(err, ErrBadFlags)
+func (err BadFlagsError) Is(target error) bool {
+	return target == ErrBadFlags
+}
+
+// FlagRuleError is the error returned by the Validate methods of all identifiers and by generators if the
+// flags of the identifier violate a FlagRule of its kind (see RegisterFlagRule). It wraps the error that
+// describes the violation, which is ErrRequiredFlag or ErrExcludedFlag for the required and excluded flags
+// of the rule. Matches ErrFlagRule with errors.Is.
+type FlagRuleError struct {
+	Tag  IdentifierTag
+	Rule string
+	Err  error
+}
+
+// Error implements the error interface for FlagRuleError
+func (err FlagRuleError) Error() string {
+	// Custom kinds are described as generic identifiers
+	subject := "identifier"
+	if int(err.Tag.Kind()) < len(defaultKindSupport) {
+		subject = err.Tag.Kind().String() + " id"
+	}
+
+	return fmt.Sprintf("invalid flags: rule %q violated for %s: %v", err.Rule, subject, err.Err)
+}
+
+// Unwrap returns the error that describes the violation of the rule
+func (err FlagRuleError) Unwrap() error {
+	return err.Err
+}
+
+// Is returns whether the target is ErrFlagRule, which allows
+// any FlagRuleError to be matched with errors.Is(err, ErrFlagRule)
+func (err FlagRuleError) Is(target error) bool {
+	return target == ErrFlagRule
+}
+
+// BadMetadataError is the error returned by the Validate methods of all identifiers and by generators if the
+// metadata of the identifier is rejected by a MetadataValidator of its kind (see RegisterMetadataValidator).
+// It wraps the error returned by the validator. Matches ErrBadMetadata with errors.Is.
+type BadMetadataError struct {
+	Tag IdentifierTag
+	Err error
+}
+
+// Error implements the error interface for BadMetadataError
+func (err BadMetadataError) Error() string {
+	// Custom kinds are described as generic identifiers
+	subject := "identifier"
+	if int(err.Tag.Kind()) < len(defaultKindSupport) {
+		subject = err.Tag.Kind().String() + " id"
+	}
+
+	return fmt.Sprintf("invalid metadata for %s: %v", subject, err.Err)
+}
+
+// Unwrap returns the error returned by the metadata validator
+func (err BadMetadataError) Unwrap() error {
+	return err.Err
+}
+
+// Is returns whether the target is ErrBadMetadata, which allows
+// any BadMetadataError to be matched with errors.Is(err, ErrBadMetadata)
+func (err BadMetadataError) Is(target error) bool {
+	return target == ErrBadMetadata
+}
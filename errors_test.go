@@ -0,0 +1,59 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadFlagsError(t *testing.T) {
+	err := Identifier{byte(TagAssetV0), 0b00100000}.Validate()
+	require.EqualError(t, err, "invalid flags: unsupported flags for asset id")
+	require.ErrorIs(t, err, ErrBadFlags)
+
+	var flagsErr BadFlagsError
+
+	require.ErrorAs(t, err, &flagsErr)
+	require.Equal(t, TagAssetV0, flagsErr.Tag)
+
+	// Custom kinds are described as generic identifiers
+	require.EqualError(t, BadFlagsError{Tag: 0xE0}, "invalid flags: unsupported flags for identifier")
+
+	assert.NotErrorIs(t, ErrUnsupportedFlag, ErrBadFlags)
+	assert.NotErrorIs(t, BadFlagsError{}, ErrUnsupportedFlag)
+}
+
+func TestInvalidTag(t *testing.T) {
+	require.Same(t, errInvalidTagKind, invalidTag(ErrUnsupportedKind))
+	require.Same(t, errInvalidTagVersion, invalidTag(ErrUnsupportedVersion))
+
+	err := invalidTag(errors.New("unknown"))
+	require.EqualError(t, err, "invalid tag: unknown")
+}
+
+func TestValidate_Allocations(t *testing.T) {
+	invalid := []Identifier{
+		{0xF0},                               // unsupported kind
+		{0x0F},                               // unsupported version
+		{byte(TagLogicV0)},                   // wrong kind
+		{byte(TagAssetV0), 0b00100000},       // unsupported flags
+		{byte(TagParticipantV0), 0b00000001}, // invalid multisig metadata
+	}
+
+	for _, id := range invalid {
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = id.Validate()
+			_ = AssetID(id).Validate()
+			_ = ParticipantID(id).Validate()
+		})
+
+		assert.Zero(t, allocs, "validation of %v allocates", id)
+	}
+
+	assert.ErrorIs(t, AssetID(invalid[0]).Validate(), ErrUnsupportedKind)
+	assert.ErrorIs(t, AssetID(invalid[1]).Validate(), ErrUnsupportedVersion)
+	assert.ErrorIs(t, AssetID(invalid[2]).Validate(), ErrNotAssetID)
+	assert.ErrorIs(t, AssetID(invalid[3]).Validate(), ErrBadFlags)
+}
@@ -0,0 +1,289 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// FileID is a unique identifier for a file (content stored under an account) in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the file identifier.
+//   - Flags: The second byte contains flags for the file identifier.
+//   - Metadata: As of v0, FileID has no metadata.
+//
+// Like all identifiers, the FileID also contains a Fingerprint and a Variant ID.
+// Flags of a FileID are specific to a version and are invalid if set in an unsupported version.
+type FileID [32]byte
+
+// NewFileID creates a new FileID from the 32-byte value.
+// It returns an error if the given data is not a valid FileID.
+func NewFileID(data [32]byte) (FileID, error) {
+	// Convert the data into a FileID
+	fileID := FileID(data)
+	// Validate the FileID
+	if err := fileID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return fileID, nil
+}
+
+// NewFileIDFromBytes creates a new FileID from the given byte slice.
+// The given value must have a length of 32 and validate into a FileID.
+func NewFileIDFromBytes(data []byte) (FileID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: file id must be 32 bytes")
+	}
+
+	return NewFileID([32]byte(data))
+}
+
+// NewFileIDFromHex creates a new FileID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a FileID.
+func NewFileIDFromHex(data string) (FileID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindFile {
+		return FileID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new FileID from the decoded value
+	// Length check is performed in NewFileIDFromBytes
+	converted, err := NewFileIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustFileID is an enforced version of NewFileID.
+// Panics if an error occurs. Use with caution.
+func MustFileID(data [32]byte) FileID { return must(NewFileID(data)) }
+
+// MustFileIDFromBytes is an enforced version of NewFileIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustFileIDFromBytes(data []byte) FileID { return must(NewFileIDFromBytes(data)) }
+
+// MustFileIDFromHex is an enforced version of NewFileIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustFileIDFromHex(data string) FileID { return must(NewFileIDFromHex(data)) }
+
+// Bytes returns the FileID as a []byte
+func (file FileID) Bytes() []byte { return file[:] }
+
+// String returns the FileID as a hex-encoded string.
+// This is identical to FileID.Hex() but is required for the fmt.Stringer interface
+func (file FileID) String() string { return file.Hex() }
+
+// Hex returns the FileID as a hex-encoded string with the 0x prefix
+func (file FileID) Hex() string {
+	return prefix0xString + hex.EncodeToString(file[:])
+}
+
+// IsNil returns if the FileID is nil, i.e., 0x000..000
+func (file FileID) IsNil() bool { return file == FileID{} }
+
+// AsIdentifier returns the FileID as an Identifier.
+func (file FileID) AsIdentifier() Identifier {
+	return Identifier(file)
+}
+
+// Tag returns the IdentifierTag for the FileID.
+func (file FileID) Tag() IdentifierTag {
+	return IdentifierTag(file[0])
+}
+
+// Flags returns the byte of flag bits from the FileID
+func (file FileID) Flags() byte {
+	return file[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the FileID.
+func (file FileID) Fingerprint() [24]byte {
+	return trimFingerprint(file)
+}
+
+// AccountID returns the 24-byte account ID from the FileID.
+// This is identical to FileID.Fingerprint()
+func (file FileID) AccountID() [24]byte {
+	return trimFingerprint(file)
+}
+
+// Variant returns the 32-bit variant ID from the FileID.
+func (file FileID) Variant() uint32 {
+	variant := trimVariant(file)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the FileID has a non-zero variant ID
+func (file FileID) IsVariant() bool {
+	variant := trimVariant(file)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the FileID.
+//
+// If the specified flag is not supported by the FileID,
+// it will return False, regardless of the actual flag value.
+func (file FileID) Flag(flag Flag) bool {
+	// Check if the flag is supported by FileID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(file.Tag()) {
+		return false
+	}
+
+	return getFlag(file[1], flag.index)
+}
+
+// Validate returns an error if the FileID is invalid.
+// An error is returned if the FileID has an invalid tag or contains unsupported flags.
+func (file FileID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := file.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a file tag
+	if file.Tag().Kind() != KindFile {
+		return ErrNotFileID
+	}
+
+	// Check that there are no unsupported flags set
+	if (file[1] & file.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: file.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(file)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure FileID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*FileID)(nil)
+	_ encoding.TextUnmarshaler   = (*FileID)(nil)
+	_ encoding.BinaryMarshaler   = (*FileID)(nil)
+	_ encoding.BinaryUnmarshaler = (*FileID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for FileID
+func (file FileID) MarshalText() ([]byte, error) {
+	return marshal32(file)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for FileID
+func (file *FileID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindFile)
+	if err != nil {
+		return err
+	}
+
+	*file = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for FileID.
+// The FileID is encoded as its raw 32 bytes.
+func (file FileID) MarshalBinary() ([]byte, error) {
+	return file.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for FileID.
+// The data must be 32 bytes long and validate into a FileID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (file *FileID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*file = FileID{}
+		return nil
+	}
+
+	decoded, err := NewFileIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*file = decoded
+	return nil
+}
+
+// GenerateFileIDv0 creates a new FileID for v0 with the given parameters.
+// The fingerprint is derived from the hash of the given content hash, which should be the SHA-256
+// digest of the file content, such that the same content always produces the same fingerprint.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:6}{immutable}][reserved:2][fingerprint:24][variant:4]
+func GenerateFileIDv0(contentHash [32]byte, variant uint32, flags ...Flag) (FileID, error) {
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for FileID v0
+	metadata[0] = byte(TagFileV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by FileID v0
+		if !flag.Supports(TagFileV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Derive the fingerprint from the content hash
+	fingerprint := hashFingerprint("moi.file", contentHash[:])
+
+	// Order the file ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return FileID(buffer), nil
+}
+
+// RandomFileIDv0 creates a random v0 FileID with
+// random content, a random variant ID and flags.
+//   - There is a 50% chance that the FileImmutable flag will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomFileIDv0() FileID {
+	flags := make([]Flag, 0, 1)
+
+	if rand.Int64() > 0 {
+		flags = append(flags, FileImmutable)
+	}
+
+	fingerprint := RandomFingerprint()
+
+	// Safe to ignore error as the flags are supported
+	file, _ := GenerateFileIDv0(sha256.Sum256(fingerprint[:]), rand.Uint32(), flags...)
+
+	return file
+}
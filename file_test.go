@@ -0,0 +1,305 @@
+package identifiers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileID(t *testing.T) {
+	data := [32]byte{
+		byte(TagFileV0), // Tag
+		0b10000001,      // Flags
+		0x00, 0x00,      // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test FileID
+	fileID, err := NewFileID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagFileV0, fileID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, fileID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), fileID.Variant())
+	// Test IsVariant
+	assert.True(t, fileID.IsVariant())
+
+	// Test Flags
+	assert.True(t, fileID.Flag(FileImmutable))
+	assert.True(t, fileID.Flag(Systemic))
+	assert.False(t, fileID.Flag(GroupThresholded)) // unsupported flag on set bit
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, fileID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsFileID()
+	require.NoError(t, err)
+	require.Equal(t, fileID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], fileID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x6081000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, fileID.String())
+	assert.Equal(t, expectedHex, fileID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestFileID_Constructor(t *testing.T) {
+	t.Run("NewFileID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			fileID, err := NewFileID([32]byte{
+				byte(TagFileV0), // Tag
+				0b00000000,      // Flags
+				0x00, 0x01,      // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, fileID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewFileID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewFileID([32]byte{byte(TagFileV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewFileID([32]byte{byte(TagGroupV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a file id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewFileID([32]byte{
+				byte(TagFileV0), // Tag
+				0b11111111,      // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for file id")
+		})
+	})
+
+	t.Run("NewFileIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewFileIDFromBytes([]byte{byte(TagFileV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: file id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			fileID, err := NewFileIDFromBytes([]byte{
+				byte(TagFileV0), // Tag
+				0b00000000,      // Flags
+				0x00, 0x01,      // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, fileID.Validate())
+			require.Equal(t, FileID{
+				byte(TagFileV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, fileID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewFileIDFromBytes([]byte{
+				byte(TagFileV0), // Tag
+				0b00000000,      // Flags
+				0x00, 0x01,      // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: file id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewFileIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			fileID, err := NewFileIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagFileV0), // Tag
+				0b00000000,      // Flags
+				0x00, 0x01,      // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, fileID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			fileID, err := NewFileIDFromHex(hex.EncodeToString([]byte{
+				byte(TagFileV0), // Tag
+				0b00000000,      // Flags
+				0x00, 0x01,      // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, fileID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewFileIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewFileIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustFileID", func(t *testing.T) {
+		t.Run("MustFileID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustFileID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustFileIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustFileIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustFileIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustFileIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestFileID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		fileID := RandomFileIDv0()
+
+		encoded, err := json.Marshal(fileID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+fileID.Hex()+`"`, string(encoded))
+
+		var decoded FileID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, fileID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded FileID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded FileID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded FileID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestFileID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			content := sha256.Sum256([]byte("hello world"))
+			fileID, err := GenerateFileIDv0(content, 42, FileImmutable)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagFileV0, fileID.Tag())
+			assert.Equal(t, uint32(42), fileID.Variant())
+			assert.True(t, fileID.Flag(FileImmutable))
+			assert.False(t, fileID.Flag(Systemic))
+
+			// Test that the same content produces the same fingerprint
+			mutable, err := GenerateFileIDv0(content, 0)
+			require.NoError(t, err)
+			assert.Equal(t, fileID.Fingerprint(), mutable.Fingerprint())
+
+			// Test that different content produces a different fingerprint
+			other, err := GenerateFileIDv0(sha256.Sum256([]byte("goodbye world")), 42, FileImmutable)
+			require.NoError(t, err)
+			assert.NotEqual(t, fileID.Fingerprint(), other.Fingerprint())
+
+			// Test unsupported flags
+			_, err = GenerateFileIDv0(content, 42, LogicIntrinsic)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			fileID := RandomFileIDv0()
+
+			assert.NoError(t, fileID.Validate())
+			assert.Equal(t, TagFileV0, fileID.Tag())
+		})
+	})
+}
+
+func TestFileID_BinaryMarshal(t *testing.T) {
+	file := RandomFileIDv0()
+
+	encoded, err := file.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, file.Bytes(), encoded)
+
+	var decoded FileID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, file, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: file id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a file id",
+	)
+}
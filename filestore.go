@@ -0,0 +1,119 @@
+package identifiers
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileStoreRecordSize is the size of a record in a FileStore: [base:32][last:4][checksum:4]
+const fileStoreRecordSize = 32 + 4 + 4
+
+// FileStore is a Store that persists its reservations to a file, so that variants are never handed out
+// twice across restarts of a service. A FileStore is safe for concurrent use within a process, and the
+// file is locked while it is open, so that it cannot be shared between processes (on platforms with
+// advisory file locks). It must be opened with OpenFileStore and closed after use.
+//
+// The file is an append-only log of reservations, with a record of the base identifier, the last reserved
+// variant ID (4 bytes, big-endian) and the CRC-32 checksum of both (4 bytes, big-endian) for each reservation.
+// Each record is synced to the disk before the reservation is returned. The log is read up to the first
+// partially written or corrupt record (from a crash during a reservation), which is safe to discard as
+// its reservation was never returned, and new records are written from that point onwards.
+type FileStore struct {
+	mutex    sync.Mutex
+	file     *os.File
+	size     int64
+	reserved map[Identifier]uint32
+}
+
+// OpenFileStore opens the FileStore at the given path, creating the file if it does not exist.
+// The file is locked before the reservations in it are loaded into memory, and the lock is
+// released when the FileStore is closed. Returns ErrStoreLocked if the file is already
+// locked by another FileStore, in this or another process.
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock the file before reading it, so that no reservations of another process are missed
+	var data []byte
+	if err = lockFile(file); err == nil {
+		data, err = io.ReadAll(file)
+	}
+
+	// Sync the directory of a new file, so that the file itself is not lost in a crash
+	if err == nil && len(data) == 0 {
+		err = syncDirectory(filepath.Dir(path))
+	}
+
+	if err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	store := &FileStore{file: file, reserved: make(map[Identifier]uint32)}
+
+	// Load the records until the first partial or corrupt record
+	for ; len(data) >= fileStoreRecordSize; data = data[fileStoreRecordSize:] {
+		record := data[:fileStoreRecordSize]
+		if crc32.ChecksumIEEE(record[:36]) != binary.BigEndian.Uint32(record[36:]) {
+			break
+		}
+
+		store.reserved[Identifier(record[:32])] = binary.BigEndian.Uint32(record[32:36])
+		store.size += fileStoreRecordSize
+	}
+
+	return store, nil
+}
+
+// Reserve implements the Store interface for FileStore.
+// Returns os.ErrClosed if the FileStore has been closed.
+func (store *FileStore) Reserve(ctx context.Context, base Identifier, count uint32) (uint32, uint32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	first, last, err := reserveVariants(store.reserved[base], count)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	record := make([]byte, 0, fileStoreRecordSize)
+	record = append(record, base[:]...)
+	record = binary.BigEndian.AppendUint32(record, last)
+	record = binary.BigEndian.AppendUint32(record, crc32.ChecksumIEEE(record))
+
+	// Write the record after the last valid record, and make it durable before it is returned
+	_, err = store.file.WriteAt(record, store.size)
+	if err == nil {
+		err = store.file.Sync()
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	store.reserved[base] = last
+	store.size += fileStoreRecordSize
+
+	return first, last, nil
+}
+
+// Close closes the file of the FileStore, which releases its lock.
+// Returns os.ErrClosed if the FileStore has already been closed.
+func (store *FileStore) Close() error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return store.file.Close()
+}
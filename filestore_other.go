@@ -0,0 +1,11 @@
+//go:build !unix
+
+package identifiers
+
+import "os"
+
+// lockFile does nothing on platforms where advisory file locks are not supported
+func lockFile(*os.File) error { return nil }
+
+// syncDirectory does nothing on platforms where directories cannot be synced
+func syncDirectory(string) error { return nil }
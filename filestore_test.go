@@ -0,0 +1,136 @@
+package identifiers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "variants")
+
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1)).AsIdentifier()
+	logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+
+	t.Run("Reserve", func(t *testing.T) {
+		store, err := OpenFileStore(path)
+		require.NoError(t, err)
+
+		first, last, err := store.Reserve(ctx, asset, 10)
+		require.NoError(t, err)
+		assert.Equal(t, [2]uint32{1, 10}, [2]uint32{first, last})
+
+		first, last, err = store.Reserve(ctx, logic, 5)
+		require.NoError(t, err)
+		assert.Equal(t, [2]uint32{1, 5}, [2]uint32{first, last})
+
+		first, last, err = store.Reserve(ctx, asset, 10)
+		require.NoError(t, err)
+		assert.Equal(t, [2]uint32{11, 20}, [2]uint32{first, last})
+
+		require.NoError(t, store.Close())
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3*fileStoreRecordSize), info.Size())
+	})
+
+	t.Run("Reopen", func(t *testing.T) {
+		store, err := OpenFileStore(path)
+		require.NoError(t, err)
+
+		t.Cleanup(func() { _ = store.Close() })
+
+		// Test that reservations continue after the persisted reservations
+		first, _, err := store.Reserve(ctx, asset, 10)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(21), first)
+
+		first, _, err = store.Reserve(ctx, logic, 10)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(6), first)
+	})
+
+	t.Run("PartialRecord", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		// Simulate a crash while writing a record, by corrupting the last record and adding a partial record
+		data[len(data)-1] ^= 0xFF
+		data = append(data, make([]byte, fileStoreRecordSize/2)...)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		store, err := OpenFileStore(path)
+		require.NoError(t, err)
+
+		// Test that the corrupt record is discarded and overwritten
+		first, _, err := store.Reserve(ctx, logic, 10)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(6), first)
+
+		first, _, err = store.Reserve(ctx, asset, 10)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(31), first)
+		require.NoError(t, store.Close())
+
+		reopened, err := OpenFileStore(path)
+		require.NoError(t, err)
+
+		t.Cleanup(func() { _ = reopened.Close() })
+
+		assert.Equal(t, map[Identifier]uint32{asset: 40, logic: 15}, reopened.reserved)
+	})
+
+	t.Run("Allocator", func(t *testing.T) {
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "variants"))
+		require.NoError(t, err)
+
+		t.Cleanup(func() { _ = store.Close() })
+
+		allocator := NewVariantAllocator(store, 4)
+
+		for variant := uint32(1); variant <= 6; variant++ {
+			next, err := allocator.Next(ctx, asset)
+			require.NoError(t, err)
+			assert.Equal(t, variant, next.Variant())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		// Test that a directory cannot be opened
+		_, err := OpenFileStore(t.TempDir())
+		assert.Error(t, err)
+
+		// Test that a file cannot be created in a missing directory
+		_, err = OpenFileStore(filepath.Join(t.TempDir(), "missing", "variants"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "variants"))
+		require.NoError(t, err)
+
+		// Test that a cancelled context fails the reservation
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, _, err = store.Reserve(cancelled, asset, 1)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		// Test that a closed store fails the reservation
+		require.NoError(t, store.Close())
+		assert.ErrorIs(t, store.Close(), os.ErrClosed)
+
+		_, _, err = store.Reserve(ctx, asset, 1)
+		assert.ErrorIs(t, err, os.ErrClosed)
+
+		// Test that an exhausted base identifier fails the reservation
+		exhausted := &FileStore{reserved: map[Identifier]uint32{asset: 1<<32 - 1}}
+
+		_, _, err = exhausted.Reserve(ctx, asset, 1)
+		assert.ErrorIs(t, err, ErrVariantsExhausted)
+	})
+}
@@ -0,0 +1,33 @@
+//go:build unix
+
+package identifiers
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// lockFile acquires an exclusive advisory lock on the file, which is released when the file is closed.
+// Returns ErrStoreLocked if the file is already locked, instead of waiting for the lock.
+func lockFile(file *os.File) error {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return ErrStoreLocked
+	}
+
+	return err
+}
+
+// syncDirectory syncs the directory at the given path to the disk,
+// which makes the creation of the files in it durable
+func syncDirectory(path string) error {
+	directory, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer directory.Close()
+
+	return directory.Sync()
+}
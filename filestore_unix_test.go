@@ -0,0 +1,43 @@
+//go:build unix
+
+package identifiers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_Lock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "variants")
+
+	store, err := OpenFileStore(path)
+	require.NoError(t, err)
+
+	// Test that the file cannot be opened again while it is locked
+	_, err = OpenFileStore(path)
+	assert.ErrorIs(t, err, ErrStoreLocked)
+
+	// Test that the failed open does not release the lock of the store
+	_, _, err = store.Reserve(context.Background(), RandomLogicIDv0().AsIdentifier(), 1)
+	require.NoError(t, err)
+
+	_, err = OpenFileStore(path)
+	assert.ErrorIs(t, err, ErrStoreLocked)
+
+	// Test that the file can be opened again after the store is closed
+	require.NoError(t, store.Close())
+
+	reopened, err := OpenFileStore(path)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Close())
+}
+
+func TestSyncDirectory(t *testing.T) {
+	require.NoError(t, syncDirectory(t.TempDir()))
+	assert.ErrorIs(t, syncDirectory(filepath.Join(t.TempDir(), "missing")), os.ErrNotExist)
+}
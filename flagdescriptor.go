@@ -0,0 +1,64 @@
+package identifiers
+
+// FlagDescriptor describes the layout of a Flag supported by an IdentifierTag.
+// It is intended for documentation UIs and debugging tools, which can use it to
+// describe the flags of any identifier generically without knowing its kind.
+type FlagDescriptor struct {
+	// Flag is the described flag
+	Flag Flag
+	// Index is the bit index of the flag, from 7 for the MSB to 0 for the LSB
+	Index uint8
+	// Name is the name of the flag, such as "asset-stateful"
+	Name string
+	// Meaning is a short description of what the flag indicates.
+	// It is empty for custom flags registered with RegisterFlag.
+	Meaning string
+	// MinVersion is the minimum version of the kind from which the flag is supported
+	MinVersion uint8
+}
+
+// flagMeanings is a map of the names of builtin flags to a short description of what they indicate
+var flagMeanings = map[string]string{
+	"systemic":                "the account of the identifier belongs to the system",
+	"nested":                  "the identifier is a child of another identifier of the same account",
+	"participant-multisig":    "the participant is an m-of-n threshold account",
+	"participant-guardian":    "the participant can recover or protect other accounts",
+	"participant-contractual": "the participant is controlled by a logic rather than a key holder",
+	"asset-stateful":          "the asset has some stateful information such as its supply",
+	"asset-logical":           "the asset has some logic associated with it",
+	"asset-fungible":          "the units of the asset are interchangeable with each other",
+	"asset-mintable":          "the supply of the asset can be increased after its creation",
+	"asset-pausable":          "transfers of the asset can be paused by its controller",
+	"logic-intrinsic":         "the logic manages some intrinsic state",
+	"logic-extrinsic":         "the logic manages some extrinsic state",
+	"logic-auxiliary":         "the logic is attached as an auxiliary to another object",
+	"logic-immutable":         "the logic cannot be upgraded once it is deployed",
+	"group-thresholded":       "actions of the group require approval from a threshold of its members",
+	"file-immutable":          "the content of the file cannot be modified",
+	"key-signing":             "the key can be used for signing",
+	"key-encryption":          "the key can be used for encryption",
+}
+
+// DescribeFlags returns a FlagDescriptor for each flag (including custom flags) that
+// is supported by the given IdentifierTag, ordered by their bit index.
+// Returns an empty slice if the tag does not support any flags.
+func DescribeFlags(tag IdentifierTag) []FlagDescriptor {
+	flags := tag.SupportedFlags()
+	descriptors := make([]FlagDescriptor, 0, len(flags))
+
+	tables := registry.Load()
+
+	for _, flag := range flags {
+		version, _ := tables.flagVersion(flag, tag.Kind())
+
+		descriptors = append(descriptors, FlagDescriptor{
+			Flag:       flag,
+			Index:      flag.index,
+			Name:       flag.name,
+			Meaning:    flagMeanings[flag.name],
+			MinVersion: version,
+		})
+	}
+
+	return descriptors
+}
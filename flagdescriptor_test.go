@@ -0,0 +1,63 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeFlags(t *testing.T) {
+	t.Run("AssetV1", func(t *testing.T) {
+		descriptors := DescribeFlags(TagAssetV1)
+		require.Len(t, descriptors, 7)
+
+		assert.Equal(t, FlagDescriptor{
+			Flag:       AssetStateful,
+			Index:      0,
+			Name:       "asset-stateful",
+			Meaning:    "the asset has some stateful information such as its supply",
+			MinVersion: 0,
+		}, descriptors[0])
+
+		assert.Equal(t, AssetFungible, descriptors[2].Flag)
+		assert.Equal(t, uint8(1), descriptors[2].MinVersion)
+
+		assert.Equal(t, "systemic", descriptors[6].Name)
+		assert.Equal(t, uint8(7), descriptors[6].Index)
+	})
+
+	t.Run("AllBuiltin", func(t *testing.T) {
+		for _, kind := range AllKinds() {
+			for _, descriptor := range DescribeFlags(MustTag(kind, 0)) {
+				assert.NotEmpty(t, descriptor.Meaning, descriptor.Name)
+			}
+		}
+
+		for _, flag := range builtinFlags {
+			assert.Contains(t, flagMeanings, flag.Name())
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		assert.Empty(t, DescribeFlags(IdentifierTag(0xF0)))
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{MaxVersion: 1, FlagMasks: []byte{0b01111111, 0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		_, err := RegisterFlag("custom-described", kindCustom, 0, 1)
+		require.NoError(t, err)
+		t.Cleanup(func() { delete(customFlags, "custom-described") })
+
+		descriptors := DescribeFlags(IdentifierTag(kindCustom<<4 | 1))
+		require.Len(t, descriptors, 2)
+
+		assert.Equal(t, "custom-described", descriptors[0].Name)
+		assert.Empty(t, descriptors[0].Meaning)
+		assert.Equal(t, uint8(1), descriptors[0].MinVersion)
+	})
+}
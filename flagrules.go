@@ -0,0 +1,106 @@
+package identifiers
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FlagRule is a consistency rule for the flags of an identifier kind, such as "AssetLogical requires
+// a non-zero standard" or "LogicAuxiliary excludes Systemic". A rule only applies to identifiers that
+// have its Flag set. Rules are declared for a kind with RegisterFlagRule, and are enforced by the
+// Validate methods of all identifiers and by the generators of identifiers.
+type FlagRule struct {
+	// Name is the name of the rule, which identifies it in errors. It must be unique for the kind.
+	Name string
+	// Flag is the flag that the rule applies to. The rule is only checked if the flag is set.
+	Flag Flag
+	// Requires are the flags that must also be set if the flag is set
+	Requires []Flag
+	// Excludes are the flags that must not be set if the flag is set
+	Excludes []Flag
+	// Check is an optional check of the identifier if the flag is set, such as a check of its metadata.
+	// It must return an error that describes the violation of the rule, and must not modify the registry.
+	Check func(id Identifier) error
+}
+
+// RegisterFlagRule declares the given FlagRule for the given kind. Once registered, identifiers of the kind
+// that violate the rule are rejected with a FlagRuleError by their Validate methods and by generators. Like
+// custom kinds and flags, flag rules are intended to be registered during initialization, as identifiers that
+// were valid before the rule was registered may be rejected after it.
+//
+// Returns an error if the kind is not recognized, if the name of the rule is empty or already registered
+// for the kind, or if the flag of the rule or any of its required or excluded flags is not a flag of the kind.
+func RegisterFlagRule(kind IdentifierKind, rule FlagRule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidFlagRule)
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	return updateRegistry(func(tables *registryTables) error {
+		if !tables.supports(kind) {
+			return ErrUnsupportedKind
+		}
+
+		// Check that all flags of the rule are flags of the kind
+		for _, flag := range append(append([]Flag{rule.Flag}, rule.Requires...), rule.Excludes...) {
+			if _, ok := tables.flagVersion(flag, kind); !ok {
+				return fmt.Errorf("%w: %v is not a flag of the kind", ErrInvalidFlagRule, flag)
+			}
+		}
+
+		for _, existing := range tables.flagRules[kind] {
+			if existing.Name == rule.Name {
+				return fmt.Errorf("%w: %q", ErrFlagRuleExists, rule.Name)
+			}
+		}
+
+		tables.flagRules[kind] = append(slices.Clone(tables.flagRules[kind]), rule)
+
+		return nil
+	})
+}
+
+// FlagRules returns the flag rules registered for the given kind, in the order they were registered
+func FlagRules(kind IdentifierKind) []FlagRule {
+	if kind > 0x0F {
+		return nil
+	}
+
+	return slices.Clone(registry.Load().flagRules[kind])
+}
+
+// checkFlagRules returns a FlagRuleError if the given identifier violates any flag rule of its kind.
+// The flags of the identifier must be supported by its tag, so that the flag bits can be checked directly.
+func (tables *registryTables) checkFlagRules(id Identifier) error {
+	for _, rule := range tables.flagRules[id.Tag().Kind()] {
+		if !getFlag(id[1], rule.Flag.index) {
+			continue
+		}
+
+		for _, required := range rule.Requires {
+			if !getFlag(id[1], required.index) {
+				return FlagRuleError{Tag: id.Tag(), Rule: rule.Name, Err: fmt.Errorf(
+					"%w: %v requires %v", ErrRequiredFlag, rule.Flag, required,
+				)}
+			}
+		}
+
+		for _, excluded := range rule.Excludes {
+			if getFlag(id[1], excluded.index) {
+				return FlagRuleError{Tag: id.Tag(), Rule: rule.Name, Err: fmt.Errorf(
+					"%w: %v excludes %v", ErrExcludedFlag, rule.Flag, excluded,
+				)}
+			}
+		}
+
+		if rule.Check != nil {
+			if err := rule.Check(id); err != nil {
+				return FlagRuleError{Tag: id.Tag(), Rule: rule.Name, Err: err}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unregisterFlagRules removes the flag rules registered with RegisterFlagRule for a kind.
+// For use in tests to restore the registry to its original state.
+func unregisterFlagRules(t *testing.T, kind IdentifierKind) {
+	t.Helper()
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	_ = updateRegistry(func(tables *registryTables) error {
+		tables.flagRules[kind] = nil
+		return nil
+	})
+}
+
+func TestRegisterFlagRule(t *testing.T) {
+	rule := FlagRule{Name: "auxiliary-not-systemic", Flag: LogicAuxiliary, Excludes: []Flag{Systemic}}
+
+	require.NoError(t, RegisterFlagRule(KindLogic, rule))
+	t.Cleanup(func() { unregisterFlagRules(t, KindLogic) })
+
+	rules := FlagRules(KindLogic)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "auxiliary-not-systemic", rules[0].Name)
+
+	assert.Empty(t, FlagRules(KindAsset))
+	assert.Nil(t, FlagRules(0x10))
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := RegisterFlagRule(KindLogic, rule)
+		require.EqualError(t, err, `flag rule already registered: "auxiliary-not-systemic"`)
+
+		err = RegisterFlagRule(KindLogic, FlagRule{Flag: LogicAuxiliary})
+		require.EqualError(t, err, "invalid flag rule: name must not be empty")
+
+		err = RegisterFlagRule(KindLogic, FlagRule{Name: "wrong-kind", Flag: AssetLogical})
+		require.EqualError(t, err, "invalid flag rule: asset-logical is not a flag of the kind")
+
+		err = RegisterFlagRule(KindLogic, FlagRule{Name: "wrong-kind", Flag: LogicAuxiliary, Requires: []Flag{KeySigning}})
+		require.EqualError(t, err, "invalid flag rule: key-signing is not a flag of the kind")
+
+		err = RegisterFlagRule(IdentifierKind(0x0E), FlagRule{Name: "unknown-kind", Flag: Systemic})
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+	})
+}
+
+func TestFlagRules_Enforced(t *testing.T) {
+	require.NoError(t, RegisterFlagRule(KindLogic, FlagRule{
+		Name: "auxiliary-not-systemic", Flag: LogicAuxiliary, Excludes: []Flag{Systemic},
+	}))
+	require.NoError(t, RegisterFlagRule(KindLogic, FlagRule{
+		Name: "immutable-intrinsic", Flag: LogicImmutable, Requires: []Flag{LogicIntrinsic},
+	}))
+	require.NoError(t, RegisterFlagRule(KindAsset, FlagRule{
+		Name: "logical-standard", Flag: AssetLogical,
+		Check: func(id Identifier) error {
+			if id.Metadata() == [2]byte{} {
+				return errors.New("asset-logical requires a non-zero standard")
+			}
+
+			return nil
+		},
+	}))
+
+	t.Cleanup(func() {
+		unregisterFlagRules(t, KindLogic)
+		unregisterFlagRules(t, KindAsset)
+	})
+
+	t.Run("Excludes", func(t *testing.T) {
+		_, err := GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicAuxiliary, Systemic)
+		require.EqualError(t, err,
+			`invalid flags: rule "auxiliary-not-systemic" violated for logic id: `+
+				`excluded flag set: logic-auxiliary excludes systemic`)
+		require.ErrorIs(t, err, ErrFlagRule)
+		require.ErrorIs(t, err, ErrExcludedFlag)
+
+		var ruleErr FlagRuleError
+
+		require.ErrorAs(t, err, &ruleErr)
+		assert.Equal(t, TagLogicV0, ruleErr.Tag)
+		assert.Equal(t, "auxiliary-not-systemic", ruleErr.Rule)
+
+		_, err = GenerateLogicIDv0(RandomFingerprint(), 0, 0, Systemic)
+		require.NoError(t, err)
+
+		invalid := LogicID{byte(TagLogicV0), 0b10000100}
+		require.ErrorIs(t, invalid.Validate(), ErrExcludedFlag)
+		require.ErrorIs(t, invalid.AsIdentifier().Validate(), ErrExcludedFlag)
+	})
+
+	t.Run("Requires", func(t *testing.T) {
+		_, err := GenerateLogicIDv1(RandomFingerprint(), 0, 0, LogicImmutable)
+		require.ErrorIs(t, err, ErrRequiredFlag)
+		require.EqualError(t, errors.Unwrap(err), "required flag not set: logic-immutable requires logic-intrinsic")
+
+		logic, err := GenerateLogicIDv1(RandomFingerprint(), 0, 0, LogicImmutable, LogicIntrinsic)
+		require.NoError(t, err)
+		require.NoError(t, logic.Validate())
+
+		// Derivation re-validates the derived identifier
+		_, err = logic.Derive(WithFlagsUnset(LogicIntrinsic))
+		require.ErrorIs(t, err, ErrRequiredFlag)
+	})
+
+	t.Run("Check", func(t *testing.T) {
+		_, err := GenerateAssetIDv0(RandomFingerprint(), 0, 0, AssetLogical)
+		require.EqualError(t, err,
+			`invalid flags: rule "logical-standard" violated for asset id: asset-logical requires a non-zero standard`)
+
+		_, err = GenerateAssetIDv1(RandomFingerprint(), 0, 0, 0, AssetLogical)
+		require.ErrorIs(t, err, ErrFlagRule)
+
+		asset, err := GenerateAssetIDv1(RandomFingerprint(), 0, 1, 0, AssetLogical)
+		require.NoError(t, err)
+		require.NoError(t, asset.Validate())
+
+		require.ErrorIs(t, AssetID{byte(TagAssetV0), 0b00000010}.Validate(), ErrFlagRule)
+	})
+}
+
+func TestFlagRules_AllKinds(t *testing.T) {
+	errSystemic := errors.New("systemic identifiers are disallowed")
+
+	for _, kind := range AllKinds() {
+		require.NoError(t, RegisterFlagRule(kind, FlagRule{
+			Name: "no-systemic", Flag: Systemic,
+			Check: func(Identifier) error { return errSystemic },
+		}))
+	}
+
+	t.Cleanup(func() {
+		for _, kind := range AllKinds() {
+			unregisterFlagRules(t, kind)
+		}
+	})
+
+	participant := RandomParticipantIDv0()
+	interaction := must(GenerateInteractionIDv0(participant, 1))
+
+	errs := map[string]error{
+		"ParticipantV0": generationError(GenerateParticipantIDv0(RandomFingerprint(), 0, Systemic)),
+		"ParticipantV1": generationError(GenerateParticipantIDv1(RandomFingerprint(), 0, Systemic)),
+		"AssetV0":       generationError(GenerateAssetIDv0(RandomFingerprint(), 0, 0, Systemic)),
+		"AssetV1":       generationError(GenerateAssetIDv1(RandomFingerprint(), 0, 0, 0, Systemic)),
+		"LogicV0":       generationError(GenerateLogicIDv0(RandomFingerprint(), 0, 0, Systemic)),
+		"LogicV1":       generationError(GenerateLogicIDv1(RandomFingerprint(), 0, 0, Systemic)),
+		"Interaction":   generationError(GenerateInteractionIDv0(participant, 2, Systemic)),
+		"Tesseract":     generationError(GenerateTesseractIDv0(RandomFingerprint(), 0, Systemic)),
+		"Group":         generationError(GenerateGroupIDv0([]ParticipantID{participant}, 0, Systemic)),
+		"File":          generationError(GenerateFileIDv0([32]byte{1}, 0, Systemic)),
+		"Receipt":       generationError(GenerateReceiptIDv0(interaction, 0, Systemic)),
+		"Topic":         generationError(GenerateTopicIDv0(RandomLogicIDv0(), "Transfer()", Systemic)),
+		"Key":           generationError(GenerateKeyIDv0(participant, 0, Systemic)),
+		"Domain":        generationError(GenerateDomainIDv0FromName("moi", Systemic)),
+	}
+
+	for name, err := range errs {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, err, errSystemic)
+			require.ErrorIs(t, err, ErrFlagRule)
+		})
+	}
+
+	validators := map[IdentifierKind]func(Identifier) error{
+		KindParticipant: func(id Identifier) error { return ParticipantID(id).Validate() },
+		KindAsset:       func(id Identifier) error { return AssetID(id).Validate() },
+		KindLogic:       func(id Identifier) error { return LogicID(id).Validate() },
+		KindInteraction: func(id Identifier) error { return InteractionID(id).Validate() },
+		KindTesseract:   func(id Identifier) error { return TesseractID(id).Validate() },
+		KindGroup:       func(id Identifier) error { return GroupID(id).Validate() },
+		KindFile:        func(id Identifier) error { return FileID(id).Validate() },
+		KindReceipt:     func(id Identifier) error { return ReceiptID(id).Validate() },
+		KindTopic:       func(id Identifier) error { return TopicID(id).Validate() },
+		KindKey:         func(id Identifier) error { return KeyID(id).Validate() },
+		KindDomain:      func(id Identifier) error { return DomainID(id).Validate() },
+	}
+
+	for kind, validate := range validators {
+		t.Run(kind.String(), func(t *testing.T) {
+			id := Identifier{byte(MustTag(kind, 0)), 0b10000000}
+			require.ErrorIs(t, validate(id), errSystemic)
+			require.NoError(t, validate(Identifier{byte(MustTag(kind, 0))}))
+		})
+	}
+
+	t.Run("Multisig", func(t *testing.T) {
+		unregisterFlagRules(t, KindParticipant)
+		require.NoError(t, RegisterFlagRule(KindParticipant, FlagRule{
+			Name: "multisig-threshold", Flag: ParticipantMultisig,
+			Check: func(id Identifier) error {
+				if id.Metadata()[0] < 2 {
+					return errors.New("threshold must be at least 2")
+				}
+
+				return nil
+			},
+		}))
+
+		members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0()}
+
+		_, err := GenerateMultisigParticipantID(members, 1, 0)
+		require.ErrorIs(t, err, ErrFlagRule)
+
+		_, err = GenerateMultisigParticipantID(members, 2, 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("CustomKind", func(t *testing.T) {
+		err := FlagRuleError{Tag: 0xE0, Rule: "custom", Err: errSystemic}
+		assert.EqualError(t, err, `invalid flags: rule "custom" violated for identifier: systemic identifiers are disallowed`)
+	})
+}
+
+// generationError returns the error from the results of a generator
+func generationError[T ~[32]byte](_ T, err error) error {
+	return err
+}
@@ -1,5 +1,11 @@
 package identifiers
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 // Every identifier reserves its second byte (index 1) for some bit flags.
 // These flags are used to provide additional information about the identifier.
 // The flag indices start at 7 for the MSB and end at 0 for the LSB.
@@ -7,57 +13,174 @@ package identifiers
 var (
 	// Systemic is a Flag for the MSB on all identifiers flags regardless of the kind.
 	// It indicates that the account associated with identifier belongs to the system.
-	// Supported from v0 for all identifiers
-	Systemic = Flag{
-		index: 7,
-		support: map[IdentifierKind]uint8{
-			KindParticipant: 0,
-			KindAsset:       0,
-			KindLogic:       0,
-		},
-	}
+	// Supported from v0 for all identifiers, including custom kinds registered with RegisterKind
+	Systemic = declareFlag("systemic", 7, map[IdentifierKind]uint8{
+		KindParticipant: 0,
+		KindAsset:       0,
+		KindLogic:       0,
+		KindInteraction: 0,
+		KindTesseract:   0,
+		KindGroup:       0,
+		KindFile:        0,
+		KindReceipt:     0,
+		KindTopic:       0,
+		KindKey:         0,
+		KindDomain:      0,
+	})
+
+	// Nested is a Flag for the 6th bit on AssetID and LogicID flags.
+	// It indicates that the identifier is a child of another identifier of the same account,
+	// with the variant ID of its parent and its child index encoded into its variant ID.
+	// Supported from v0 of AssetID and LogicID
+	Nested = declareFlag("nested", 6, map[IdentifierKind]uint8{
+		KindAsset: 0,
+		KindLogic: 0,
+	})
+
+	// ParticipantMultisig is a Flag on ParticipantID for the Multisig flag on its 0th bit.
+	// It indicates that the participant is an m-of-n threshold account, with the
+	// threshold (m) and the number of members (n) encoded into its metadata.
+	// Supported from v0 of ParticipantID
+	ParticipantMultisig = makeFlag("participant-multisig", KindParticipant, 0, 0)
+	// ParticipantGuardian is a Flag on ParticipantID for the Guardian flag on its 1st bit.
+	// It indicates that the participant acts as a guardian, which can recover or protect other accounts.
+	// Supported from v1 of ParticipantID
+	ParticipantGuardian = makeFlag("participant-guardian", KindParticipant, 1, 1)
+	// ParticipantContractual is a Flag on ParticipantID for the Contractual flag on its 2nd bit.
+	// It indicates that the participant is controlled by a contract (logic) rather than a key holder.
+	// Supported from v1 of ParticipantID
+	ParticipantContractual = makeFlag("participant-contractual", KindParticipant, 2, 1)
 
 	// AssetStateful is a Flag on AssetID for the Stateful flag on its 0th bit.
 	// It indicates that the asset has some stateful information such as its supply.
 	// Supported from v0 of AssetID
-	AssetStateful = makeFlag(KindAsset, 0, 0)
+	AssetStateful = makeFlag("asset-stateful", KindAsset, 0, 0)
 	// AssetLogical is a Flag on AssetID for the Logical flag on its 1st bit.
 	// It indicates that the asset has some logic associated with it.
 	// Supported from v0 of AssetID
-	AssetLogical = makeFlag(KindAsset, 1, 0)
+	AssetLogical = makeFlag("asset-logical", KindAsset, 1, 0)
+	// AssetFungible is a Flag on AssetID for the Fungible flag on its 2nd bit.
+	// It indicates that the units of the asset are interchangeable with each other.
+	// Supported from v1 of AssetID
+	AssetFungible = makeFlag("asset-fungible", KindAsset, 2, 1)
+	// AssetMintable is a Flag on AssetID for the Mintable flag on its 3rd bit.
+	// It indicates that the supply of the asset can be increased after its creation.
+	// Supported from v1 of AssetID
+	AssetMintable = makeFlag("asset-mintable", KindAsset, 3, 1)
+	// AssetPausable is a Flag on AssetID for the Pausable flag on its 4th bit.
+	// It indicates that transfers of the asset can be paused by its controller.
+	// Supported from v1 of AssetID
+	AssetPausable = makeFlag("asset-pausable", KindAsset, 4, 1)
 
 	// LogicIntrinsic is a Flag on LogicID for the Intrinsic flag on its 0th bit.
 	// It indicates that the logic manages some intrinsic state
 	// Supported from v0 of LogicID
-	LogicIntrinsic = makeFlag(KindLogic, 0, 0)
+	LogicIntrinsic = makeFlag("logic-intrinsic", KindLogic, 0, 0)
 	// LogicExtrinsic is a Flag on LogicID for the Extrinsic flag on its 1st bit.
 	// It indicates that the logic manages some extrinsic state
 	// Supported from v0 of LogicID
-	LogicExtrinsic = makeFlag(KindLogic, 1, 0)
+	LogicExtrinsic = makeFlag("logic-extrinsic", KindLogic, 1, 0)
 	// LogicAuxiliary is a Flag on LogicID for the Auxiliary flag on its 2nd bit.
 	// It indicates that the logic is attached as an auxiliary to another object.
 	// Supported from v0 of LogicID
-	LogicAuxiliary = makeFlag(KindLogic, 2, 0)
+	LogicAuxiliary = makeFlag("logic-auxiliary", KindLogic, 2, 0)
+	// LogicImmutable is a Flag on LogicID for the Immutable flag on its 3rd bit.
+	// It indicates that the logic cannot be upgraded once it is deployed.
+	// Supported from v0 of LogicID
+	LogicImmutable = makeFlag("logic-immutable", KindLogic, 3, 0)
+
+	// GroupThresholded is a Flag on GroupID for the Thresholded flag on its 0th bit.
+	// It indicates that actions of the group require approval from a threshold of its members.
+	// Supported from v0 of GroupID
+	GroupThresholded = makeFlag("group-thresholded", KindGroup, 0, 0)
+
+	// FileImmutable is a Flag on FileID for the Immutable flag on its 0th bit.
+	// It indicates that the content of the file cannot be modified.
+	// Supported from v0 of FileID
+	FileImmutable = makeFlag("file-immutable", KindFile, 0, 0)
+
+	// KeySigning is a Flag on KeyID for the Signing flag on its 0th bit.
+	// It indicates that the key can be used for signing.
+	// Supported from v0 of KeyID
+	KeySigning = makeFlag("key-signing", KindKey, 0, 0)
+	// KeyEncryption is a Flag on KeyID for the Encryption flag on its 1st bit.
+	// It indicates that the key can be used for encryption.
+	// Supported from v0 of KeyID
+	KeyEncryption = makeFlag("key-encryption", KindKey, 1, 0)
 )
 
+// builtinFlags is the list of all flags defined by this package.
+// Custom flags registered with RegisterFlag are tracked separately.
+var builtinFlags = []Flag{
+	Systemic, Nested,
+	ParticipantMultisig, ParticipantGuardian, ParticipantContractual,
+	AssetStateful, AssetLogical, AssetFungible, AssetMintable, AssetPausable,
+	LogicIntrinsic, LogicExtrinsic, LogicAuxiliary, LogicImmutable,
+	GroupThresholded,
+	FileImmutable,
+	KeySigning, KeyEncryption,
+}
+
 // Flag represents a flag specifier for an identifier.
 type Flag struct {
+	// the name of the flag
+	name string
 	// the bit index of the flag
 	index uint8
-	// the supported identifier kinds mapped to minimum supported version
-	support map[IdentifierKind]uint8
+	// the position of the flag in the flag support table of the registry (see registryTables),
+	// which starts at 1 so that the zero Flag is not supported by any kind
+	slot uint16
 }
 
 // Supports returns if the flag is supported by the given kind.
+// It reads the current registry snapshot and does not acquire any locks.
 func (flag Flag) Supports(tag IdentifierTag) bool {
-	// Check if the kind is supported by the flag & obtain version
-	version, ok := flag.support[tag.Kind()]
-	if !ok {
-		return false
+	return registry.Load().supportsFlag(flag, tag)
+}
+
+// Name returns the name of the flag, such as "systemic" or "asset-stateful".
+// Custom flags are named with the (lowercase) name they were registered with.
+func (flag Flag) Name() string {
+	return flag.name
+}
+
+// String returns the name of the flag (see Flag.Name).
+// Flags without a name are rendered as "flag(<index>)".
+func (flag Flag) String() string {
+	if flag.name == "" {
+		return fmt.Sprintf("flag(%d)", flag.index)
+	}
+
+	return flag.name
+}
+
+// ParseFlag returns the flag (including custom flags) with the given name, such as "asset-stateful".
+// The name is case-insensitive. Returns an error that wraps ErrUnsupportedFlag if no flag has the name.
+func ParseFlag(name string) (Flag, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	if flag, ok := lookupFlag(name); ok {
+		return flag, nil
+	}
+
+	return Flag{}, fmt.Errorf("%w: %q", ErrUnsupportedFlag, name)
+}
+
+// lookupFlag returns the flag (including custom flags) with the given case-insensitive
+// name and whether it was found. Must be called while holding a lock of registryLock.
+func lookupFlag(name string) (Flag, bool) {
+	name = strings.ToLower(name)
+
+	for _, flag := range builtinFlags {
+		if flag.name == name {
+			return flag, true
+		}
 	}
 
-	// Check if the version is supported by flag
-	return tag.Version() >= version
+	flag, ok := customFlags[name]
+
+	return flag, ok
 }
 
 // getFlag retrieves a flag value from a given flag set and an index.
@@ -82,30 +205,100 @@ func setFlag(value byte, index uint8, flag bool) byte {
 	return value
 }
 
-// makeFlag is used to construct a valid Flag object
-// which is only supported by a single IdentifierKind
-func makeFlag(kind IdentifierKind, index uint8, version uint8) Flag {
+// flagUnsupported is the minimum version of a flag in the flag support tables for kinds that do not support it
+const flagUnsupported = 0xFF
+
+// defaultFlagSupport is the minimum supported version of each builtin flag for each kind, indexed by the slot
+// of the flag and then by kind. It is the initial state of the flag support table of the registry, to which
+// custom flags are added with RegisterFlag. Slot 0 is reserved for the zero Flag, which is never supported.
+var defaultFlagSupport = [][16]uint8{unsupportedKinds()}
+
+// unsupportedKinds returns a row of a flag support table for a flag that is not supported by any kind
+func unsupportedKinds() [16]uint8 {
+	var support [16]uint8
+	for kind := range support {
+		support[kind] = flagUnsupported
+	}
+
+	return support
+}
+
+// declareFlag is used to construct a valid builtin Flag that is supported from the given minimum
+// version of each of the given kinds. The support of the flag is added to defaultFlagSupport,
+// so it must only be used to declare the builtin flags during package initialization.
+func declareFlag(name string, index uint8, support map[IdentifierKind]uint8) Flag {
 	if index > 7 {
 		panic("invalid flag location: must be between 0 and 7")
 	}
 
-	if version > 15 {
-		panic("invalid flag version: must be between 0 and 7")
-	}
+	row := unsupportedKinds()
+
+	for kind, version := range support {
+		if version > 15 {
+			panic("invalid flag version: must be between 0 and 15")
+		}
 
-	return Flag{
-		index:   index,
-		support: map[IdentifierKind]uint8{kind: version},
+		row[kind] = version
 	}
+
+	defaultFlagSupport = append(defaultFlagSupport, row)
+
+	return Flag{name: name, index: index, slot: uint16(len(defaultFlagSupport) - 1)}
+}
+
+// makeFlag is used to construct a valid builtin Flag
+// which is only supported by a single IdentifierKind
+func makeFlag(name string, kind IdentifierKind, index uint8, version uint8) Flag {
+	return declareFlag(name, index, map[IdentifierKind]uint8{kind: version})
 }
 
-// flagMasks represent the mask of supported flags for an IdentifierTag.
-// Can be accessed with IdentifierTag.FlagMask().
+// defaultFlagMasks represent the mask of supported flags for each builtin IdentifierTag.
+// It is the initial state of the registry tables, which can be accessed with IdentifierTag.FlagMask().
+// Custom kinds can add masks for their tags with RegisterKind, and custom flags can unmask
+// their bits with RegisterFlag.
 //
 // A set bit indicates that position is not allowed for the tag,
 // While an unset bit indicates it is a supported flag for the tag.
-var flagMasks = map[IdentifierTag]byte{
-	TagParticipantV0: 0b01111111,
-	TagLogicV0:       0b01111000,
-	TagAssetV0:       0b01111100,
+var defaultFlagMasks = map[IdentifierTag]byte{
+	TagParticipantV0: 0b01111110,
+	TagLogicV0:       0b00110000,
+	TagAssetV0:       0b00111100,
+	TagInteractionV0: 0b01111111,
+	TagTesseractV0:   0b01111111,
+	TagGroupV0:       0b01111110,
+	TagFileV0:        0b01111110,
+	TagReceiptV0:     0b01111111,
+	TagTopicV0:       0b01111111,
+	TagKeyV0:         0b01111100,
+	TagDomainV0:      0b01111111,
+
+	TagParticipantV1: 0b01111000,
+	TagLogicV1:       0b00110000,
+	TagAssetV1:       0b00100000,
+}
+
+// FlagMask returns the mask of unsupported flags for the IdentifierTag.
+// A set bit indicates that position is not allowed for the tag, while an unset bit
+// indicates it is a supported flag. Unrecognized tags do not support any flags.
+func (tag IdentifierTag) FlagMask() byte {
+	return registry.Load().flagMasks[tag]
+}
+
+// SupportedFlags returns all flags (including custom flags) that
+// are supported by the IdentifierTag, ordered by their bit index.
+func (tag IdentifierTag) SupportedFlags() []Flag {
+	registryLock.RLock()
+	flags := slices.Clone(builtinFlags)
+	for _, flag := range customFlags {
+		flags = append(flags, flag)
+	}
+	registryLock.RUnlock()
+
+	// Remove flags that are not supported by the tag
+	tables := registry.Load()
+	flags = slices.DeleteFunc(flags, func(flag Flag) bool { return !tables.supportsFlag(flag, tag) })
+	// Sort the flags by their bit index
+	slices.SortFunc(flags, func(a, b Flag) int { return int(a.index) - int(b.index) })
+
+	return flags
 }
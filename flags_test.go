@@ -67,37 +67,132 @@ func TestSetFlag(t *testing.T) {
 }
 
 func TestMakeFlag(t *testing.T) {
+	// Invalid flags are rejected before their support is declared
+	require.Panics(t, func() { makeFlag("test", KindLogic, 10, 1) })
+	require.Panics(t, func() { makeFlag("test", KindLogic, 1, 20) })
+
+	// Test that every builtin flag has its own slot in the flag support table
+	slots := make(map[uint16]bool, len(builtinFlags))
+
+	for _, flag := range builtinFlags {
+		assert.NotZero(t, flag.slot)
+		assert.False(t, slots[flag.slot])
+
+		slots[flag.slot] = true
+	}
+
+	assert.Equal(t, unsupportedKinds(), defaultFlagSupport[0])
+	assert.Equal(t, uint8(1), defaultFlagSupport[AssetFungible.slot][KindAsset])
+	assert.Equal(t, uint8(flagUnsupported), defaultFlagSupport[AssetFungible.slot][KindLogic])
+}
+
+func TestFlag_Supports(t *testing.T) {
+	assert.True(t, AssetFungible.Supports(TagAssetV1))
+	assert.False(t, AssetFungible.Supports(TagAssetV0))
+	assert.False(t, AssetFungible.Supports(TagLogicV1))
+	assert.True(t, Systemic.Supports(TagTopicV0))
+
+	// The zero Flag and flags from unknown slots are not supported by any tag
+	assert.False(t, Flag{}.Supports(TagParticipantV0))
+	assert.False(t, Flag{slot: 1 << 15}.Supports(TagAssetV0))
+
+	t.Run("CustomKind", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		// Copies of a flag that were made before registration are not modified by it
+		systemic := Systemic
+		before := registry.Load()
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{FlagMasks: []byte{0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		assert.Equal(t, Systemic, systemic)
+		assert.True(t, systemic.Supports(IdentifierTag(kindCustom<<4)))
+
+		// Earlier snapshots of the registry are not modified by the registration
+		assert.False(t, before.supportsFlag(Systemic, IdentifierTag(kindCustom<<4)))
+	})
+}
+
+func TestFlag_Name(t *testing.T) {
+	for _, flag := range builtinFlags {
+		assert.NotEmpty(t, flag.Name())
+		assert.Equal(t, flag.Name(), flag.String())
+		assert.Equal(t, flag, must(ParseFlag(flag.Name())))
+	}
+
+	assert.Equal(t, "systemic", Systemic.Name())
+	assert.Equal(t, "asset-stateful", AssetStateful.String())
+	assert.Equal(t, "", Flag{}.Name())
+	assert.Equal(t, "flag(3)", Flag{index: 3}.String())
+}
+
+func TestParseFlag(t *testing.T) {
+	flag, err := ParseFlag("Logic-Auxiliary")
+	require.NoError(t, err)
+	assert.Equal(t, LogicAuxiliary, flag)
+
+	_, err = ParseFlag("unknown")
+	require.EqualError(t, err, `unsupported flag: "unknown"`)
+	require.ErrorIs(t, err, ErrUnsupportedFlag)
+
+	t.Run("CustomFlag", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{FlagMasks: []byte{0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		custom, err := RegisterFlag("Custom-Parsed", kindCustom, 1, 0)
+		require.NoError(t, err)
+		t.Cleanup(func() { delete(customFlags, "custom-parsed") })
+
+		assert.Equal(t, "custom-parsed", custom.Name())
+		assert.Equal(t, custom, must(ParseFlag("CUSTOM-PARSED")))
+	})
+}
+
+func TestIdentifierTag_FlagMask(t *testing.T) {
+	assert.Equal(t, byte(0b00111100), TagAssetV0.FlagMask())
+	// Unrecognized tags do not support any flags
+	assert.Equal(t, byte(0xFF), IdentifierTag(0xF0).FlagMask())
+}
+
+func TestIdentifierTag_SupportedFlags(t *testing.T) {
 	tests := []struct {
-		kind    IdentifierKind
-		index   uint8
-		version uint8
-		want    Flag
+		tag   IdentifierTag
+		flags []Flag
 	}{
-		{
-			KindParticipant, 0, 0,
-			Flag{index: 0, support: map[IdentifierKind]uint8{KindParticipant: 0}},
-		},
-		{
-			KindAsset, 1, 1,
-			Flag{index: 1, support: map[IdentifierKind]uint8{KindAsset: 1}},
-		},
-		{
-			KindLogic, 10, 1,
-			Flag{index: 1, support: map[IdentifierKind]uint8{KindAsset: 1}},
-		},
-		{
-			KindLogic, 1, 20,
-			Flag{index: 1, support: map[IdentifierKind]uint8{KindAsset: 1}},
-		},
+		{TagParticipantV0, []Flag{ParticipantMultisig, Systemic}},
+		{TagParticipantV1, []Flag{ParticipantMultisig, ParticipantGuardian, ParticipantContractual, Systemic}},
+		{TagAssetV0, []Flag{AssetStateful, AssetLogical, Nested, Systemic}},
+		{TagAssetV1, []Flag{AssetStateful, AssetLogical, AssetFungible, AssetMintable, AssetPausable, Nested, Systemic}},
+		{TagLogicV0, []Flag{LogicIntrinsic, LogicExtrinsic, LogicAuxiliary, LogicImmutable, Nested, Systemic}},
+		{TagKeyV0, []Flag{KeySigning, KeyEncryption, Systemic}},
+		{TagTopicV0, []Flag{Systemic}},
+		{IdentifierTag(0xF0), []Flag{}},
 	}
 
 	for _, tt := range tests {
-		if tt.index > 7 || tt.version > 15 {
-			require.Panics(t, func() {
-				makeFlag(tt.kind, tt.index, tt.version)
-			})
-		} else {
-			assert.Equal(t, tt.want, makeFlag(tt.kind, tt.index, tt.version))
-		}
+		t.Run(tt.tag.String(), func(t *testing.T) {
+			assert.Equal(t, tt.flags, tt.tag.SupportedFlags())
+
+			// Every supported flag must be unmasked for the tag
+			for _, flag := range tt.flags {
+				assert.False(t, getFlag(tt.tag.FlagMask(), flag.index))
+			}
+		})
 	}
+
+	t.Run("CustomFlag", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{FlagMasks: []byte{0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		custom, err := RegisterFlag("custom-flag", kindCustom, 2, 0)
+		require.NoError(t, err)
+		t.Cleanup(func() { delete(customFlags, "custom-flag") })
+
+		assert.Equal(t, []Flag{custom, Systemic}, IdentifierTag(kindCustom<<4).SupportedFlags())
+	})
 }
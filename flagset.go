@@ -0,0 +1,92 @@
+package identifiers
+
+// FlagSet is the set of flags of an identifier, which wraps the raw flag byte (the 2nd byte of
+// an identifier) with the IdentifierTag that determines the meaning and support of its bits.
+// A FlagSet is a value type, and its methods return a modified copy instead of modifying it.
+type FlagSet struct {
+	tag   IdentifierTag
+	flags byte
+}
+
+// NewFlagSet creates a FlagSet for the given tag from the given raw flag byte.
+// The raw byte is not validated, which can be done with FlagSet.Validate.
+func NewFlagSet(tag IdentifierTag, flags byte) FlagSet {
+	return FlagSet{tag: tag, flags: flags}
+}
+
+// FlagSet returns the FlagSet of the Identifier
+func (id Identifier) FlagSet() FlagSet {
+	return NewFlagSet(id.Tag(), id.Flags())
+}
+
+// Tag returns the IdentifierTag of the FlagSet
+func (set FlagSet) Tag() IdentifierTag {
+	return set.tag
+}
+
+// Byte returns the raw flag byte of the FlagSet
+func (set FlagSet) Byte() byte {
+	return set.flags
+}
+
+// Has returns if the given Flag is set in the FlagSet.
+//
+// If the specified flag is not supported by the tag of the FlagSet,
+// it will return False, regardless of the actual flag value.
+func (set FlagSet) Has(flag Flag) bool {
+	if !flag.Supports(set.tag) {
+		return false
+	}
+
+	return getFlag(set.flags, flag.index)
+}
+
+// Set returns a copy of the FlagSet with the given flags set.
+// Returns ErrUnsupportedFlag if any of the flags are not supported by the tag of the FlagSet.
+func (set FlagSet) Set(flags ...Flag) (FlagSet, error) {
+	return set.update(flags, true)
+}
+
+// Unset returns a copy of the FlagSet with the given flags unset.
+// Returns ErrUnsupportedFlag if any of the flags are not supported by the tag of the FlagSet.
+func (set FlagSet) Unset(flags ...Flag) (FlagSet, error) {
+	return set.update(flags, false)
+}
+
+// update returns a copy of the FlagSet with the given flags set or unset
+func (set FlagSet) update(flags []Flag, value bool) (FlagSet, error) {
+	for _, flag := range flags {
+		// Check if the given flag is supported by the tag
+		if !flag.Supports(set.tag) {
+			return FlagSet{}, ErrUnsupportedFlag
+		}
+
+		set.flags = setFlag(set.flags, flag.index, value)
+	}
+
+	return set, nil
+}
+
+// List returns the flags (including custom flags) that are set in the FlagSet, ordered by their bit index.
+// Set bits that are not supported by the tag of the FlagSet are not listed.
+func (set FlagSet) List() []Flag {
+	flags := make([]Flag, 0, 8)
+
+	for _, flag := range set.tag.SupportedFlags() {
+		if getFlag(set.flags, flag.index) {
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags
+}
+
+// Validate returns an error if the FlagSet has any flags set that are not supported by its tag.
+// Returns a BadFlagsError (which wraps ErrBadFlags) if there are unsupported flags.
+func (set FlagSet) Validate() error {
+	if set.flags&set.tag.FlagMask() != 0 {
+		return BadFlagsError{Tag: set.tag}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagSet(t *testing.T) {
+	set := NewFlagSet(TagAssetV0, 0b10000001)
+
+	assert.Equal(t, TagAssetV0, set.Tag())
+	assert.Equal(t, byte(0b10000001), set.Byte())
+	assert.True(t, set.Has(Systemic))
+	assert.True(t, set.Has(AssetStateful))
+	assert.False(t, set.Has(AssetLogical))
+	// Unsupported flags are never set, regardless of the bit value
+	assert.False(t, set.Has(LogicIntrinsic))
+
+	assert.Equal(t, []Flag{AssetStateful, Systemic}, set.List())
+	assert.NoError(t, set.Validate())
+
+	t.Run("Identifier", func(t *testing.T) {
+		asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0, AssetLogical))
+		assert.Equal(t, NewFlagSet(TagAssetV0, 0b00000010), asset.AsIdentifier().FlagSet())
+	})
+
+	t.Run("SetUnset", func(t *testing.T) {
+		updated, err := set.Set(AssetLogical, Nested)
+		require.NoError(t, err)
+		assert.Equal(t, byte(0b11000011), updated.Byte())
+		// The original set is not modified
+		assert.Equal(t, byte(0b10000001), set.Byte())
+
+		updated, err = updated.Unset(Systemic, AssetStateful)
+		require.NoError(t, err)
+		assert.Equal(t, []Flag{AssetLogical, Nested}, updated.List())
+
+		_, err = set.Set(KeySigning)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+
+		_, err = set.Unset(AssetStateful, ParticipantMultisig)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := NewFlagSet(TagAssetV0, 0b00100001)
+		assert.Equal(t, []Flag{AssetStateful}, invalid.List())
+
+		err := invalid.Validate()
+		require.EqualError(t, err, "invalid flags: unsupported flags for asset id")
+		require.ErrorIs(t, err, ErrBadFlags)
+
+		// Unrecognized tags do not support any flags
+		assert.Empty(t, NewFlagSet(IdentifierTag(0xF0), 0b10000000).List())
+		assert.ErrorIs(t, NewFlagSet(IdentifierTag(0xF0), 0b10000000).Validate(), ErrBadFlags)
+		assert.NoError(t, NewFlagSet(IdentifierTag(0xF0), 0).Validate())
+	})
+}
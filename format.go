@@ -0,0 +1,215 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// shortHex returns the hex encoding of the given identifier with the 0x prefix, truncated to the given
+// number of leading and trailing hex characters which are separated by an ellipsis, such as 0x2001…0042.
+// At least the 2 leading characters of the tag byte are always included. Negative lengths are treated as
+// zero, and the full hex encoding is returned if the lengths cover the entire identifier.
+func shortHex(id [32]byte, prefixLen, suffixLen int) string {
+	encoded := hex.EncodeToString(id[:])
+
+	prefixLen = max(prefixLen, 2)
+	suffixLen = max(suffixLen, 0)
+
+	if prefixLen+suffixLen >= len(encoded) {
+		return prefix0xString + encoded
+	}
+
+	return prefix0xString + encoded[:prefixLen] + "…" + encoded[len(encoded)-suffixLen:]
+}
+
+// ShortHex returns the Identifier as a hex string with the 0x prefix, truncated to the given number of
+// leading and trailing hex characters, such as 0x2001…0042 for ShortHex(4, 4). It is intended for
+// dashboards and logs, and always includes the tag byte. The full hex string is returned if the
+// lengths cover the entire identifier.
+func (id Identifier) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(id, prefixLen, suffixLen)
+}
+
+// ShortHex returns the ParticipantID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (participant ParticipantID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(participant, prefixLen, suffixLen)
+}
+
+// ShortHex returns the AssetID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (asset AssetID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(asset, prefixLen, suffixLen)
+}
+
+// ShortHex returns the LogicID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (logic LogicID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(logic, prefixLen, suffixLen)
+}
+
+// ShortHex returns the InteractionID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (interaction InteractionID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(interaction, prefixLen, suffixLen)
+}
+
+// ShortHex returns the TesseractID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (tesseract TesseractID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(tesseract, prefixLen, suffixLen)
+}
+
+// ShortHex returns the GroupID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (group GroupID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(group, prefixLen, suffixLen)
+}
+
+// ShortHex returns the FileID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (file FileID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(file, prefixLen, suffixLen)
+}
+
+// ShortHex returns the ReceiptID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (receipt ReceiptID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(receipt, prefixLen, suffixLen)
+}
+
+// ShortHex returns the TopicID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (topic TopicID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(topic, prefixLen, suffixLen)
+}
+
+// ShortHex returns the KeyID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (key KeyID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(key, prefixLen, suffixLen)
+}
+
+// ShortHex returns the DomainID as a truncated hex string with the 0x prefix, as described in Identifier.ShortHex
+func (domain DomainID) ShortHex(prefixLen, suffixLen int) string {
+	return shortHex(domain, prefixLen, suffixLen)
+}
+
+// formatIdentifier implements fmt.Formatter for an identifier with the given type name and GoString method.
+// It supports the following verbs, with any width being used to pad the output with spaces:
+//   - %s and %v print the hex encoding with the 0x prefix. A precision such as %.8s prints
+//     a shortened form with that many leading and trailing hex characters (see Identifier.ShortHex).
+//   - %x and %X print the lower and upper case hex encoding without the 0x prefix,
+//     which is added with the # flag (%#x). A precision is not supported.
+//   - %q prints the hex encoding as a quoted string, which can also be shortened with a precision.
+//   - %#v prints the identifier as Go syntax with its GoString method.
+func formatIdentifier(state fmt.State, verb rune, name string, id [32]byte, goString func() string) {
+	var output string
+
+	switch verb {
+	case 'v', 's', 'q':
+		if verb == 'v' && state.Flag('#') {
+			output = goString()
+
+			break
+		}
+
+		output = prefix0xString + hex.EncodeToString(id[:])
+		if precision, ok := state.Precision(); ok {
+			output = shortHex(id, precision, precision)
+		}
+
+		if verb == 'q' {
+			output = strconv.Quote(output)
+		}
+
+	case 'x', 'X':
+		output = hex.EncodeToString(id[:])
+		if verb == 'X' {
+			output = strings.ToUpper(output)
+		}
+
+		if state.Flag('#') {
+			output = prefix0xString + output
+		}
+
+	default:
+		output = fmt.Sprintf("%%!%c(identifiers.%s=%s%x)", verb, name, prefix0xString, id[:])
+	}
+
+	if width, ok := state.Width(); ok && width > len([]rune(output)) {
+		padding := strings.Repeat(" ", width-len([]rune(output)))
+
+		if state.Flag('-') {
+			output += padding
+		} else {
+			output = padding + output
+		}
+	}
+
+	_, _ = state.Write([]byte(output))
+}
+
+// Format implements the fmt.Formatter interface for the Identifier.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (id Identifier) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "Identifier", id, id.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the ParticipantID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (participant ParticipantID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "ParticipantID", participant, participant.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the AssetID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (asset AssetID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "AssetID", asset, asset.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the LogicID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (logic LogicID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "LogicID", logic, logic.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the InteractionID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (interaction InteractionID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "InteractionID", interaction, interaction.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the TesseractID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (tesseract TesseractID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "TesseractID", tesseract, tesseract.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the GroupID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (group GroupID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "GroupID", group, group.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the FileID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (file FileID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "FileID", file, file.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the ReceiptID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (receipt ReceiptID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "ReceiptID", receipt, receipt.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the TopicID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (topic TopicID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "TopicID", topic, topic.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the KeyID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (key KeyID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "KeyID", key, key.GoString)
+}
+
+// Format implements the fmt.Formatter interface for the DomainID.
+// The supported verbs are %s, %v, %x, %X, %q and %#v, with %.Ns printing a shortened form.
+func (domain DomainID) Format(state fmt.State, verb rune) {
+	formatIdentifier(state, verb, "DomainID", domain, domain.GoString)
+}
@@ -0,0 +1,88 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifier_ShortHex(t *testing.T) {
+	id := Identifier{0x20, 0x01, 30: 0x00, 31: 0x42}
+
+	assert.Equal(t, "0x2001…0042", id.ShortHex(4, 4))
+	assert.Equal(t, "0x20…42", id.ShortHex(0, 2))
+	assert.Equal(t, "0x20…", id.ShortHex(-1, -1))
+	assert.Equal(t, id.Hex(), id.ShortHex(32, 32))
+	assert.Equal(t, id.Hex(), id.ShortHex(60, 4))
+}
+
+func TestIdentifier_Format(t *testing.T) {
+	id := Identifier{0x20, 0xAB, 31: 0x42}
+	encoded := strings.TrimPrefix(id.Hex(), "0x")
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%s", "0x" + encoded},
+		{"%v", "0x" + encoded},
+		{"%x", encoded},
+		{"%X", strings.ToUpper(encoded)},
+		{"%#x", "0x" + encoded},
+		{"%q", `"0x` + encoded + `"`},
+		{"%.4s", "0x20ab…0042"},
+		{"%.4v", "0x20ab…0042"},
+		{"%.2q", `"0x20…42"`},
+		{"%#v", `identifiers.MustIdentifierFromHex("0x` + encoded + `")`},
+		{"%14.4s", "   0x20ab…0042"},
+		{"%-14.4s|", "0x20ab…0042   |"},
+		{"%4s", "0x" + encoded},
+		{"%d", "%!d(identifiers.Identifier=0x" + encoded + ")"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fmt.Sprintf(tt.format, id))
+		})
+	}
+}
+
+func TestFormat_AllTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		id   interface {
+			TaggedIdentifier
+			fmt.Formatter
+			ShortHex(prefixLen, suffixLen int) string
+		}
+	}{
+		{"ParticipantID", RandomParticipantIDv1()},
+		{"AssetID", RandomAssetIDv1()},
+		{"LogicID", RandomLogicIDv1()},
+		{"InteractionID", RandomInteractionIDv0()},
+		{"TesseractID", RandomTesseractIDv0()},
+		{"GroupID", RandomGroupIDv0()},
+		{"FileID", RandomFileIDv0()},
+		{"ReceiptID", RandomReceiptIDv0()},
+		{"TopicID", RandomTopicIDv0()},
+		{"KeyID", RandomKeyIDv0()},
+		{"DomainID", RandomDomainIDv0()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := tt.id.Hex()
+
+			assert.Equal(t, encoded, fmt.Sprintf("%s", tt.id))
+			assert.Equal(t, encoded, fmt.Sprintf("%v", tt.id))
+			assert.Equal(t, encoded[2:], fmt.Sprintf("%x", tt.id))
+			assert.Equal(t, encoded[:10]+"…"+encoded[58:], fmt.Sprintf("%.8s", tt.id))
+			assert.Equal(t, "identifiers.Must"+tt.name+"FromHex(\""+encoded+"\")", fmt.Sprintf("%#v", tt.id))
+			assert.Equal(t, "%!d(identifiers."+tt.name+"="+encoded+")", fmt.Sprintf("%d", tt.id))
+
+			assert.Equal(t, encoded[:6]+"…"+encoded[62:], tt.id.ShortHex(4, 4))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package identifiers
+
+import (
+	"fmt"
+)
+
+// AnyID is a type constraint that is satisfied by the Identifier and all identifier types.
+// It allows generic code to be written over identifier types, such as with ParseAs and FromBytesAs.
+type AnyID interface {
+	Identifier | ParticipantID | AssetID | LogicID | InteractionID | TesseractID |
+		GroupID | FileID | ReceiptID | TopicID | KeyID | DomainID
+}
+
+// ParseAs creates an identifier of the type T from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional), with a length of 64
+// characters (32 bytes) and validate into T, as with the NewXFromHex constructor of the type.
+// Like NewIdentifierFromHex, ParseAs[Identifier] does not validate the identifier.
+func ParseAs[T AnyID](data string) (T, error) {
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return T{}, err
+	}
+
+	return FromBytesAs[T](decoded)
+}
+
+// FromBytesAs creates an identifier of the type T from the given byte slice.
+// The given value must have a length of 32 and validate into T, as with the NewXFromBytes constructor
+// of the type. Like NewIdentifierFromHex, FromBytesAs[Identifier] does not validate the identifier.
+func FromBytesAs[T AnyID](data []byte) (T, error) {
+	if len(data) != 32 {
+		return T{}, fmt.Errorf("%w: identifier must be 32 bytes", ErrInvalidLength)
+	}
+
+	return newAs[T]([32]byte(data))
+}
+
+// newAs creates an identifier of the type T from the given 32-byte value with the constructor of the type
+func newAs[T AnyID](data [32]byte) (T, error) {
+	var (
+		id  any
+		err error
+	)
+
+	switch any(T{}).(type) {
+	case Identifier:
+		id = Identifier(data)
+	case ParticipantID:
+		id, err = NewParticipantID(data)
+	case AssetID:
+		id, err = NewAssetID(data)
+	case LogicID:
+		id, err = NewLogicID(data)
+	case InteractionID:
+		id, err = NewInteractionID(data)
+	case TesseractID:
+		id, err = NewTesseractID(data)
+	case GroupID:
+		id, err = NewGroupID(data)
+	case FileID:
+		id, err = NewFileID(data)
+	case ReceiptID:
+		id, err = NewReceiptID(data)
+	case TopicID:
+		id, err = NewTopicID(data)
+	case KeyID:
+		id, err = NewKeyID(data)
+	case DomainID:
+		id, err = NewDomainID(data)
+	}
+
+	if err != nil {
+		return T{}, err
+	}
+
+	return id.(T), nil //nolint:forcetypeassert // the type switch is exhaustive over AnyID
+}
@@ -0,0 +1,75 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testParseAs checks that ParseAs and FromBytesAs round trip the given identifier,
+// and reject an identifier of another kind with the same error as the constructor of T.
+func testParseAs[T AnyID](t *testing.T, id T, constructor func([32]byte) (T, error)) {
+	t.Helper()
+
+	raw := [32]byte(id)
+
+	parsed, err := ParseAs[T](Identifier(raw).Hex())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	parsed, err = FromBytesAs[T](raw[:])
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	// An identifier of another kind is rejected
+	other := [32]byte(RandomTesseractIDv0())
+	if Identifier(raw).Tag().Kind() == KindTesseract {
+		other = [32]byte(RandomAssetIDv0())
+	}
+
+	_, expected := constructor(other)
+	require.Error(t, expected)
+
+	_, err = FromBytesAs[T](other[:])
+	require.EqualError(t, err, expected.Error())
+}
+
+func TestParseAs(t *testing.T) {
+	t.Run("ParticipantID", func(t *testing.T) { testParseAs(t, RandomParticipantIDv1(), NewParticipantID) })
+	t.Run("AssetID", func(t *testing.T) { testParseAs(t, RandomAssetIDv1(), NewAssetID) })
+	t.Run("LogicID", func(t *testing.T) { testParseAs(t, RandomLogicIDv1(), NewLogicID) })
+	t.Run("InteractionID", func(t *testing.T) { testParseAs(t, RandomInteractionIDv0(), NewInteractionID) })
+	t.Run("TesseractID", func(t *testing.T) { testParseAs(t, RandomTesseractIDv0(), NewTesseractID) })
+	t.Run("GroupID", func(t *testing.T) { testParseAs(t, RandomGroupIDv0(), NewGroupID) })
+	t.Run("FileID", func(t *testing.T) { testParseAs(t, RandomFileIDv0(), NewFileID) })
+	t.Run("ReceiptID", func(t *testing.T) { testParseAs(t, RandomReceiptIDv0(), NewReceiptID) })
+	t.Run("TopicID", func(t *testing.T) { testParseAs(t, RandomTopicIDv0(), NewTopicID) })
+	t.Run("KeyID", func(t *testing.T) { testParseAs(t, RandomKeyIDv0(), NewKeyID) })
+	t.Run("DomainID", func(t *testing.T) { testParseAs(t, RandomDomainIDv0(), NewDomainID) })
+
+	t.Run("Identifier", func(t *testing.T) {
+		// Identifiers are not validated, like NewIdentifierFromHex
+		invalid := Identifier{0xF0, 31: 0x01}
+
+		parsed, err := ParseAs[Identifier](invalid.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, invalid, parsed)
+
+		parsed, err = FromBytesAs[Identifier](invalid.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, invalid, parsed)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseAs[AssetID]("0xzz")
+		require.Error(t, err)
+
+		_, err = ParseAs[AssetID]("0x0102")
+		require.ErrorIs(t, err, ErrInvalidLength)
+		require.EqualError(t, err, "invalid length: identifier must be 32 bytes")
+
+		_, err = FromBytesAs[LogicID](make([]byte, 31))
+		require.ErrorIs(t, err, ErrInvalidLength)
+	})
+}
@@ -0,0 +1,210 @@
+package identifiers
+
+import (
+	"encoding/gob"
+)
+
+// RegisterGob registers all identifier types (and Address) with encoding/gob.
+// This is required for identifiers to be encoded into interface-typed values with gob,
+// such as a TaggedIdentifier or an any field. It is safe to call RegisterGob multiple times.
+func RegisterGob() {
+	gob.Register(Identifier{})
+	gob.Register(ParticipantID{})
+	gob.Register(AssetID{})
+	gob.Register(LogicID{})
+	gob.Register(InteractionID{})
+	gob.Register(TesseractID{})
+	gob.Register(GroupID{})
+	gob.Register(FileID{})
+	gob.Register(ReceiptID{})
+	gob.Register(TopicID{})
+	gob.Register(KeyID{})
+	gob.Register(DomainID{})
+	gob.Register(Address{})
+}
+
+var (
+	// Ensure all identifiers implement the gob interfaces
+	_ gob.GobEncoder = Identifier{}
+	_ gob.GobDecoder = (*Identifier)(nil)
+	_ gob.GobEncoder = ParticipantID{}
+	_ gob.GobDecoder = (*ParticipantID)(nil)
+	_ gob.GobEncoder = AssetID{}
+	_ gob.GobDecoder = (*AssetID)(nil)
+	_ gob.GobEncoder = LogicID{}
+	_ gob.GobDecoder = (*LogicID)(nil)
+	_ gob.GobEncoder = InteractionID{}
+	_ gob.GobDecoder = (*InteractionID)(nil)
+	_ gob.GobEncoder = TesseractID{}
+	_ gob.GobDecoder = (*TesseractID)(nil)
+	_ gob.GobEncoder = GroupID{}
+	_ gob.GobDecoder = (*GroupID)(nil)
+	_ gob.GobEncoder = FileID{}
+	_ gob.GobDecoder = (*FileID)(nil)
+	_ gob.GobEncoder = ReceiptID{}
+	_ gob.GobDecoder = (*ReceiptID)(nil)
+	_ gob.GobEncoder = TopicID{}
+	_ gob.GobDecoder = (*TopicID)(nil)
+	_ gob.GobEncoder = KeyID{}
+	_ gob.GobDecoder = (*KeyID)(nil)
+	_ gob.GobEncoder = DomainID{}
+	_ gob.GobDecoder = (*DomainID)(nil)
+	_ gob.GobEncoder = Address{}
+	_ gob.GobDecoder = (*Address)(nil)
+)
+
+// GobEncode implements the gob.GobEncoder interface for Identifier.
+// The Identifier is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (id Identifier) GobEncode() ([]byte, error) {
+	return id.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for Identifier.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (id *Identifier) GobDecode(data []byte) error {
+	return id.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for ParticipantID.
+// The ParticipantID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (participant ParticipantID) GobEncode() ([]byte, error) {
+	return participant.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for ParticipantID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (participant *ParticipantID) GobDecode(data []byte) error {
+	return participant.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for AssetID.
+// The AssetID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (asset AssetID) GobEncode() ([]byte, error) {
+	return asset.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for AssetID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (asset *AssetID) GobDecode(data []byte) error {
+	return asset.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for LogicID.
+// The LogicID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (logic LogicID) GobEncode() ([]byte, error) {
+	return logic.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for LogicID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (logic *LogicID) GobDecode(data []byte) error {
+	return logic.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for InteractionID.
+// The InteractionID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (interaction InteractionID) GobEncode() ([]byte, error) {
+	return interaction.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for InteractionID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (interaction *InteractionID) GobDecode(data []byte) error {
+	return interaction.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for TesseractID.
+// The TesseractID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (tesseract TesseractID) GobEncode() ([]byte, error) {
+	return tesseract.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for TesseractID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (tesseract *TesseractID) GobDecode(data []byte) error {
+	return tesseract.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for GroupID.
+// The GroupID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (group GroupID) GobEncode() ([]byte, error) {
+	return group.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for GroupID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (group *GroupID) GobDecode(data []byte) error {
+	return group.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for FileID.
+// The FileID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (file FileID) GobEncode() ([]byte, error) {
+	return file.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for FileID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (file *FileID) GobDecode(data []byte) error {
+	return file.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for ReceiptID.
+// The ReceiptID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (receipt ReceiptID) GobEncode() ([]byte, error) {
+	return receipt.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for ReceiptID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (receipt *ReceiptID) GobDecode(data []byte) error {
+	return receipt.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for TopicID.
+// The TopicID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (topic TopicID) GobEncode() ([]byte, error) {
+	return topic.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for TopicID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (topic *TopicID) GobDecode(data []byte) error {
+	return topic.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for KeyID.
+// The KeyID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (key KeyID) GobEncode() ([]byte, error) {
+	return key.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for KeyID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (key *KeyID) GobDecode(data []byte) error {
+	return key.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for DomainID.
+// The DomainID is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (domain DomainID) GobEncode() ([]byte, error) {
+	return domain.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for DomainID.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (domain *DomainID) GobDecode(data []byte) error {
+	return domain.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface for Address.
+// The Address is encoded as its raw 32 bytes, identical to MarshalBinary.
+func (address Address) GobEncode() ([]byte, error) {
+	return address.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for Address.
+// The data is decoded and validated identical to UnmarshalBinary.
+func (address *Address) GobDecode(data []byte) error {
+	return address.UnmarshalBinary(data)
+}
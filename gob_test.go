@@ -0,0 +1,61 @@
+package identifiers
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGob(t *testing.T) {
+	RegisterGob()
+	// Registering multiple times is allowed
+	RegisterGob()
+
+	type message struct {
+		Target any
+	}
+
+	values := []any{
+		RandomTopicIDv0().AsIdentifier(),
+		RandomParticipantIDv0(),
+		RandomAssetIDv0(),
+		RandomLogicIDv0(),
+		RandomInteractionIDv0(),
+		RandomTesseractIDv0(),
+		RandomGroupIDv0(),
+		RandomFileIDv0(),
+		RandomReceiptIDv0(),
+		RandomTopicIDv0(),
+		RandomKeyIDv0(),
+		RandomDomainIDv0(),
+		Address(RandomAssetIDv0()),
+	}
+
+	for _, value := range values {
+		t.Run(fmt.Sprintf("%T", value), func(t *testing.T) {
+			var buffer bytes.Buffer
+
+			require.NoError(t, gob.NewEncoder(&buffer).Encode(message{Target: value}))
+
+			var decoded message
+
+			require.NoError(t, gob.NewDecoder(&buffer).Decode(&decoded))
+			assert.Equal(t, value, decoded.Target)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		var buffer bytes.Buffer
+
+		// Encode an AssetID and decode it as a LogicID
+		require.NoError(t, gob.NewEncoder(&buffer).Encode(RandomAssetIDv0()))
+
+		var decoded LogicID
+
+		require.EqualError(t, gob.NewDecoder(&buffer).Decode(&decoded), "invalid tag: not a logic id")
+	})
+}
@@ -0,0 +1,81 @@
+package identifiers
+
+import (
+	"encoding/hex"
+)
+
+// goString returns the Go syntax representation of an identifier with the given type name, for use with
+// the %#v verb. Valid identifiers are rendered as a call to the Must<name>FromHex constructor of the type,
+// such as identifiers.MustAssetIDFromHex("0x..."), and nil identifiers as the typed nil value of the type.
+// Identifiers that do not validate into the type are rendered as a conversion from an Identifier instead,
+// so that the rendered expression does not panic when evaluated.
+func goString[T ~[32]byte](name string, id T, valid bool) string {
+	if id == (T{}) {
+		return "identifiers.Nil" + name
+	}
+
+	encoded := `"` + prefix0xString + hex.EncodeToString(id[:]) + `"`
+	if !valid {
+		return "identifiers." + name + "(identifiers.MustIdentifierFromHex(" + encoded + "))"
+	}
+
+	return "identifiers.Must" + name + "FromHex(" + encoded + ")"
+}
+
+// GoString returns the Identifier as Go syntax, such as identifiers.MustIdentifierFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (id Identifier) GoString() string { return goString("Identifier", id, true) }
+
+// GoString returns the ParticipantID as Go syntax, such as identifiers.MustParticipantIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (participant ParticipantID) GoString() string {
+	return goString("ParticipantID", participant, participant.Validate() == nil)
+}
+
+// GoString returns the AssetID as Go syntax, such as identifiers.MustAssetIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (asset AssetID) GoString() string { return goString("AssetID", asset, asset.Validate() == nil) }
+
+// GoString returns the LogicID as Go syntax, such as identifiers.MustLogicIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (logic LogicID) GoString() string { return goString("LogicID", logic, logic.Validate() == nil) }
+
+// GoString returns the InteractionID as Go syntax, such as identifiers.MustInteractionIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (interaction InteractionID) GoString() string {
+	return goString("InteractionID", interaction, interaction.Validate() == nil)
+}
+
+// GoString returns the TesseractID as Go syntax, such as identifiers.MustTesseractIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (tesseract TesseractID) GoString() string {
+	return goString("TesseractID", tesseract, tesseract.Validate() == nil)
+}
+
+// GoString returns the GroupID as Go syntax, such as identifiers.MustGroupIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (group GroupID) GoString() string { return goString("GroupID", group, group.Validate() == nil) }
+
+// GoString returns the FileID as Go syntax, such as identifiers.MustFileIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (file FileID) GoString() string { return goString("FileID", file, file.Validate() == nil) }
+
+// GoString returns the ReceiptID as Go syntax, such as identifiers.MustReceiptIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (receipt ReceiptID) GoString() string {
+	return goString("ReceiptID", receipt, receipt.Validate() == nil)
+}
+
+// GoString returns the TopicID as Go syntax, such as identifiers.MustTopicIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (topic TopicID) GoString() string { return goString("TopicID", topic, topic.Validate() == nil) }
+
+// GoString returns the KeyID as Go syntax, such as identifiers.MustKeyIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (key KeyID) GoString() string { return goString("KeyID", key, key.Validate() == nil) }
+
+// GoString returns the DomainID as Go syntax, such as identifiers.MustDomainIDFromHex("0x...").
+// It implements the fmt.GoStringer interface, which is used by the %#v verb.
+func (domain DomainID) GoString() string {
+	return goString("DomainID", domain, domain.Validate() == nil)
+}
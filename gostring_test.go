@@ -0,0 +1,72 @@
+package identifiers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoString(t *testing.T) {
+	tests := []struct {
+		name string
+		id   interface {
+			TaggedIdentifier
+			fmt.GoStringer
+		}
+	}{
+		{"Identifier", RandomAssetIDv0().AsIdentifier()},
+		{"ParticipantID", RandomParticipantIDv1()},
+		{"AssetID", RandomAssetIDv1()},
+		{"LogicID", RandomLogicIDv1()},
+		{"InteractionID", RandomInteractionIDv0()},
+		{"TesseractID", RandomTesseractIDv0()},
+		{"GroupID", RandomGroupIDv0()},
+		{"FileID", RandomFileIDv0()},
+		{"ReceiptID", RandomReceiptIDv0()},
+		{"TopicID", RandomTopicIDv0()},
+		{"KeyID", RandomKeyIDv0()},
+		{"DomainID", RandomDomainIDv0()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := tt.id.Hex()
+
+			expected := "identifiers.Must" + tt.name + "FromHex(\"" + encoded + "\")"
+			assert.Equal(t, expected, tt.id.GoString())
+			assert.Equal(t, expected, fmt.Sprintf("%#v", tt.id))
+		})
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, "identifiers.NilIdentifier", fmt.Sprintf("%#v", NilIdentifier))
+		assert.Equal(t, "identifiers.NilAssetID", fmt.Sprintf("%#v", NilAssetID))
+		assert.Equal(t, "identifiers.NilDomainID", NilDomainID.GoString())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		// Identifiers that do not validate into their type are rendered as a conversion
+		logic := RandomLogicIDv0()
+		asset := AssetID(logic)
+
+		assert.Equal(t,
+			"identifiers.AssetID(identifiers.MustIdentifierFromHex(\""+logic.Hex()+"\"))",
+			asset.GoString(),
+		)
+
+		// Identifiers are not validated
+		invalid := Identifier{0xF0}
+		assert.Equal(t, "identifiers.MustIdentifierFromHex(\""+invalid.Hex()+"\")", invalid.GoString())
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		type record struct{ Asset AssetID }
+
+		asset := RandomAssetIDv0()
+		assert.Equal(t,
+			"identifiers.record{Asset:identifiers.MustAssetIDFromHex(\""+asset.Hex()+"\")}",
+			fmt.Sprintf("%#v", record{asset}),
+		)
+	})
+}
@@ -0,0 +1,298 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// GroupID is a unique identifier for a group of participants in the MOI Protocol,
+// such as a committee or a multi-signature entity.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the group identifier.
+//   - Flags: The second byte contains flags for the group identifier.
+//   - Metadata: As of v0, GroupID has no metadata.
+//
+// Like all identifiers, the GroupID also contains a Fingerprint and a Variant ID.
+// Flags of a GroupID are specific to a version and are invalid if set in an unsupported version.
+type GroupID [32]byte
+
+// NewGroupID creates a new GroupID from the 32-byte value.
+// It returns an error if the given data is not a valid GroupID.
+func NewGroupID(data [32]byte) (GroupID, error) {
+	// Convert the data into a GroupID
+	groupID := GroupID(data)
+	// Validate the GroupID
+	if err := groupID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return groupID, nil
+}
+
+// NewGroupIDFromBytes creates a new GroupID from the given byte slice.
+// The given value must have a length of 32 and validate into a GroupID.
+func NewGroupIDFromBytes(data []byte) (GroupID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: group id must be 32 bytes")
+	}
+
+	return NewGroupID([32]byte(data))
+}
+
+// NewGroupIDFromHex creates a new GroupID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a GroupID.
+func NewGroupIDFromHex(data string) (GroupID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindGroup {
+		return GroupID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new GroupID from the decoded value
+	// Length check is performed in NewGroupIDFromBytes
+	converted, err := NewGroupIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustGroupID is an enforced version of NewGroupID.
+// Panics if an error occurs. Use with caution.
+func MustGroupID(data [32]byte) GroupID { return must(NewGroupID(data)) }
+
+// MustGroupIDFromBytes is an enforced version of NewGroupIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustGroupIDFromBytes(data []byte) GroupID { return must(NewGroupIDFromBytes(data)) }
+
+// MustGroupIDFromHex is an enforced version of NewGroupIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustGroupIDFromHex(data string) GroupID { return must(NewGroupIDFromHex(data)) }
+
+// Bytes returns the GroupID as a []byte
+func (group GroupID) Bytes() []byte { return group[:] }
+
+// String returns the GroupID as a hex-encoded string.
+// This is identical to GroupID.Hex() but is required for the fmt.Stringer interface
+func (group GroupID) String() string { return group.Hex() }
+
+// Hex returns the GroupID as a hex-encoded string with the 0x prefix
+func (group GroupID) Hex() string {
+	return prefix0xString + hex.EncodeToString(group[:])
+}
+
+// IsNil returns if the GroupID is nil, i.e., 0x000..000
+func (group GroupID) IsNil() bool { return group == GroupID{} }
+
+// AsIdentifier returns the GroupID as an Identifier.
+func (group GroupID) AsIdentifier() Identifier {
+	return Identifier(group)
+}
+
+// Tag returns the IdentifierTag for the GroupID.
+func (group GroupID) Tag() IdentifierTag {
+	return IdentifierTag(group[0])
+}
+
+// Flags returns the byte of flag bits from the GroupID
+func (group GroupID) Flags() byte {
+	return group[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the GroupID.
+func (group GroupID) Fingerprint() [24]byte {
+	return trimFingerprint(group)
+}
+
+// AccountID returns the 24-byte account ID from the GroupID.
+// This is identical to GroupID.Fingerprint()
+func (group GroupID) AccountID() [24]byte {
+	return trimFingerprint(group)
+}
+
+// Variant returns the 32-bit variant ID from the GroupID.
+func (group GroupID) Variant() uint32 {
+	variant := trimVariant(group)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the GroupID has a non-zero variant ID
+func (group GroupID) IsVariant() bool {
+	variant := trimVariant(group)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the GroupID.
+//
+// If the specified flag is not supported by the GroupID,
+// it will return False, regardless of the actual flag value.
+func (group GroupID) Flag(flag Flag) bool {
+	// Check if the flag is supported by GroupID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(group.Tag()) {
+		return false
+	}
+
+	return getFlag(group[1], flag.index)
+}
+
+// Validate returns an error if the GroupID is invalid.
+// An error is returned if the GroupID has an invalid tag or contains unsupported flags.
+func (group GroupID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := group.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a group tag
+	if group.Tag().Kind() != KindGroup {
+		return ErrNotGroupID
+	}
+
+	// Check that there are no unsupported flags set
+	if (group[1] & group.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: group.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(group)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure GroupID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*GroupID)(nil)
+	_ encoding.TextUnmarshaler   = (*GroupID)(nil)
+	_ encoding.BinaryMarshaler   = (*GroupID)(nil)
+	_ encoding.BinaryUnmarshaler = (*GroupID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for GroupID
+func (group GroupID) MarshalText() ([]byte, error) {
+	return marshal32(group)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for GroupID
+func (group *GroupID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindGroup)
+	if err != nil {
+		return err
+	}
+
+	*group = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for GroupID.
+// The GroupID is encoded as its raw 32 bytes.
+func (group GroupID) MarshalBinary() ([]byte, error) {
+	return group.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for GroupID.
+// The data must be 32 bytes long and validate into a GroupID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (group *GroupID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*group = GroupID{}
+		return nil
+	}
+
+	decoded, err := NewGroupIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*group = decoded
+	return nil
+}
+
+// GenerateGroupIDv0 creates a new GroupID for v0 with the given parameters.
+// The fingerprint is derived from the hash of the members sorted in ascending byte order,
+// which makes it independent of the order in which the members are provided.
+// Returns an error if there are no members, invalid or duplicate members or unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:6}{thresholded}][reserved:2][fingerprint:24][variant:4]
+func GenerateGroupIDv0(members []ParticipantID, variant uint32, flags ...Flag) (GroupID, error) {
+	// Check that the group has at least one member
+	if len(members) == 0 {
+		return Nil, errors.New("invalid members: group must have at least 1 member")
+	}
+
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for GroupID v0
+	metadata[0] = byte(TagGroupV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by GroupID v0
+		if !flag.Supports(TagGroupV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Derive the fingerprint from the canonical member set
+	fingerprint, err := membersFingerprint("moi.group", "group", members)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Order the group ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return GroupID(buffer), nil
+}
+
+// RandomGroupIDv0 creates a random v0 GroupID with
+// random members, a random variant ID and flags.
+//   - There is a 50% chance that the GroupThresholded flag will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomGroupIDv0() GroupID {
+	flags := make([]Flag, 0, 1)
+
+	if rand.Int64() > 0 {
+		flags = append(flags, GroupThresholded)
+	}
+
+	// Safe to ignore error as the members are unique and the flags are supported
+	group, _ := GenerateGroupIDv0(
+		[]ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0()},
+		rand.Uint32(), flags...,
+	)
+
+	return group
+}
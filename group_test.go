@@ -0,0 +1,316 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupID(t *testing.T) {
+	data := [32]byte{
+		byte(TagGroupV0), // Tag
+		0b00000001,       // Flags
+		0x00, 0x00,       // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test GroupID
+	groupID, err := NewGroupID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagGroupV0, groupID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, groupID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), groupID.Variant())
+	// Test IsVariant
+	assert.True(t, groupID.IsVariant())
+
+	// Test Flags
+	assert.True(t, groupID.Flag(GroupThresholded))
+	assert.False(t, groupID.Flag(Systemic))
+	assert.False(t, groupID.Flag(AssetStateful)) // unsupported flag on set bit
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, groupID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsGroupID()
+	require.NoError(t, err)
+	require.Equal(t, groupID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], groupID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x5001000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, groupID.String())
+	assert.Equal(t, expectedHex, groupID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestGroupID_Constructor(t *testing.T) {
+	t.Run("NewGroupID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			groupID, err := NewGroupID([32]byte{
+				byte(TagGroupV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, groupID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewGroupID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewGroupID([32]byte{byte(TagGroupV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewGroupID([32]byte{byte(TagAssetV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a group id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewGroupID([32]byte{
+				byte(TagGroupV0), // Tag
+				0b11111111,       // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for group id")
+		})
+	})
+
+	t.Run("NewGroupIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewGroupIDFromBytes([]byte{byte(TagGroupV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: group id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			groupID, err := NewGroupIDFromBytes([]byte{
+				byte(TagGroupV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, groupID.Validate())
+			require.Equal(t, GroupID{
+				byte(TagGroupV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, groupID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewGroupIDFromBytes([]byte{
+				byte(TagGroupV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: group id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewGroupIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			groupID, err := NewGroupIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagGroupV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, groupID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			groupID, err := NewGroupIDFromHex(hex.EncodeToString([]byte{
+				byte(TagGroupV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, groupID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewGroupIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewGroupIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustGroupID", func(t *testing.T) {
+		t.Run("MustGroupID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustGroupID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustGroupIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustGroupIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustGroupIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustGroupIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestGroupID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		groupID := RandomGroupIDv0()
+
+		encoded, err := json.Marshal(groupID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+groupID.Hex()+`"`, string(encoded))
+
+		var decoded GroupID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, groupID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded GroupID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded GroupID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded GroupID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestGroupID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0(), RandomParticipantIDv0()}
+
+		t.Run("Generate", func(t *testing.T) {
+			groupID, err := GenerateGroupIDv0(members, 42, GroupThresholded)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagGroupV0, groupID.Tag())
+			assert.Equal(t, uint32(42), groupID.Variant())
+			assert.True(t, groupID.Flag(GroupThresholded))
+			assert.False(t, groupID.Flag(Systemic))
+
+			// Test that member order does not affect the fingerprint
+			reordered, err := GenerateGroupIDv0([]ParticipantID{members[1], members[2], members[0]}, 42, GroupThresholded)
+			require.NoError(t, err)
+			assert.Equal(t, groupID, reordered)
+
+			// Test that groups and multisig participants with the same members have different fingerprints
+			multisig, err := GenerateMultisigParticipantID(members, 2, 42)
+			require.NoError(t, err)
+			assert.NotEqual(t, groupID.Fingerprint(), multisig.Fingerprint())
+
+			// Test unsupported flags
+			_, err = GenerateGroupIDv0(members, 42, LogicAuxiliary)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("InvalidMembers", func(t *testing.T) {
+			_, err := GenerateGroupIDv0(nil, 0)
+			require.EqualError(t, err, "invalid members: group must have at least 1 member")
+
+			_, err = GenerateGroupIDv0([]ParticipantID{members[0], members[0]}, 0)
+			require.EqualError(t, err, "invalid members: duplicate group member")
+
+			_, err = GenerateGroupIDv0([]ParticipantID{{byte(TagLogicV0)}}, 0)
+			require.EqualError(t, err, "invalid member: invalid tag: not a participant id")
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			groupID := RandomGroupIDv0()
+
+			assert.NoError(t, groupID.Validate())
+			assert.Equal(t, TagGroupV0, groupID.Tag())
+		})
+	})
+}
+
+func TestGroupID_BinaryMarshal(t *testing.T) {
+	group := RandomGroupIDv0()
+
+	encoded, err := group.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, group.Bytes(), encoded)
+
+	var decoded GroupID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, group, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: group id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a group id",
+	)
+}
@@ -0,0 +1,95 @@
+package identifiers
+
+// GroupByAccount groups the given identifiers by their 24-byte account ID.
+// The identifiers in each group retain their order from the given slice.
+func GroupByAccount(ids []Identifier) map[[24]byte][]Identifier {
+	var grouper AccountGrouper
+
+	grouper.Add(ids...)
+
+	return grouper.Groups()
+}
+
+// GroupByAccountChan groups the identifiers received from the given channel by their
+// 24-byte account ID, until the channel is closed. The identifiers in each group
+// retain the order in which they were received.
+func GroupByAccountChan(ids <-chan Identifier) map[[24]byte][]Identifier {
+	var grouper AccountGrouper
+
+	for id := range ids {
+		grouper.Add(id)
+	}
+
+	return grouper.Groups()
+}
+
+// StreamAccountGroups groups the identifiers received from the given channel by their account ID,
+// for identifiers that are ordered by account ID (such as when scanning an AccountIndex or keys
+// that are prefixed with the account ID). Each group is emitted to the given function as soon as
+// an identifier of another account is received, so only one group is held in memory at a time.
+//
+// Identifiers of an account that are not received consecutively are emitted as separate groups.
+// Returns when the channel is closed or when the function returns false.
+func StreamAccountGroups(ids <-chan Identifier, emit func(account [24]byte, group []Identifier) bool) {
+	var (
+		account [24]byte
+		group   []Identifier
+	)
+
+	for id := range ids {
+		if len(group) > 0 && id.AccountID() != account {
+			if !emit(account, group) {
+				return
+			}
+
+			group = nil
+		}
+
+		account = id.AccountID()
+		group = append(group, id)
+	}
+
+	if len(group) > 0 {
+		emit(account, group)
+	}
+}
+
+// AccountGrouper incrementally groups identifiers by their 24-byte account ID.
+// The zero value is an empty grouper that is ready to use.
+// An AccountGrouper is not safe for concurrent use.
+type AccountGrouper struct {
+	groups map[[24]byte][]Identifier
+}
+
+// Add adds the given identifiers to the groups of their account IDs
+func (grouper *AccountGrouper) Add(ids ...Identifier) {
+	if grouper.groups == nil {
+		grouper.groups = make(map[[24]byte][]Identifier)
+	}
+
+	for _, id := range ids {
+		grouper.groups[id.AccountID()] = append(grouper.groups[id.AccountID()], id)
+	}
+}
+
+// Groups returns the identifiers grouped by their account IDs.
+// The returned map is shared with the grouper and must not be modified.
+func (grouper *AccountGrouper) Groups() map[[24]byte][]Identifier {
+	if grouper.groups == nil {
+		return make(map[[24]byte][]Identifier)
+	}
+
+	return grouper.groups
+}
+
+// SplitByKind splits the given group of identifiers by their kind, such as
+// separating the assets and logics under one account. The identifiers of
+// each kind retain their order from the given group.
+func SplitByKind(group []Identifier) map[IdentifierKind][]Identifier {
+	split := make(map[IdentifierKind][]Identifier)
+	for _, id := range group {
+		split[id.Tag().Kind()] = append(split[id.Tag().Kind()], id)
+	}
+
+	return split
+}
@@ -0,0 +1,84 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByAccount(t *testing.T) {
+	accountA, accountB := accountWith(0xAA), accountWith(0xBB)
+
+	assetA := must(GenerateAssetIDv0(accountA, 0, 0)).AsIdentifier()
+	logicA := must(GenerateLogicIDv0(accountA, 1, 0)).AsIdentifier()
+	assetA2 := must(GenerateAssetIDv0(accountA, 2, 0)).AsIdentifier()
+	assetB := must(GenerateAssetIDv0(accountB, 0, 0)).AsIdentifier()
+
+	ids := []Identifier{assetA, assetB, logicA, assetA2}
+	expected := map[[24]byte][]Identifier{
+		accountA: {assetA, logicA, assetA2},
+		accountB: {assetB},
+	}
+
+	require.Equal(t, expected, GroupByAccount(ids))
+	require.Empty(t, GroupByAccount(nil))
+
+	var grouper AccountGrouper
+	require.NotNil(t, grouper.Groups())
+
+	t.Run("Chan", func(t *testing.T) {
+		channel := make(chan Identifier, len(ids))
+		for _, id := range ids {
+			channel <- id
+		}
+
+		close(channel)
+
+		require.Equal(t, expected, GroupByAccountChan(channel))
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		stream := func(ids ...Identifier) <-chan Identifier {
+			channel := make(chan Identifier, len(ids))
+			for _, id := range ids {
+				channel <- id
+			}
+
+			close(channel)
+
+			return channel
+		}
+
+		var emitted [][]Identifier
+
+		StreamAccountGroups(stream(assetA, logicA, assetB, assetA2), func(account [24]byte, group []Identifier) bool {
+			require.Equal(t, group[0].AccountID(), account)
+			emitted = append(emitted, group)
+
+			return true
+		})
+		require.Equal(t, [][]Identifier{{assetA, logicA}, {assetB}, {assetA2}}, emitted)
+
+		// The stream stops when the function returns false
+		emitted = nil
+
+		StreamAccountGroups(stream(assetA, assetB, assetA2), func(account [24]byte, group []Identifier) bool {
+			emitted = append(emitted, group)
+			return false
+		})
+		require.Equal(t, [][]Identifier{{assetA}}, emitted)
+
+		StreamAccountGroups(stream(), func([24]byte, []Identifier) bool {
+			t.Fatal("unexpected group")
+			return true
+		})
+	})
+
+	t.Run("SplitByKind", func(t *testing.T) {
+		split := SplitByKind(GroupByAccount(ids)[accountA])
+		require.Equal(t, map[IdentifierKind][]Identifier{
+			KindAsset: {assetA, assetA2},
+			KindLogic: {logicA},
+		}, split)
+	})
+}
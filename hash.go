@@ -0,0 +1,69 @@
+package identifiers
+
+import (
+	"hash/maphash"
+
+	"github.com/sarvalabs/go-moi-identifiers/internal/keccak"
+)
+
+const (
+	// fnvOffset64 and fnvPrime64 are the parameters of the 64-bit FNV-1a hash
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+
+	// domainHashPrefix is the protocol-defined prefix for domain-separated hashes of identifiers
+	domainHashPrefix = "moi.identifier:"
+)
+
+// Hash64 returns a 64-bit hash of the Identifier, for use as a key in hash maps and caches.
+//
+// The hash is the 64-bit FNV-1a hash of the 32 bytes, mixed with the SplitMix64 finalizer
+// to distribute its bits uniformly. It does not allocate and is stable across processes,
+// so it must not be used for maps that are exposed to untrusted input (use a Hasher).
+func (id Identifier) Hash64() uint64 {
+	hash := uint64(fnvOffset64)
+	for _, value := range id {
+		hash ^= uint64(value)
+		hash *= fnvPrime64
+	}
+
+	hash = (hash ^ (hash >> 30)) * 0xbf58476d1ce4e5b9
+	hash = (hash ^ (hash >> 27)) * 0x94d049bb133111eb
+
+	return hash ^ (hash >> 31)
+}
+
+// HashWithDomain returns the 32-byte domain-separated hash of the Identifier, for use in Merkle trees and
+// signature payloads that include identifiers, so that they are computed identically by all consumers.
+//
+// The hash is the Keccak-256 digest (with the original Keccak padding) of the protocol-defined prefix
+// "moi.identifier:", followed by the domain and the 32 bytes of the Identifier. The same Identifier
+// hashed with different domains produces unrelated hashes, which prevents hashes computed for one
+// purpose from being replayed for another.
+func (id Identifier) HashWithDomain(domain string) [32]byte {
+	return keccak.Sum256([]byte(domainHashPrefix), []byte(domain), id[:])
+}
+
+// Hasher is a randomly seeded hash function for identifiers, which can be used with hash map
+// implementations that accept a custom hasher with Hash and Equal methods. It is based on hash/maphash,
+// so its hashes are unpredictable without the seed and it can be used for maps that are exposed to
+// untrusted input. Hashers with different seeds produce unrelated hashes, and the hashes of a Hasher
+// are not stable across processes. A Hasher must be created with NewHasher, and is safe for concurrent use.
+type Hasher struct {
+	seed maphash.Seed
+}
+
+// NewHasher creates a new Hasher with a random seed
+func NewHasher() Hasher {
+	return Hasher{seed: maphash.MakeSeed()}
+}
+
+// Hash returns the 64-bit hash of the given Identifier with the seed of the Hasher
+func (hasher Hasher) Hash(id Identifier) uint64 {
+	return maphash.Bytes(hasher.seed, id[:])
+}
+
+// Equal returns whether the given identifiers are equal
+func (hasher Hasher) Equal(a, b Identifier) bool {
+	return a == b
+}
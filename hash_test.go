@@ -0,0 +1,62 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"hash/fnv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sarvalabs/go-moi-identifiers/internal/keccak"
+)
+
+func TestIdentifier_Hash64(t *testing.T) {
+	id := RandomAssetIDv0().AsIdentifier()
+
+	// The hash must match the FNV-1a hash of the standard library (before mixing)
+	reference := fnv.New64a()
+	_, _ = reference.Write(id[:])
+
+	expected := reference.Sum64()
+	expected = (expected ^ (expected >> 30)) * 0xbf58476d1ce4e5b9
+	expected = (expected ^ (expected >> 27)) * 0x94d049bb133111eb
+	expected ^= expected >> 31
+
+	require.Equal(t, expected, id.Hash64())
+	require.Equal(t, uint64(0xd991e919042832c6), Identifier(Nil).Hash64())
+
+	assert.NotEqual(t, id.Hash64(), RandomAssetIDv0().AsIdentifier().Hash64())
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _ = id.Hash64() }))
+}
+
+func TestIdentifier_HashWithDomain(t *testing.T) {
+	// Test against a known hash of the nil identifier
+	hash := Identifier(Nil).HashWithDomain("moi.merkle")
+	assert.Equal(t, "48b2c84fc5e04d3209c86495d4d5e7438999cd92be5cfe6e3b6d2dc6ddbe1eb4", hex.EncodeToString(hash[:]))
+
+	id := RandomAssetIDv0().AsIdentifier()
+	other := RandomAssetIDv0().AsIdentifier()
+
+	// Test that the hash is deterministic and depends on the domain and the identifier
+	assert.Equal(t, id.HashWithDomain("moi.merkle"), id.HashWithDomain("moi.merkle"))
+	assert.NotEqual(t, id.HashWithDomain("moi.merkle"), id.HashWithDomain("moi.signature"))
+	assert.NotEqual(t, id.HashWithDomain("moi.merkle"), other.HashWithDomain("moi.merkle"))
+
+	// Test that the hash includes the protocol-defined prefix
+	assert.Equal(t, keccak.Sum256([]byte("moi.identifier:moi.merkle"), id[:]), id.HashWithDomain("moi.merkle"))
+	assert.NotEqual(t, keccak.Sum256([]byte("moi.merkle"), id[:]), id.HashWithDomain("moi.merkle"))
+}
+
+func TestHasher(t *testing.T) {
+	id := RandomLogicIDv0().AsIdentifier()
+
+	hasher := NewHasher()
+
+	assert.Equal(t, hasher.Hash(id), hasher.Hash(id))
+	assert.NotEqual(t, hasher.Hash(id), hasher.Hash(Identifier{}))
+	assert.NotEqual(t, hasher.Hash(id), NewHasher().Hash(id))
+
+	assert.True(t, hasher.Equal(id, id))
+	assert.False(t, hasher.Equal(id, Identifier{}))
+}
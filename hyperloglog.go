@@ -0,0 +1,150 @@
+package identifiers
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+const (
+	// MinHyperLogLogPrecision is the minimum precision of a HyperLogLog
+	MinHyperLogLogPrecision = 4
+	// MaxHyperLogLogPrecision is the maximum precision of a HyperLogLog
+	MaxHyperLogLogPrecision = 16
+)
+
+// HyperLogLog is a sketch that estimates the number of unique identifiers added to it, without
+// retaining the identifiers. It uses 2^precision single-byte registers, with a standard error of
+// about 1.04/sqrt(2^precision), such that a precision of 14 uses 16KB with an error of about 0.8%.
+//
+// Identifiers are hashed deterministically, so sketches with the same precision can be merged
+// across processes and nodes, and serialized with MarshalBinary. A HyperLogLog is not safe for
+// concurrent use, and must be created with NewHyperLogLog.
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+var (
+	// Ensure HyperLogLog implements binary marshaling interfaces
+	_ encoding.BinaryMarshaler   = (*HyperLogLog)(nil)
+	_ encoding.BinaryUnmarshaler = (*HyperLogLog)(nil)
+)
+
+// NewHyperLogLog creates a new empty HyperLogLog with the given precision.
+// Returns an error if the precision is not between 4 and 16 (inclusive).
+func NewHyperLogLog(precision uint8) (*HyperLogLog, error) {
+	if precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision {
+		return nil, fmt.Errorf("invalid hyperloglog precision %d: must be between 4 and 16", precision)
+	}
+
+	return &HyperLogLog{precision: precision, registers: make([]uint8, 1<<precision)}, nil
+}
+
+// Precision returns the precision of the HyperLogLog
+func (hll *HyperLogLog) Precision() uint8 { return hll.precision }
+
+// AddIdentifier adds the given identifier to the HyperLogLog
+func (hll *HyperLogLog) AddIdentifier(id TaggedIdentifier) {
+	hash := Identifier(id.Bytes()).Hash64()
+
+	// The register is selected with the upper bits of the hash, and
+	// its rank is the position of the first set bit in the remaining bits
+	register := hash >> (64 - hll.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<hll.precision|1<<(hll.precision-1)) + 1)
+
+	if rank > hll.registers[register] {
+		hll.registers[register] = rank
+	}
+}
+
+// EstimateCardinality returns the estimated number of unique identifiers added to the HyperLogLog
+func (hll *HyperLogLog) EstimateCardinality() uint64 {
+	var (
+		sum    float64
+		zeroes int
+	)
+
+	for _, rank := range hll.registers {
+		sum += math.Ldexp(1, -int(rank))
+
+		if rank == 0 {
+			zeroes++
+		}
+	}
+
+	count := float64(len(hll.registers))
+
+	var alpha float64
+
+	switch len(hll.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/count)
+	}
+
+	estimate := alpha * count * count / sum
+
+	// Use linear counting for small cardinalities
+	if estimate <= 2.5*count && zeroes > 0 {
+		estimate = count * math.Log(count/float64(zeroes))
+	}
+
+	return uint64(estimate + 0.5)
+}
+
+// Merge merges the given HyperLogLog into this HyperLogLog, such that it estimates the number
+// of unique identifiers added to either of them. Returns an error if the precisions are different.
+func (hll *HyperLogLog) Merge(other *HyperLogLog) error {
+	if hll.precision != other.precision {
+		return fmt.Errorf("cannot merge hyperloglog with precision %d into precision %d", other.precision, hll.precision)
+	}
+
+	for register, rank := range other.registers {
+		hll.registers[register] = max(hll.registers[register], rank)
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for HyperLogLog.
+// The HyperLogLog is encoded as its precision (1 byte) followed by its registers (1 byte each).
+func (hll *HyperLogLog) MarshalBinary() ([]byte, error) {
+	return append([]byte{hll.precision}, hll.registers...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for HyperLogLog.
+// The data must be a valid encoding of a HyperLogLog, as produced by HyperLogLog.MarshalBinary
+func (hll *HyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("invalid hyperloglog: %w", ErrInvalidLength)
+	}
+
+	decoded, err := NewHyperLogLog(data[0])
+	if err != nil {
+		return err
+	}
+
+	if len(data)-1 != len(decoded.registers) {
+		return fmt.Errorf("invalid hyperloglog: %w", ErrInvalidLength)
+	}
+
+	for register, rank := range data[1:] {
+		if rank > 64-decoded.precision+1 {
+			return errors.New("invalid hyperloglog: register rank out of range")
+		}
+
+		decoded.registers[register] = rank
+	}
+
+	*hll = *decoded
+
+	return nil
+}
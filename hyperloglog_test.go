@@ -0,0 +1,114 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireEstimate asserts that the estimated cardinality is within the given relative error
+func requireEstimate(t *testing.T, hll *HyperLogLog, expected int, tolerance float64) {
+	t.Helper()
+
+	estimate := float64(hll.EstimateCardinality())
+	require.InDelta(t, float64(expected), estimate, math.Max(1, float64(expected)*tolerance))
+}
+
+func TestHyperLogLog(t *testing.T) {
+	_, err := NewHyperLogLog(3)
+	require.EqualError(t, err, "invalid hyperloglog precision 3: must be between 4 and 16")
+
+	_, err = NewHyperLogLog(17)
+	require.EqualError(t, err, "invalid hyperloglog precision 17: must be between 4 and 16")
+
+	hll, err := NewHyperLogLog(14)
+	require.NoError(t, err)
+	require.Equal(t, uint8(14), hll.Precision())
+	require.Equal(t, uint64(0), hll.EstimateCardinality())
+
+	// Identifiers are derived deterministically to keep the estimates stable
+	ids := make([]ParticipantID, 50000)
+	for i := range ids {
+		ids[i] = must(GenerateParticipantIDv0(hashFingerprint("test", binary.BigEndian.AppendUint32(nil, uint32(i))), 0))
+	}
+
+	// Small cardinalities are estimated with linear counting
+	for _, id := range ids[:100] {
+		hll.AddIdentifier(id)
+		hll.AddIdentifier(id)
+	}
+
+	requireEstimate(t, hll, 100, 0.02)
+
+	for _, id := range ids {
+		hll.AddIdentifier(id)
+	}
+
+	requireEstimate(t, hll, 50000, 0.03)
+
+	t.Run("SmallPrecision", func(t *testing.T) {
+		for _, precision := range []uint8{4, 5, 6} {
+			small := must(NewHyperLogLog(precision))
+			for _, id := range ids[:5000] {
+				small.AddIdentifier(id)
+			}
+
+			requireEstimate(t, small, 5000, 0.6)
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		left, right := must(NewHyperLogLog(12)), must(NewHyperLogLog(12))
+
+		for _, id := range ids[:30000] {
+			left.AddIdentifier(id)
+		}
+
+		for _, id := range ids[20000:] {
+			right.AddIdentifier(id)
+		}
+
+		require.NoError(t, left.Merge(right))
+		requireEstimate(t, left, 50000, 0.06)
+
+		err := left.Merge(must(NewHyperLogLog(10)))
+		require.EqualError(t, err, "cannot merge hyperloglog with precision 10 into precision 12")
+	})
+}
+
+func TestHyperLogLog_BinaryMarshal(t *testing.T) {
+	hll := must(NewHyperLogLog(8))
+	for i := 0; i < 1000; i++ {
+		hll.AddIdentifier(RandomAssetIDv0())
+	}
+
+	encoded, err := hll.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, encoded, 1+256)
+
+	decoded := &HyperLogLog{}
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, hll, decoded)
+	require.Equal(t, hll.EstimateCardinality(), decoded.EstimateCardinality())
+
+	t.Run("Errors", func(t *testing.T) {
+		err := decoded.UnmarshalBinary(nil)
+		require.EqualError(t, err, "invalid hyperloglog: invalid length")
+
+		err = decoded.UnmarshalBinary([]byte{2, 0, 0, 0, 0})
+		require.EqualError(t, err, "invalid hyperloglog precision 2: must be between 4 and 16")
+
+		err = decoded.UnmarshalBinary(encoded[:100])
+		require.EqualError(t, err, "invalid hyperloglog: invalid length")
+
+		invalid := append([]byte{}, encoded...)
+		invalid[1] = 64 - 8 + 2
+
+		err = decoded.UnmarshalBinary(invalid)
+		require.EqualError(t, err, "invalid hyperloglog: register rank out of range")
+
+		require.Equal(t, hll, decoded)
+	})
+}
@@ -5,6 +5,10 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
 )
 
 // IdentifierKind represents the kinds of recognized identifiers.
@@ -14,26 +18,103 @@ const (
 	KindParticipant IdentifierKind = iota
 	KindAsset
 	KindLogic
+	KindInteraction
+	KindTesseract
+	KindGroup
+	KindFile
+	KindReceipt
+	KindTopic
+	KindKey
+	KindDomain
 )
 
 const (
-	maxIdentifierKind = KindLogic
-	identifierV0      = 0
+	identifierV0 = 0
+	identifierV1 = 1
 )
 
-// kindSupport is a map of IdentifierKind to the maximum supported version.
-var kindSupport = map[IdentifierKind]uint8{
-	KindParticipant: 0,
-	KindAsset:       0,
-	KindLogic:       0,
+// defaultKindSupport is the maximum supported version of each builtin IdentifierKind, indexed by kind.
+// It is the initial state of the registry tables, to which custom kinds can be added with RegisterKind
+// and where the maximum supported version of a kind can be modified with SetMaxVersion.
+var defaultKindSupport = [...]uint8{
+	KindParticipant: 1,
+	KindAsset:       1,
+	KindLogic:       1,
+	KindInteraction: 0,
+	KindTesseract:   0,
+	KindGroup:       0,
+	KindFile:        0,
+	KindReceipt:     0,
+	KindTopic:       0,
+	KindKey:         0,
+	KindDomain:      0,
+}
+
+// kindNames is a map of IdentifierKind to its name.
+// Custom kinds can be added to it with RegisterKind.
+var kindNames = map[IdentifierKind]string{
+	KindParticipant: "participant",
+	KindAsset:       "asset",
+	KindLogic:       "logic",
+	KindInteraction: "interaction",
+	KindTesseract:   "tesseract",
+	KindGroup:       "group",
+	KindFile:        "file",
+	KindReceipt:     "receipt",
+	KindTopic:       "topic",
+	KindKey:         "key",
+	KindDomain:      "domain",
+}
+
+// AllKinds returns all recognized identifier kinds (including custom kinds) in ascending order.
+func AllKinds() []IdentifierKind {
+	current := registry.Load()
+
+	kinds := make([]IdentifierKind, 0, bits.OnesCount16(current.kinds))
+	for kind := IdentifierKind(0); kind <= 0x0F; kind++ {
+		if current.supports(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return kinds
+}
+
+// ParseIdentifierKind returns the IdentifierKind for the given kind name, such as "asset".
+// Names are matched case-insensitively. Returns ErrUnsupportedKind if the name is not recognized.
+func ParseIdentifierKind(name string) (IdentifierKind, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	for kind, kindName := range kindNames {
+		if strings.EqualFold(kindName, name) {
+			return kind, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnsupportedKind, name)
+}
+
+// String returns the name of the IdentifierKind, such as "asset".
+// Kinds without a name are rendered as "kind(<value>)".
+func (kind IdentifierKind) String() string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	if name, ok := kindNames[kind]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("kind(%d)", uint8(kind))
 }
 
 // IdentifierTag represents the tag of an identifier.
 // The first 4-bit nibble represents the kind of the identifier (IdentifierKind),
 // and the second 4-bit nibble represents the version for that identifier kind.
 //
-// While the version is currently set to 0 for all kinds, it allows for future
-// changes to the identifier format while maintaining backward compatibility.
+// The version allows for changes to the identifier format while maintaining backward
+// compatibility. Participant, Asset and Logic identifiers support versions 0 and 1,
+// while all other kinds of identifiers only support version 0.
 //
 // This format allows for up to 16 different kinds of identifiers and 16 different
 // versions for each kind. While this headroom is excessive for current requirements,
@@ -45,8 +126,76 @@ const (
 	TagParticipantV0 = IdentifierTag((KindParticipant << 4) | identifierV0)
 	TagAssetV0       = IdentifierTag((KindAsset << 4) | identifierV0)
 	TagLogicV0       = IdentifierTag((KindLogic << 4) | identifierV0)
+	TagInteractionV0 = IdentifierTag((KindInteraction << 4) | identifierV0)
+	TagTesseractV0   = IdentifierTag((KindTesseract << 4) | identifierV0)
+	TagGroupV0       = IdentifierTag((KindGroup << 4) | identifierV0)
+	TagFileV0        = IdentifierTag((KindFile << 4) | identifierV0)
+	TagReceiptV0     = IdentifierTag((KindReceipt << 4) | identifierV0)
+	TagTopicV0       = IdentifierTag((KindTopic << 4) | identifierV0)
+	TagKeyV0         = IdentifierTag((KindKey << 4) | identifierV0)
+	TagDomainV0      = IdentifierTag((KindDomain << 4) | identifierV0)
+
+	TagParticipantV1 = IdentifierTag((KindParticipant << 4) | identifierV1)
+	TagAssetV1       = IdentifierTag((KindAsset << 4) | identifierV1)
+	TagLogicV1       = IdentifierTag((KindLogic << 4) | identifierV1)
 )
 
+// NewIdentifierTag creates a new IdentifierTag from the given kind and version.
+// Returns an error if the kind or version do not fit in their 4-bit nibbles,
+// or if the version is not supported for the kind.
+func NewIdentifierTag(kind IdentifierKind, version uint8) (IdentifierTag, error) {
+	// Check that the kind fits into the upper nibble of the tag
+	if kind > 0x0F {
+		return 0, ErrUnsupportedKind
+	}
+
+	// Check that the version fits into the lower nibble of the tag
+	if version > 0x0F {
+		return 0, ErrUnsupportedVersion
+	}
+
+	// Assemble the tag and check that the kind and version are supported
+	tag := IdentifierTag((kind << 4) | IdentifierKind(version))
+	if err := tag.Validate(); err != nil {
+		return 0, err
+	}
+
+	return tag, nil
+}
+
+// MustTag is an enforced version of NewIdentifierTag.
+// Panics if an error occurs. Use with caution.
+func MustTag(kind IdentifierKind, version uint8) IdentifierTag {
+	return must(NewIdentifierTag(kind, version))
+}
+
+// ParseTag creates a new IdentifierTag from its symbolic form <kind>/v<version>, such as "asset/v0".
+// Returns ErrInvalidTagFormat if the string is malformed, or an error if the tag is not supported.
+func ParseTag(data string) (IdentifierTag, error) {
+	name, version, found := strings.Cut(data, "/")
+	if !found || !strings.HasPrefix(version, "v") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidTagFormat, data)
+	}
+
+	kind, err := ParseIdentifierKind(name)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := strconv.ParseUint(version[1:], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidTagFormat, data)
+	}
+
+	return NewIdentifierTag(kind, uint8(number))
+}
+
+// String returns the symbolic form of the IdentifierTag, such as "asset/v0".
+// The kind is rendered as described in IdentifierKind.String
+func (tag IdentifierTag) String() string {
+	return tag.Kind().String() + "/v" + strconv.FormatUint(uint64(tag.Version()), 10)
+}
+
 // Kind returns the IdentifierKind from the IdentifierTag
 func (tag IdentifierTag) Kind() IdentifierKind {
 	// Determine the kind from the upper 4 bits
@@ -62,17 +211,7 @@ func (tag IdentifierTag) Version() uint8 {
 // Validate checks if the IdentifierTag is valid and returns an error if not.
 // An error is returned if the version is not supported or the kind is invalid
 func (tag IdentifierTag) Validate() error {
-	// Check if the kind is under the maximum supported kind
-	if tag.Kind() > maxIdentifierKind {
-		return ErrUnsupportedKind
-	}
-
-	// Check if the version is supported for the kind
-	if tag.Version() > kindSupport[tag.Kind()] {
-		return ErrUnsupportedVersion
-	}
-
-	return nil
+	return registry.Load().validateTag(tag)
 }
 
 // Identifier represents a unique 32-byte (256-bit) identifier
@@ -94,6 +233,34 @@ func (tag IdentifierTag) Validate() error {
 // The last 4 bytes represent a 32-bit variant number, which can be used for sub-identifiers.
 type Identifier [32]byte
 
+// TaggedIdentifier is the common interface for the components of an identifier.
+// It is implemented by Identifier and all kind specific identifiers such as AssetID,
+// allowing generic code to operate uniformly on identifiers of any kind.
+type TaggedIdentifier interface {
+	Tag() IdentifierTag
+	Flags() byte
+	AccountID() [24]byte
+	Variant() uint32
+	Bytes() []byte
+	Hex() string
+}
+
+var (
+	// Ensure all identifiers implement the TaggedIdentifier interface
+	_ TaggedIdentifier = Identifier{}
+	_ TaggedIdentifier = ParticipantID{}
+	_ TaggedIdentifier = AssetID{}
+	_ TaggedIdentifier = LogicID{}
+	_ TaggedIdentifier = InteractionID{}
+	_ TaggedIdentifier = TesseractID{}
+	_ TaggedIdentifier = GroupID{}
+	_ TaggedIdentifier = FileID{}
+	_ TaggedIdentifier = ReceiptID{}
+	_ TaggedIdentifier = TopicID{}
+	_ TaggedIdentifier = KeyID{}
+	_ TaggedIdentifier = DomainID{}
+)
+
 // NewIdentifierFromHex creates a new Identifier from the given hex string.
 // The given value must decode as hexadecimal string (0x prefix is optional), with a length of 64 characters (32 bytes)
 func NewIdentifierFromHex(data string) (Identifier, error) {
@@ -126,7 +293,7 @@ func (id Identifier) String() string { return id.Hex() }
 func (id Identifier) Hex() string { return prefix0xString + hex.EncodeToString(id[:]) }
 
 // IsNil returns if the Identifier is nil, i.e., 0x000..000
-func (id Identifier) IsNil() bool { return id == Nil }
+func (id Identifier) IsNil() bool { return id == Identifier{} }
 
 // Tag returns the IdentifierTag from the Identifier
 func (id Identifier) Tag() IdentifierTag { return IdentifierTag(id[0]) }
@@ -140,6 +307,10 @@ func (id Identifier) Metadata() [2]byte { return [2]byte{id[2], id[3]} }
 // Fingerprint returns 24-byte fingerprint ID from the Identifier
 func (id Identifier) Fingerprint() [24]byte { return trimFingerprint(id) }
 
+// AccountID returns 24-byte account ID from the Identifier.
+// This is identical to Identifier.Fingerprint()
+func (id Identifier) AccountID() [24]byte { return trimFingerprint(id) }
+
 // Variant returns the 32-bit variant ID from the Identifier
 func (id Identifier) Variant() uint32 {
 	variant := trimVariant(id)
@@ -152,6 +323,33 @@ func (id Identifier) IsVariant() bool {
 	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
 }
 
+// Validate returns an error if the Identifier is invalid for its kind.
+// An error is returned if the Identifier has an invalid tag, contains unsupported flags or violates
+// the rules of its kind (such as the threshold of a multisig participant). Unlike the validation of
+// specific identifiers, it does not check that the identifier is of a specific kind, and also
+// applies to any custom kinds registered with RegisterKind.
+func (id Identifier) Validate() error {
+	// Use a single snapshot of the registry for all checks
+	current := registry.Load()
+
+	// Check basic validity of the identifier tag
+	if err := current.validateTag(id.Tag()); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check that there are no unsupported flags set
+	if (id.Flags() & current.flagMasks[id.Tag()]) != 0 {
+		return BadFlagsError{Tag: id.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := current.checkKindRules(id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeriveVariant returns a new Identifier with the given variant ID and specified flags set/unset.
 // Returns an error if the given flags are not supported for the Identifier tag.
 func (id Identifier) DeriveVariant(variant uint32, set []Flag, unset []Flag) (Identifier, error) {
@@ -162,27 +360,32 @@ func (id Identifier) DeriveVariant(variant uint32, set []Flag, unset []Flag) (Id
 	// Encode the new variant ID
 	binary.BigEndian.PutUint32(derived[28:], variant)
 
-	for _, flag := range set {
-		// Check if the given flag is supported by identifier tag
-		if !flag.Supports(derived.Tag()) {
-			return Nil, ErrUnsupportedFlag
-		}
+	// Set and unset the flags, which must be supported by the identifier tag
+	flags, err := derived.FlagSet().Set(set...)
+	if err != nil {
+		return Nil, err
+	}
 
-		// Set the flag
-		derived[1] = setFlag(derived[1], flag.index, true)
+	if flags, err = flags.Unset(unset...); err != nil {
+		return Nil, err
 	}
 
-	for _, flag := range unset {
-		// Check if the given flag is supported by identifier tag
-		if !flag.Supports(derived.Tag()) {
-			return Nil, ErrUnsupportedFlag
-		}
+	derived[1] = flags.Byte()
+
+	return derived, nil
+}
 
-		// Unset the flag
-		derived[1] = setFlag(derived[1], flag.index, false)
+// deriveVariant derives a new identifier of the same type from the given identifier with Identifier.DeriveVariant,
+// and converts it with the given function, which validates the derived identifier for its kind.
+func deriveVariant[T ~[32]byte](
+	id T, variant uint32, set, unset []Flag, convert func([32]byte) (T, error),
+) (T, error) {
+	derived, err := Identifier(id).DeriveVariant(variant, set, unset)
+	if err != nil {
+		return Nil, err
 	}
 
-	return derived, nil
+	return convert(derived)
 }
 
 // AsParticipantID returns the Identifier as a ParticipantID.
@@ -197,10 +400,44 @@ func (id Identifier) AsAssetID() (AssetID, error) { return NewAssetID(id) }
 // Returns an error if the Identifier is not a valid LogicID
 func (id Identifier) AsLogicID() (LogicID, error) { return NewLogicID(id) }
 
+// AsInteractionID returns the Identifier as an InteractionID.
+// Returns an error if the Identifier is not a valid InteractionID
+func (id Identifier) AsInteractionID() (InteractionID, error) { return NewInteractionID(id) }
+
+// AsTesseractID returns the Identifier as a TesseractID.
+// Returns an error if the Identifier is not a valid TesseractID
+func (id Identifier) AsTesseractID() (TesseractID, error) { return NewTesseractID(id) }
+
+// AsGroupID returns the Identifier as a GroupID.
+// Returns an error if the Identifier is not a valid GroupID
+func (id Identifier) AsGroupID() (GroupID, error) { return NewGroupID(id) }
+
+// AsFileID returns the Identifier as a FileID.
+// Returns an error if the Identifier is not a valid FileID
+func (id Identifier) AsFileID() (FileID, error) { return NewFileID(id) }
+
+// AsReceiptID returns the Identifier as a ReceiptID.
+// Returns an error if the Identifier is not a valid ReceiptID
+func (id Identifier) AsReceiptID() (ReceiptID, error) { return NewReceiptID(id) }
+
+// AsTopicID returns the Identifier as a TopicID.
+// Returns an error if the Identifier is not a valid TopicID
+func (id Identifier) AsTopicID() (TopicID, error) { return NewTopicID(id) }
+
+// AsKeyID returns the Identifier as a KeyID.
+// Returns an error if the Identifier is not a valid KeyID
+func (id Identifier) AsKeyID() (KeyID, error) { return NewKeyID(id) }
+
+// AsDomainID returns the Identifier as a DomainID.
+// Returns an error if the Identifier is not a valid DomainID
+func (id Identifier) AsDomainID() (DomainID, error) { return NewDomainID(id) }
+
 var (
-	// Ensure Identifier implements text marshaling interfaces
-	_ encoding.TextMarshaler   = (*Identifier)(nil)
-	_ encoding.TextUnmarshaler = (*Identifier)(nil)
+	// Ensure Identifier implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*Identifier)(nil)
+	_ encoding.TextUnmarshaler   = (*Identifier)(nil)
+	_ encoding.BinaryMarshaler   = (*Identifier)(nil)
+	_ encoding.BinaryUnmarshaler = (*Identifier)(nil)
 )
 
 // MarshalText implements the encoding.TextMarshaler interface for Identifier
@@ -218,3 +455,32 @@ func (id *Identifier) UnmarshalText(data []byte) error {
 	*id = decoded
 	return nil
 }
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Identifier.
+// The Identifier is encoded as its raw 32 bytes.
+func (id Identifier) MarshalBinary() ([]byte, error) {
+	return id.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Identifier.
+// The data must be 32 bytes long and validate into an Identifier, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (id *Identifier) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*id = Identifier{}
+		return nil
+	}
+
+	// Check length of the data
+	if len(data) != 32 {
+		return errors.New("invalid length: identifier must be 32 bytes")
+	}
+
+	decoded := Identifier(data)
+	if err := decoded.Validate(); err != nil {
+		return err
+	}
+
+	*id = decoded
+	return nil
+}
@@ -1,7 +1,9 @@
 package identifiers
 
 import (
+	"encoding"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,10 +39,38 @@ func TestIdentifierTag(t *testing.T) {
 			expectedVersion: 0,
 			expectedValid:   true,
 		},
+		{
+			name:            "Participant V1",
+			tag:             TagParticipantV1,
+			expectedKind:    KindParticipant,
+			expectedVersion: 1,
+			expectedValid:   true,
+		},
+		{
+			name:            "Asset V1",
+			tag:             TagAssetV1,
+			expectedKind:    KindAsset,
+			expectedVersion: 1,
+			expectedValid:   true,
+		},
+		{
+			name:            "Logic V1",
+			tag:             TagLogicV1,
+			expectedKind:    KindLogic,
+			expectedVersion: 1,
+			expectedValid:   true,
+		},
 		{
 			name:            "Invalid Version",
-			tag:             IdentifierTag((KindParticipant << 4) | 1),
+			tag:             IdentifierTag((KindParticipant << 4) | 2),
 			expectedKind:    KindParticipant,
+			expectedVersion: 2,
+			expectedValid:   false,
+		},
+		{
+			name:            "Invalid Version (v0 only kind)",
+			tag:             IdentifierTag((KindInteraction << 4) | 1),
+			expectedKind:    KindInteraction,
 			expectedVersion: 1,
 			expectedValid:   false,
 		},
@@ -72,6 +102,116 @@ func TestIdentifierTag(t *testing.T) {
 	}
 }
 
+func TestIdentifierKind(t *testing.T) {
+	tests := []struct {
+		kind IdentifierKind
+		name string
+	}{
+		{KindParticipant, "participant"},
+		{KindAsset, "asset"},
+		{KindLogic, "logic"},
+		{KindInteraction, "interaction"},
+		{KindTesseract, "tesseract"},
+		{KindGroup, "group"},
+		{KindFile, "file"},
+		{KindReceipt, "receipt"},
+		{KindTopic, "topic"},
+		{KindKey, "key"},
+		{KindDomain, "domain"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.name, tt.kind.String())
+
+		parsed, err := ParseIdentifierKind(tt.name)
+		require.NoError(t, err)
+		assert.Equal(t, tt.kind, parsed)
+	}
+
+	// Test case-insensitive parsing
+	parsed, err := ParseIdentifierKind("Asset")
+	require.NoError(t, err)
+	assert.Equal(t, KindAsset, parsed)
+
+	// Test unknown kinds
+	assert.Equal(t, "kind(15)", IdentifierKind(0x0F).String())
+
+	_, err = ParseIdentifierKind("unknown")
+	require.EqualError(t, err, `unsupported tag kind: "unknown"`)
+
+	// Test enumeration of all kinds
+	assert.Equal(t, []IdentifierKind{
+		KindParticipant, KindAsset, KindLogic, KindInteraction, KindTesseract,
+		KindGroup, KindFile, KindReceipt, KindTopic, KindKey, KindDomain,
+	}, AllKinds())
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		input string
+		tag   IdentifierTag
+		err   error
+	}{
+		{"participant/v0", TagParticipantV0, nil},
+		{"asset/v1", TagAssetV1, nil},
+		{"Logic/v1", TagLogicV1, nil},
+		{"domain/v0", TagDomainV0, nil},
+		{"asset", 0, ErrInvalidTagFormat},
+		{"asset/0", 0, ErrInvalidTagFormat},
+		{"asset/vx", 0, ErrInvalidTagFormat},
+		{"asset/v256", 0, ErrInvalidTagFormat},
+		{"asset/v2", 0, ErrUnsupportedVersion},
+		{"unknown/v0", 0, ErrUnsupportedKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			tag, err := ParseTag(tt.input)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.tag, tag)
+			assert.Equal(t, strings.ToLower(tt.input), tag.String())
+		})
+	}
+
+	// Test rendering of unknown tags
+	assert.Equal(t, "kind(15)/v3", IdentifierTag(0xF3).String())
+}
+
+func TestNewIdentifierTag(t *testing.T) {
+	tests := []struct {
+		kind    IdentifierKind
+		version uint8
+		want    IdentifierTag
+		err     error
+	}{
+		{KindParticipant, 0, TagParticipantV0, nil},
+		{KindAsset, 1, TagAssetV1, nil},
+		{KindDomain, 0, TagDomainV0, nil},
+		{KindLogic, 2, 0, ErrUnsupportedVersion},
+		{KindInteraction, 1, 0, ErrUnsupportedVersion},
+		{KindAsset, 16, 0, ErrUnsupportedVersion},
+		{IdentifierKind(0x0F), 0, 0, ErrUnsupportedKind},
+		{IdentifierKind(0x10), 0, 0, ErrUnsupportedKind},
+	}
+
+	for _, tt := range tests {
+		tag, err := NewIdentifierTag(tt.kind, tt.version)
+		assert.Equal(t, tt.err, err)
+		assert.Equal(t, tt.want, tag)
+
+		if tt.err != nil {
+			assert.Panics(t, func() { MustTag(tt.kind, tt.version) })
+		} else {
+			assert.Equal(t, tt.want, MustTag(tt.kind, tt.version))
+		}
+	}
+}
+
 func TestIdentifier(t *testing.T) {
 	data := [32]byte{
 		byte(TagParticipantV0), // Tag
@@ -106,7 +246,7 @@ func TestIdentifier(t *testing.T) {
 
 	// Test IsNil
 	assert.False(t, id.IsNil())
-	assert.True(t, Identifier(Nil).IsNil())
+	assert.True(t, NilIdentifier.IsNil())
 
 	// Test Bytes method
 	assert.Equal(t, id[:], id.Bytes())
@@ -117,6 +257,75 @@ func TestIdentifier(t *testing.T) {
 	assert.Equal(t, expectedHex, id.Hex())
 }
 
+func TestIsNil(t *testing.T) {
+	id := Identifier{0x01}
+
+	tests := []struct {
+		name   string
+		nilled bool
+		other  bool
+	}{
+		{"Identifier", Identifier{}.IsNil(), id.IsNil()},
+		{"ParticipantID", ParticipantID{}.IsNil(), ParticipantID(id).IsNil()},
+		{"AssetID", AssetID{}.IsNil(), AssetID(id).IsNil()},
+		{"LogicID", LogicID{}.IsNil(), LogicID(id).IsNil()},
+		{"InteractionID", InteractionID{}.IsNil(), InteractionID(id).IsNil()},
+		{"TesseractID", TesseractID{}.IsNil(), TesseractID(id).IsNil()},
+		{"GroupID", GroupID{}.IsNil(), GroupID(id).IsNil()},
+		{"FileID", FileID{}.IsNil(), FileID(id).IsNil()},
+		{"ReceiptID", ReceiptID{}.IsNil(), ReceiptID(id).IsNil()},
+		{"TopicID", TopicID{}.IsNil(), TopicID(id).IsNil()},
+		{"KeyID", KeyID{}.IsNil(), KeyID(id).IsNil()},
+		{"DomainID", DomainID{}.IsNil(), DomainID(id).IsNil()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.nilled)
+			assert.False(t, tt.other)
+		})
+	}
+
+	// The typed nil values are equal to the untyped Nil
+	assert.Equal(t, Nil, [32]byte(NilAssetID))
+}
+
+func TestZeroValue_Binary(t *testing.T) {
+	tests := []struct {
+		name    string
+		zero    encoding.BinaryMarshaler
+		decoded interface {
+			encoding.BinaryUnmarshaler
+			IsNil() bool
+		}
+	}{
+		{"Identifier", Identifier{}, &Identifier{0x01}},
+		{"ParticipantID", ParticipantID{}, &ParticipantID{0x01}},
+		{"AssetID", AssetID{}, &AssetID{0x01}},
+		{"LogicID", LogicID{}, &LogicID{0x01}},
+		{"InteractionID", InteractionID{}, &InteractionID{0x01}},
+		{"TesseractID", TesseractID{}, &TesseractID{0x01}},
+		{"GroupID", GroupID{}, &GroupID{0x01}},
+		{"FileID", FileID{}, &FileID{0x01}},
+		{"ReceiptID", ReceiptID{}, &ReceiptID{0x01}},
+		{"TopicID", TopicID{}, &TopicID{0x01}},
+		{"KeyID", KeyID{}, &KeyID{0x01}},
+		{"DomainID", DomainID{}, &DomainID{0x01}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := tt.zero.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, Nil[:], encoded)
+
+			// Test that the zero value round-trips, even though it does not validate for most kinds
+			require.NoError(t, tt.decoded.UnmarshalBinary(encoded))
+			assert.True(t, tt.decoded.IsNil())
+		})
+	}
+}
+
 func TestIdentifier_FromHex(t *testing.T) {
 	t.Run("ValidHex", func(t *testing.T) {
 		_, err := NewIdentifierFromHex(RandomAssetIDv0().AsIdentifier().Hex())
@@ -172,7 +381,7 @@ func TestIdentifier_DeriveVariant(t *testing.T) {
 
 	t.Run("DeriveWithUnsupportedSet", func(t *testing.T) {
 		// Generate a logic ID with a zero variant
-		identifier, err := GenerateLogicIDv0(RandomFingerprint(), 0)
+		identifier, err := GenerateLogicIDv0(RandomFingerprint(), 0, 0)
 		require.NoError(t, err)
 
 		// Attempt derivation with a new variant and an unsupported flag set
@@ -199,7 +408,7 @@ func TestIdentifier_DeriveVariant(t *testing.T) {
 
 	t.Run("DeriveWithUnsupportedUnset", func(t *testing.T) {
 		// Generate a logic ID with a zero variant
-		identifier, err := GenerateLogicIDv0(RandomFingerprint(), 0, LogicIntrinsic)
+		identifier, err := GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicIntrinsic)
 		require.NoError(t, err)
 		require.True(t, identifier.Flag(LogicIntrinsic))
 
@@ -242,3 +451,70 @@ func TestIdentifier_TextMarshal(t *testing.T) {
 		)
 	})
 }
+
+func TestIdentifier_Validate(t *testing.T) {
+	assert.NoError(t, RandomAssetIDv0().AsIdentifier().Validate())
+	assert.NoError(t, RandomLogicIDv1().AsIdentifier().Validate())
+
+	assert.EqualError(t, Identifier{0xF0}.Validate(), "invalid tag: unsupported tag kind")
+	assert.EqualError(t, Identifier{0x0F}.Validate(), "invalid tag: unsupported tag version")
+	assert.EqualError(t,
+		Identifier{byte(TagParticipantV0), 0b01000000}.Validate(),
+		"invalid flags: unsupported flags for participant id",
+	)
+}
+
+func TestTaggedIdentifier(t *testing.T) {
+	tests := []TaggedIdentifier{
+		must(NewIdentifierFromHex(RandomAssetIDv0().Hex())),
+		must(GenerateMultisigParticipantID(
+			[]ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0()}, 1, 3,
+		)),
+		RandomAssetIDv1(),
+		RandomLogicIDv0(),
+		RandomInteractionIDv0(),
+		RandomTesseractIDv0(),
+		RandomGroupIDv0(),
+		RandomFileIDv0(),
+		RandomReceiptIDv0(),
+		RandomTopicIDv0(),
+		RandomKeyIDv0(),
+		RandomDomainIDv0(),
+	}
+
+	for _, tagged := range tests {
+		t.Run(tagged.Tag().String(), func(t *testing.T) {
+			id := Identifier(tagged.Bytes())
+
+			assert.Equal(t, id.Tag(), tagged.Tag())
+			assert.Equal(t, id.Flags(), tagged.Flags())
+			assert.Equal(t, id.Fingerprint(), tagged.AccountID())
+			assert.Equal(t, id.AccountID(), tagged.AccountID())
+			assert.Equal(t, id.Variant(), tagged.Variant())
+			assert.Equal(t, id.Hex(), tagged.Hex())
+
+			// Concrete identifiers expose both the fingerprint and account ID accessors
+			if fingerprinted, ok := tagged.(interface{ Fingerprint() [24]byte }); ok {
+				assert.Equal(t, fingerprinted.Fingerprint(), tagged.AccountID())
+			}
+		})
+	}
+}
+
+func TestIdentifier_BinaryMarshal(t *testing.T) {
+	id := RandomAssetIDv1().AsIdentifier()
+
+	encoded, err := id.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, id.Bytes(), encoded)
+
+	var decoded Identifier
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, id, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: identifier must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{0xF0}, make([]byte, 31)...)),
+		"invalid tag: unsupported tag kind",
+	)
+}
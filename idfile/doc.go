@@ -0,0 +1,12 @@
+// Package idfile implements a flat file format of fixed-size identifier records, for the interchange
+// of identifier snapshots between tools. A file consists of a 16-byte header, followed by the 32-byte
+// identifiers concatenated without any separators, which allows random access to any record by its
+// index. Files can be read sequentially with a Reader, or mapped into memory with Open.
+//
+// The header is encoded as follows (all integers are big-endian):
+//   - Magic: The 4 bytes "MOID"
+//   - Version: The format version of the file (1 byte), which is currently 1
+//   - Reserved: 1 byte that must be zero
+//   - Kinds: The KindFilter of the identifier kinds that are allowed in the file (2 bytes)
+//   - Count: The number of identifier records in the file (8 bytes)
+package idfile
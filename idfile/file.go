@@ -0,0 +1,112 @@
+package idfile
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// File is an identifier file that is mapped into memory for random access to its records.
+// Records are read directly from the mapping without copying the file. A File is safe for
+// concurrent reads, but must not be used after it is closed. It must be created with Open.
+type File struct {
+	header  Header
+	mapping []byte
+	records []byte
+}
+
+// Open opens the identifier file at the given path and maps it into memory.
+// Returns an error if the header is invalid or if the size of the file does
+// not match the number of records declared in the header.
+func Open(path string) (*File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	// Stat the opened file, so that the size belongs to the same file that is mapped
+	info, err := file.Stat()
+	if err == nil && (info.Size() < HeaderSize || info.Size() > math.MaxInt) {
+		err = fmt.Errorf("%w: file size %d", identifiers.ErrInvalidLength, info.Size())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := mapFile(file, int(info.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := decodeHeader([HeaderSize]byte(mapping))
+	if err != nil {
+		_ = unmapFile(mapping)
+		return nil, err
+	}
+
+	// Check that the file contains exactly the declared number of records
+	records := mapping[HeaderSize:]
+	if len(records)%RecordSize != 0 || uint64(len(records)/RecordSize) != header.Count {
+		_ = unmapFile(mapping)
+		return nil, fmt.Errorf("%w: file size %d for %d records", identifiers.ErrInvalidLength, len(mapping), header.Count)
+	}
+
+	return &File{header: header, mapping: mapping, records: records}, nil
+}
+
+// Header returns the header of the file
+func (file *File) Header() Header { return file.header }
+
+// Len returns the number of identifier records in the file
+func (file *File) Len() int { return len(file.records) / RecordSize }
+
+// At returns the identifier record at the given index. Returns an error if the index is out of
+// range, or an error wrapping ErrKindNotAllowed if the kind of the identifier is not allowed by
+// the KindFilter of the file.
+func (file *File) At(index int) (identifiers.Identifier, error) {
+	if index < 0 || index >= file.Len() {
+		return identifiers.Nil, fmt.Errorf("index %d out of range for %d records", index, file.Len())
+	}
+
+	id := identifiers.Identifier(file.records[index*RecordSize:])
+	if err := file.header.Kinds.check(id); err != nil {
+		return identifiers.Nil, err
+	}
+
+	return id, nil
+}
+
+// Range calls the given function for each identifier record in the file in order.
+// Iteration stops if the function returns false, or if a record is not allowed
+// by the KindFilter of the file, in which case the error is returned.
+func (file *File) Range(fn func(index int, id identifiers.Identifier) bool) error {
+	for index := 0; index < file.Len(); index++ {
+		id, err := file.At(index)
+		if err != nil {
+			return err
+		}
+
+		if !fn(index, id) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Close unmaps the file from memory
+func (file *File) Close() error {
+	if file.mapping == nil {
+		return os.ErrClosed
+	}
+
+	mapping := file.mapping
+	file.mapping, file.records = nil, nil
+
+	return unmapFile(mapping)
+}
@@ -0,0 +1,151 @@
+package idfile
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+func TestFile(t *testing.T) {
+	ids := make([]identifiers.Identifier, 100)
+	for index := range ids {
+		ids[index] = identifiers.RandomLogicIDv0().AsIdentifier()
+	}
+
+	path := filepath.Join(t.TempDir(), "logics.moid")
+	require.NoError(t, WriteFile(path, NewKindFilter(identifiers.KindLogic), ids))
+
+	file, err := Open(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, Header{Version: Version, Kinds: NewKindFilter(identifiers.KindLogic), Count: 100}, file.Header())
+	assert.Equal(t, 100, file.Len())
+
+	t.Run("At", func(t *testing.T) {
+		for _, index := range []int{0, 42, 99} {
+			id, err := file.At(index)
+			require.NoError(t, err)
+			assert.Equal(t, ids[index], id)
+		}
+
+		_, err := file.At(100)
+		require.EqualError(t, err, "index 100 out of range for 100 records")
+
+		_, err = file.At(-1)
+		require.EqualError(t, err, "index -1 out of range for 100 records")
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		var ranged []identifiers.Identifier
+
+		require.NoError(t, file.Range(func(index int, id identifiers.Identifier) bool {
+			require.Equal(t, len(ranged), index)
+			ranged = append(ranged, id)
+
+			return true
+		}))
+
+		assert.Equal(t, ids, ranged)
+
+		// Stop after the first record
+		count := 0
+
+		require.NoError(t, file.Range(func(int, identifiers.Identifier) bool {
+			count++
+			return false
+		}))
+
+		assert.Equal(t, 1, count)
+	})
+
+	require.NoError(t, file.Close())
+	require.ErrorIs(t, file.Close(), os.ErrClosed)
+	assert.Zero(t, file.Len())
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	logic := identifiers.RandomLogicIDv0().AsIdentifier()
+
+	write := func(t *testing.T, data []byte) string {
+		t.Helper()
+
+		path := filepath.Join(dir, t.Name()+".moid")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		return path
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		file, err := Open(write(t, encodeFile(Header{Version: Version})))
+		require.NoError(t, err)
+		assert.Zero(t, file.Len())
+		require.NoError(t, file.Close())
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := Open(filepath.Join(dir, "missing.moid"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Unopenable", func(t *testing.T) {
+		// Unix sockets can be stat-ed but not opened
+		listener, err := net.Listen("unix", filepath.Join(dir, "socket"))
+		require.NoError(t, err)
+
+		defer listener.Close()
+
+		_, err = Open(filepath.Join(dir, "socket"))
+		require.Error(t, err)
+	})
+
+	t.Run("Unmappable", func(t *testing.T) {
+		// Directories cannot be mapped into memory (ensure that it is large enough)
+		unmappable := filepath.Join(dir, "directory")
+		for index := 0; index < 8; index++ {
+			require.NoError(t, os.MkdirAll(filepath.Join(unmappable, strconv.Itoa(index)), 0o700))
+		}
+
+		_, err := Open(unmappable)
+		require.Error(t, err)
+	})
+
+	t.Run("InvalidSize", func(t *testing.T) {
+		_, err := Open(write(t, []byte("MOID")))
+		require.ErrorIs(t, err, identifiers.ErrInvalidLength)
+
+		_, err = Open(write(t, encodeFile(Header{Version: Version, Count: 2}, logic)))
+		require.EqualError(t, err, "invalid length: file size 48 for 2 records")
+
+		_, err = Open(write(t, append(encodeFile(Header{Version: Version, Count: 1}, logic), 0xFF)))
+		require.EqualError(t, err, "invalid length: file size 49 for 1 records")
+	})
+
+	t.Run("InvalidHeader", func(t *testing.T) {
+		_, err := Open(write(t, encodeFile(Header{Version: 2})))
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("InvalidRecord", func(t *testing.T) {
+		header := Header{Version: Version, Kinds: NewKindFilter(identifiers.KindAsset), Count: 1}
+
+		file, err := Open(write(t, encodeFile(header, logic)))
+		require.NoError(t, err)
+
+		defer file.Close()
+
+		_, err = file.At(0)
+		require.ErrorIs(t, err, ErrKindNotAllowed)
+
+		err = file.Range(func(int, identifiers.Identifier) bool { return true })
+		require.ErrorIs(t, err, ErrKindNotAllowed)
+	})
+}
@@ -0,0 +1,118 @@
+package idfile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+const (
+	// Version is the current version of the file format
+	Version = 1
+	// HeaderSize is the size of the file header in bytes
+	HeaderSize = 16
+	// RecordSize is the size of an identifier record in bytes
+	RecordSize = 32
+)
+
+// magic is the sequence of bytes at the start of every file
+var magic = [4]byte{'M', 'O', 'I', 'D'}
+
+var (
+	ErrInvalidHeader      = errors.New("invalid header")
+	ErrUnsupportedVersion = errors.New("unsupported file version")
+	ErrKindNotAllowed     = errors.New("kind not allowed")
+)
+
+// KindFilter is a bitmask of the identifier kinds that are allowed in a file,
+// where bit N is set if the kind N is allowed. The zero value allows all kinds.
+type KindFilter uint16
+
+// NewKindFilter creates a KindFilter that allows the given kinds.
+// If no kinds are given, the filter allows all kinds.
+func NewKindFilter(kinds ...identifiers.IdentifierKind) KindFilter {
+	var filter KindFilter
+	for _, kind := range kinds {
+		filter |= 1 << (kind & 0x0F)
+	}
+
+	return filter
+}
+
+// Allows returns whether the given kind is allowed by the KindFilter
+func (filter KindFilter) Allows(kind identifiers.IdentifierKind) bool {
+	return filter == 0 || (kind <= 0x0F && filter&(1<<kind) != 0)
+}
+
+// Kinds returns the kinds allowed by the KindFilter in ascending order.
+// Returns nil if the filter allows all kinds.
+func (filter KindFilter) Kinds() []identifiers.IdentifierKind {
+	if filter == 0 {
+		return nil
+	}
+
+	kinds := make([]identifiers.IdentifierKind, 0, bits.OnesCount16(uint16(filter)))
+	for kind := identifiers.IdentifierKind(0); kind <= 0x0F; kind++ {
+		if filter&(1<<kind) != 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return kinds
+}
+
+// check returns an error if the kind of the given identifier is not allowed by the KindFilter
+func (filter KindFilter) check(id identifiers.Identifier) error {
+	if !filter.Allows(id.Tag().Kind()) {
+		return fmt.Errorf("%w: %v identifier", ErrKindNotAllowed, id.Tag().Kind())
+	}
+
+	return nil
+}
+
+// Header is the header of an identifier file
+type Header struct {
+	// Version is the format version of the file
+	Version uint8
+	// Kinds is the filter of identifier kinds that are allowed in the file
+	Kinds KindFilter
+	// Count is the number of identifier records in the file
+	Count uint64
+}
+
+// encode encodes the Header into its 16-byte representation
+func (header Header) encode() [HeaderSize]byte {
+	var encoded [HeaderSize]byte
+
+	copy(encoded[:4], magic[:])
+	encoded[4] = header.Version
+	binary.BigEndian.PutUint16(encoded[6:8], uint16(header.Kinds))
+	binary.BigEndian.PutUint64(encoded[8:], header.Count)
+
+	return encoded
+}
+
+// decodeHeader decodes a Header from the given 16 bytes.
+// Returns an error if the magic, version or reserved byte are invalid.
+func decodeHeader(data [HeaderSize]byte) (Header, error) {
+	if [4]byte(data[:4]) != magic {
+		return Header{}, fmt.Errorf("%w: missing magic", ErrInvalidHeader)
+	}
+
+	if data[4] != Version {
+		return Header{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[4])
+	}
+
+	if data[5] != 0 {
+		return Header{}, fmt.Errorf("%w: non-zero reserved byte", ErrInvalidHeader)
+	}
+
+	return Header{
+		Version: data[4],
+		Kinds:   KindFilter(binary.BigEndian.Uint16(data[6:8])),
+		Count:   binary.BigEndian.Uint64(data[8:]),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package idfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+func TestKindFilter(t *testing.T) {
+	filter := NewKindFilter(identifiers.KindAsset, identifiers.KindLogic)
+	assert.Equal(t, KindFilter(0b110), filter)
+	assert.Equal(t, []identifiers.IdentifierKind{identifiers.KindAsset, identifiers.KindLogic}, filter.Kinds())
+
+	assert.True(t, filter.Allows(identifiers.KindAsset))
+	assert.False(t, filter.Allows(identifiers.KindParticipant))
+	assert.False(t, filter.Allows(0x12))
+
+	// The zero filter allows all kinds
+	assert.Equal(t, KindFilter(0), NewKindFilter())
+	assert.True(t, NewKindFilter().Allows(identifiers.KindParticipant))
+	assert.Nil(t, NewKindFilter().Kinds())
+
+	err := filter.check(identifiers.RandomParticipantIDv0().AsIdentifier())
+	require.ErrorIs(t, err, ErrKindNotAllowed)
+	require.EqualError(t, err, "kind not allowed: participant identifier")
+}
+
+func TestHeader(t *testing.T) {
+	header := Header{Version: Version, Kinds: NewKindFilter(identifiers.KindAsset), Count: 300}
+
+	encoded := header.encode()
+	assert.Equal(t, [HeaderSize]byte{'M', 'O', 'I', 'D', 1, 0, 0, 2, 0, 0, 0, 0, 0, 0, 1, 44}, encoded)
+
+	decoded, err := decodeHeader(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, header, decoded)
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := encoded
+		invalid[0] = 'X'
+
+		_, err = decodeHeader(invalid)
+		require.EqualError(t, err, "invalid header: missing magic")
+
+		invalid = encoded
+		invalid[4] = 2
+
+		_, err = decodeHeader(invalid)
+		require.EqualError(t, err, "unsupported file version: 2")
+
+		invalid = encoded
+		invalid[5] = 1
+
+		_, err = decodeHeader(invalid)
+		require.EqualError(t, err, "invalid header: non-zero reserved byte")
+	})
+}
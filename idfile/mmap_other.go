@@ -0,0 +1,22 @@
+//go:build !unix
+
+package idfile
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile reads the given number of bytes of the file into memory,
+// on platforms where memory mapping is not supported
+func mapFile(file *os.File, size int) ([]byte, error) {
+	mapping := make([]byte, size)
+	if _, err := io.ReadFull(file, mapping); err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+// unmapFile releases memory that was read with mapFile
+func unmapFile([]byte) error { return nil }
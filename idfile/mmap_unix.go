@@ -0,0 +1,18 @@
+//go:build unix
+
+package idfile
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile maps the given number of bytes of the file into memory as read-only
+func mapFile(file *os.File, size int) ([]byte, error) {
+	return syscall.Mmap(int(file.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// unmapFile unmaps memory that was mapped with mapFile
+func unmapFile(mapping []byte) error {
+	return syscall.Munmap(mapping)
+}
@@ -0,0 +1,79 @@
+package idfile
+
+import (
+	"errors"
+	"io"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// Reader reads identifier records sequentially from a file.
+// A Reader is not safe for concurrent use, and must be created with NewReader.
+type Reader struct {
+	src    io.Reader
+	header Header
+	read   uint64
+}
+
+// NewReader creates a new Reader that reads identifier records from the source.
+// The header is read immediately and an error is returned if it is invalid.
+func NewReader(src io.Reader) (*Reader, error) {
+	var encoded [HeaderSize]byte
+	if _, err := io.ReadFull(src, encoded[:]); err != nil {
+		return nil, err
+	}
+
+	header, err := decodeHeader(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{src: src, header: header}, nil
+}
+
+// Header returns the header of the file
+func (reader *Reader) Header() Header { return reader.header }
+
+// Next reads the next identifier record. Returns io.EOF once all records declared in the header have
+// been read, or io.ErrUnexpectedEOF if the source ends before that. Returns an error wrapping
+// ErrKindNotAllowed if the kind of the identifier is not allowed by the KindFilter of the file.
+func (reader *Reader) Next() (identifiers.Identifier, error) {
+	if reader.read == reader.header.Count {
+		return identifiers.Nil, io.EOF
+	}
+
+	var id identifiers.Identifier
+	if _, err := io.ReadFull(reader.src, id[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return identifiers.Nil, io.ErrUnexpectedEOF
+		}
+
+		return identifiers.Nil, err
+	}
+
+	reader.read++
+
+	if err := reader.header.Kinds.check(id); err != nil {
+		return identifiers.Nil, err
+	}
+
+	return id, nil
+}
+
+// ReadAll reads all remaining identifier records
+func (reader *Reader) ReadAll() ([]identifiers.Identifier, error) {
+	ids := make([]identifiers.Identifier, 0, min(reader.header.Count-reader.read, 1<<16))
+
+	for {
+		id, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return ids, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+}
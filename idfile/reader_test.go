@@ -0,0 +1,87 @@
+package idfile
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// encodeFile encodes a file with the given header and records
+func encodeFile(header Header, ids ...identifiers.Identifier) []byte {
+	encoded := header.encode()
+	data := encoded[:]
+
+	for _, id := range ids {
+		data = append(data, id.Bytes()...)
+	}
+
+	return data
+}
+
+func TestReader(t *testing.T) {
+	participant := identifiers.RandomParticipantIDv0().AsIdentifier()
+	asset := identifiers.RandomAssetIDv0().AsIdentifier()
+
+	header := Header{Version: Version, Kinds: NewKindFilter(identifiers.KindParticipant), Count: 2}
+
+	t.Run("Valid", func(t *testing.T) {
+		// Trailing data after the declared records is not read
+		reader, err := NewReader(bytes.NewReader(append(encodeFile(header, participant, participant), 0xFF)))
+		require.NoError(t, err)
+		assert.Equal(t, header, reader.Header())
+
+		id, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, participant, id)
+
+		ids, err := reader.ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, []identifiers.Identifier{participant}, ids)
+
+		_, err = reader.Next()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("InvalidHeader", func(t *testing.T) {
+		_, err := NewReader(bytes.NewReader([]byte("MOID")))
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		_, err = NewReader(bytes.NewReader(make([]byte, HeaderSize)))
+		require.ErrorIs(t, err, ErrInvalidHeader)
+	})
+
+	t.Run("InvalidRecords", func(t *testing.T) {
+		reader, err := NewReader(bytes.NewReader(encodeFile(header, participant, asset)))
+		require.NoError(t, err)
+
+		_, err = reader.ReadAll()
+		require.ErrorIs(t, err, ErrKindNotAllowed)
+
+		// Missing records
+		reader, err = NewReader(bytes.NewReader(encodeFile(header, participant)))
+		require.NoError(t, err)
+
+		_, err = reader.ReadAll()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		// Partial records
+		reader, err = NewReader(bytes.NewReader(encodeFile(header, participant)[:HeaderSize+10]))
+		require.NoError(t, err)
+
+		_, err = reader.Next()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		// Failing source
+		reader, err = NewReader(io.MultiReader(bytes.NewReader(encodeFile(header)), iotest.ErrReader(errFaulty)))
+		require.NoError(t, err)
+
+		_, err = reader.Next()
+		require.ErrorIs(t, err, errFaulty)
+	})
+}
@@ -0,0 +1,116 @@
+package idfile
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// Writer writes identifier records into a file.
+//
+// The header is written with a count of zero when the Writer is created, and is rewritten with the
+// number of written records when the Writer is closed, which requires the destination to be seekable.
+// A Writer is not safe for concurrent use, and must be created with NewWriter.
+type Writer struct {
+	dst    io.WriteSeeker
+	buffer *bufio.Writer
+	header Header
+	closed bool
+}
+
+// NewWriter creates a new Writer that writes identifier records of the kinds allowed by the
+// given KindFilter into the destination, starting at its current position.
+func NewWriter(dst io.WriteSeeker, kinds KindFilter) (*Writer, error) {
+	writer := &Writer{
+		dst:    dst,
+		buffer: bufio.NewWriter(dst),
+		header: Header{Version: Version, Kinds: kinds},
+	}
+
+	encoded := writer.header.encode()
+	if _, err := dst.Write(encoded[:]); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write writes the given identifier as a record.
+// Returns an error if the kind of the identifier is not allowed by the KindFilter of the Writer.
+func (writer *Writer) Write(id identifiers.TaggedIdentifier) error {
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	if err := writer.header.Kinds.check(identifiers.Identifier(id.Bytes())); err != nil {
+		return err
+	}
+
+	if _, err := writer.buffer.Write(id.Bytes()); err != nil {
+		return err
+	}
+
+	writer.header.Count++
+
+	return nil
+}
+
+// Count returns the number of records written so far
+func (writer *Writer) Count() uint64 { return writer.header.Count }
+
+// Close flushes all buffered records and rewrites the header with the number of written records.
+// The position of the destination is restored to the end of the records afterwards. Close does not
+// close the destination itself.
+func (writer *Writer) Close() error {
+	if writer.closed {
+		return os.ErrClosed
+	}
+
+	writer.closed = true
+
+	if err := writer.buffer.Flush(); err != nil {
+		return err
+	}
+
+	// Seek back to the start of the header to rewrite it
+	size := int64(HeaderSize + writer.header.Count*RecordSize)
+	if _, err := writer.dst.Seek(-size, io.SeekCurrent); err != nil {
+		return err
+	}
+
+	encoded := writer.header.encode()
+	if _, err := writer.dst.Write(encoded[:]); err != nil {
+		return err
+	}
+
+	_, err := writer.dst.Seek(size-HeaderSize, io.SeekCurrent)
+
+	return err
+}
+
+// WriteFile writes the given identifiers into a file at the given path, which is created or truncated.
+// Returns an error if the kind of any identifier is not allowed by the given KindFilter.
+func WriteFile[T identifiers.TaggedIdentifier](path string, kinds KindFilter, ids []T) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() { err = errors.Join(err, file.Close()) }()
+
+	writer, err := NewWriter(file, kinds)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err = writer.Write(id); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,138 @@
+package idfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+var errFaulty = errors.New("faulty")
+
+// faultyWriter is an io.WriteSeeker that discards all writes,
+// and fails at the configured call of Write or Seek (starting at 1)
+type faultyWriter struct {
+	writes, failWrite int
+	seeks, failSeek   int
+}
+
+func (writer *faultyWriter) Write(data []byte) (int, error) {
+	if writer.writes++; writer.writes == writer.failWrite {
+		return 0, errFaulty
+	}
+
+	return len(data), nil
+}
+
+func (writer *faultyWriter) Seek(int64, int) (int64, error) {
+	if writer.seeks++; writer.seeks == writer.failSeek {
+		return 0, errFaulty
+	}
+
+	return 0, nil
+}
+
+func TestWriter(t *testing.T) {
+	assets := []identifiers.AssetID{identifiers.RandomAssetIDv0(), identifiers.RandomAssetIDv1()}
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "assets.moid"))
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	// Write some data before the records to check that the header is rewritten in place
+	_, err = file.WriteString("prefix")
+	require.NoError(t, err)
+
+	writer, err := NewWriter(file, NewKindFilter(identifiers.KindAsset))
+	require.NoError(t, err)
+
+	for _, asset := range assets {
+		require.NoError(t, writer.Write(asset))
+	}
+
+	require.ErrorIs(t, writer.Write(identifiers.RandomLogicIDv0()), ErrKindNotAllowed)
+	require.Equal(t, uint64(2), writer.Count())
+
+	require.NoError(t, writer.Close())
+	require.ErrorIs(t, writer.Close(), os.ErrClosed)
+	require.ErrorIs(t, writer.Write(assets[0]), os.ErrClosed)
+
+	// The position must be restored to the end of the records
+	position, err := file.Seek(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("prefix")+HeaderSize+2*RecordSize), position)
+
+	_, err = file.Seek(int64(len("prefix")), 0)
+	require.NoError(t, err)
+
+	reader, err := NewReader(file)
+	require.NoError(t, err)
+	assert.Equal(t, Header{Version: Version, Kinds: NewKindFilter(identifiers.KindAsset), Count: 2}, reader.Header())
+
+	ids, err := reader.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []identifiers.Identifier{assets[0].AsIdentifier(), assets[1].AsIdentifier()}, ids)
+
+	t.Run("Faulty", func(t *testing.T) {
+		asset := identifiers.RandomAssetIDv0()
+
+		_, err := NewWriter(&faultyWriter{failWrite: 1}, 0)
+		require.ErrorIs(t, err, errFaulty)
+
+		// Records are flushed once the buffer is full
+		writer, err := NewWriter(&faultyWriter{failWrite: 2}, 0)
+		require.NoError(t, err)
+
+		for err == nil {
+			err = writer.Write(asset)
+		}
+
+		require.ErrorIs(t, err, errFaulty)
+
+		tests := []*faultyWriter{
+			{failWrite: 2}, // flush records
+			{failSeek: 1},  // seek to header
+			{failWrite: 3}, // rewrite header
+			{failSeek: 2},  // seek to end
+		}
+
+		for _, test := range tests {
+			writer, err := NewWriter(test, 0)
+			require.NoError(t, err)
+			require.NoError(t, writer.Write(asset))
+			require.ErrorIs(t, writer.Close(), errFaulty)
+		}
+	})
+}
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logics.moid")
+	logics := []identifiers.LogicID{identifiers.RandomLogicIDv0(), identifiers.RandomLogicIDv1()}
+
+	require.NoError(t, WriteFile(path, 0, logics))
+
+	file, err := Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	assert.Equal(t, Header{Version: Version, Count: 2}, file.Header())
+
+	t.Run("Errors", func(t *testing.T) {
+		err := WriteFile(filepath.Join(t.TempDir(), "missing", "logics.moid"), 0, logics)
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		err = WriteFile(path, NewKindFilter(identifiers.KindAsset), logics)
+		require.ErrorIs(t, err, ErrKindNotAllowed)
+
+		// Writes to /dev/full always fail
+		err = WriteFile("/dev/full", 0, logics)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,50 @@
+package identifiers
+
+import (
+	"slices"
+)
+
+// IdentifierList is a list of identifiers that can be kept in sorted order.
+//
+// Identifiers are ordered lexicographically by their 32 bytes, which matches the order of keys
+// in on-disk key-value stores that use the raw bytes of identifiers as keys. This allows range
+// scans and merge joins over identifier lists to be performed in memory with the same ordering.
+// The methods that rely on the order (SearchBinary, InsertSorted and Dedup) require the list
+// to be sorted, which can be ensured with Sort.
+type IdentifierList []Identifier
+
+// Sort sorts the IdentifierList in ascending order
+func (list IdentifierList) Sort() { slices.SortFunc(list, Identifier.Compare) }
+
+// IsSorted returns whether the IdentifierList is sorted in ascending order
+func (list IdentifierList) IsSorted() bool { return slices.IsSortedFunc(list, Identifier.Compare) }
+
+// SearchBinary searches for the given identifier in the sorted IdentifierList.
+// Returns the position where the identifier is found, or the position where it would be
+// inserted to keep the list sorted, and whether the identifier was found.
+func (list IdentifierList) SearchBinary(id Identifier) (int, bool) {
+	return slices.BinarySearchFunc(list, id, Identifier.Compare)
+}
+
+// Contains returns whether the given identifier is in the sorted IdentifierList
+func (list IdentifierList) Contains(id Identifier) bool {
+	_, found := list.SearchBinary(id)
+	return found
+}
+
+// InsertSorted inserts the given identifier into the sorted IdentifierList, keeping it sorted.
+// Returns false (without inserting) if the identifier is already in the list.
+func (list *IdentifierList) InsertSorted(id Identifier) bool {
+	position, found := list.SearchBinary(id)
+	if found {
+		return false
+	}
+
+	*list = slices.Insert(*list, position, id)
+
+	return true
+}
+
+// Dedup removes consecutive duplicate identifiers from the IdentifierList.
+// If the list is sorted, this removes all duplicates.
+func (list *IdentifierList) Dedup() { *list = slices.Compact(*list) }
@@ -0,0 +1,49 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifierList(t *testing.T) {
+	a := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000000001")
+	b := MustIdentifierFromHex("0x0100000000000000000000000000000000000000000000000000000000000002")
+	c := MustIdentifierFromHex("0x0200000000000000000000000000000000000000000000000000000000000000")
+	d := MustIdentifierFromHex("0x0200000000000000000000000000000000000000000000000000000000000001")
+
+	list := IdentifierList{d, b, a, b, d}
+	require.False(t, list.IsSorted())
+
+	list.Sort()
+	require.True(t, list.IsSorted())
+	require.Equal(t, IdentifierList{a, b, b, d, d}, list)
+
+	list.Dedup()
+	require.Equal(t, IdentifierList{a, b, d}, list)
+
+	position, found := list.SearchBinary(b)
+	require.True(t, found)
+	require.Equal(t, 1, position)
+
+	position, found = list.SearchBinary(c)
+	require.False(t, found)
+	require.Equal(t, 2, position)
+
+	require.True(t, list.Contains(d))
+	require.False(t, list.Contains(c))
+
+	require.True(t, list.InsertSorted(c))
+	require.False(t, list.InsertSorted(c))
+	require.Equal(t, IdentifierList{a, b, c, d}, list)
+	require.True(t, list.IsSorted())
+
+	t.Run("Empty", func(t *testing.T) {
+		var empty IdentifierList
+
+		require.True(t, empty.IsSorted())
+		require.False(t, empty.Contains(a))
+		require.True(t, empty.InsertSorted(a))
+		require.Equal(t, IdentifierList{a}, empty)
+	})
+}
@@ -0,0 +1,143 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// IdentifierMap is a map of values keyed by identifiers. Identifiers of any kind can be used as
+// keys, and the map can optionally be restricted to identifiers of specific kinds. It is encoded
+// in JSON as an object with the hex-encoded identifiers as its keys.
+//
+// The zero value is an empty map without kind restrictions that is ready to use.
+// An IdentifierMap is not safe for concurrent use.
+type IdentifierMap[V any] struct {
+	// kinds is a bitmask of the allowed kinds, with zero allowing all kinds
+	kinds   uint16
+	entries map[Identifier]V
+}
+
+// NewIdentifierMap creates a new empty IdentifierMap.
+// If any kinds are given, the map only accepts identifiers of those kinds.
+func NewIdentifierMap[V any](kinds ...IdentifierKind) *IdentifierMap[V] {
+	idmap := &IdentifierMap[V]{entries: make(map[Identifier]V)}
+	for _, kind := range kinds {
+		idmap.kinds |= 1 << (kind & 0x0F)
+	}
+
+	return idmap
+}
+
+// allows returns an error if the kind of the given Identifier is not allowed in the map
+func (idmap *IdentifierMap[V]) allows(id Identifier) error {
+	if kind := id.Tag().Kind(); idmap.kinds != 0 && idmap.kinds&(1<<kind) == 0 {
+		return fmt.Errorf("%w: %v identifiers are not allowed in map", ErrUnsupportedKind, kind)
+	}
+
+	return nil
+}
+
+// Set sets the value for the given identifier.
+// Returns an error if the kind of the identifier is not allowed in the map.
+func (idmap *IdentifierMap[V]) Set(id TaggedIdentifier, value V) error {
+	key := Identifier(id.Bytes())
+	if err := idmap.allows(key); err != nil {
+		return err
+	}
+
+	if idmap.entries == nil {
+		idmap.entries = make(map[Identifier]V)
+	}
+
+	idmap.entries[key] = value
+
+	return nil
+}
+
+// Get returns the value for the given identifier and whether it exists in the map
+func (idmap *IdentifierMap[V]) Get(id TaggedIdentifier) (V, bool) {
+	value, ok := idmap.entries[Identifier(id.Bytes())]
+	return value, ok
+}
+
+// GetParticipant returns the value for the given ParticipantID and whether it exists in the map
+func (idmap *IdentifierMap[V]) GetParticipant(participant ParticipantID) (V, bool) {
+	return idmap.Get(participant)
+}
+
+// GetAsset returns the value for the given AssetID and whether it exists in the map
+func (idmap *IdentifierMap[V]) GetAsset(asset AssetID) (V, bool) { return idmap.Get(asset) }
+
+// GetLogic returns the value for the given LogicID and whether it exists in the map
+func (idmap *IdentifierMap[V]) GetLogic(logic LogicID) (V, bool) { return idmap.Get(logic) }
+
+// Has returns whether the given identifier exists in the map
+func (idmap *IdentifierMap[V]) Has(id TaggedIdentifier) bool {
+	_, ok := idmap.entries[Identifier(id.Bytes())]
+	return ok
+}
+
+// Delete removes the given identifier from the map, if it exists
+func (idmap *IdentifierMap[V]) Delete(id TaggedIdentifier) {
+	delete(idmap.entries, Identifier(id.Bytes()))
+}
+
+// Len returns the number of identifiers in the map
+func (idmap *IdentifierMap[V]) Len() int { return len(idmap.entries) }
+
+// Keys returns the identifiers in the map in ascending byte order
+func (idmap *IdentifierMap[V]) Keys() []Identifier {
+	keys := make([]Identifier, 0, len(idmap.entries))
+	for key := range idmap.entries {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, Identifier.Compare)
+
+	return keys
+}
+
+// Range calls the given function for each identifier and value in the map, in no particular order.
+// Iteration stops if the function returns false.
+func (idmap *IdentifierMap[V]) Range(fn func(Identifier, V) bool) {
+	for key, value := range idmap.entries {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface for IdentifierMap.
+// The map is encoded as a JSON object with the hex-encoded identifiers as keys.
+func (idmap IdentifierMap[V]) MarshalJSON() ([]byte, error) {
+	if idmap.entries == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(idmap.entries)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for IdentifierMap.
+// All identifiers must be valid and of a kind that is allowed in the map.
+// The decoded entries replace any existing entries, while the kind restriction is retained.
+func (idmap *IdentifierMap[V]) UnmarshalJSON(data []byte) error {
+	var entries map[Identifier]V
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for key := range entries {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+
+		if err := idmap.allows(key); err != nil {
+			return err
+		}
+	}
+
+	idmap.entries = entries
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifierMap(t *testing.T) {
+	participant := RandomParticipantIDv0()
+	asset := RandomAssetIDv0()
+	logic := RandomLogicIDv0()
+
+	var idmap IdentifierMap[string]
+
+	// The zero value is usable and allows all kinds
+	require.NoError(t, idmap.Set(participant, "participant"))
+	require.NoError(t, idmap.Set(asset, "asset"))
+	require.NoError(t, idmap.Set(logic.AsIdentifier(), "logic"))
+	require.Equal(t, 3, idmap.Len())
+
+	value, ok := idmap.GetParticipant(participant)
+	assert.True(t, ok)
+	assert.Equal(t, "participant", value)
+
+	value, ok = idmap.GetAsset(asset)
+	assert.True(t, ok)
+	assert.Equal(t, "asset", value)
+
+	value, ok = idmap.GetLogic(logic)
+	assert.True(t, ok)
+	assert.Equal(t, "logic", value)
+
+	value, ok = idmap.Get(logic.AsIdentifier())
+	assert.True(t, ok)
+	assert.Equal(t, "logic", value)
+
+	_, ok = idmap.GetAsset(RandomAssetIDv0())
+	assert.False(t, ok)
+
+	keys := idmap.Keys()
+	require.Len(t, keys, 3)
+	require.IsIncreasing(t, []string{keys[0].Hex(), keys[1].Hex(), keys[2].Hex()})
+
+	count := 0
+	idmap.Range(func(id Identifier, value string) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+
+	idmap.Range(func(id Identifier, value string) bool {
+		count++
+		return true
+	})
+	require.Equal(t, 4, count)
+
+	idmap.Delete(asset)
+	require.False(t, idmap.Has(asset))
+	require.True(t, idmap.Has(logic))
+	require.Equal(t, 2, idmap.Len())
+}
+
+func TestIdentifierMap_Kinds(t *testing.T) {
+	idmap := NewIdentifierMap[int](KindAsset, KindLogic)
+
+	require.NoError(t, idmap.Set(RandomAssetIDv0(), 1))
+	require.NoError(t, idmap.Set(RandomLogicIDv0(), 2))
+
+	err := idmap.Set(RandomParticipantIDv0(), 3)
+	require.ErrorIs(t, err, ErrUnsupportedKind)
+	require.EqualError(t, err, "unsupported tag kind: participant identifiers are not allowed in map")
+	require.Equal(t, 2, idmap.Len())
+}
+
+func TestIdentifierMap_JSON(t *testing.T) {
+	asset := RandomAssetIDv0()
+
+	idmap := NewIdentifierMap[int](KindAsset)
+	require.NoError(t, idmap.Set(asset, 42))
+
+	encoded, err := json.Marshal(idmap)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"`+asset.Hex()+`": 42}`, string(encoded))
+
+	decoded := NewIdentifierMap[int](KindAsset)
+	require.NoError(t, json.Unmarshal(encoded, decoded))
+	require.Equal(t, idmap, decoded)
+
+	t.Run("Empty", func(t *testing.T) {
+		var empty IdentifierMap[int]
+
+		encoded, err := json.Marshal(empty)
+		require.NoError(t, err)
+		require.Equal(t, "{}", string(encoded))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`[]`), decoded)
+		require.Error(t, err)
+
+		invalid := asset.AsIdentifier()
+		invalid[0] = 0xF0
+
+		err = json.Unmarshal([]byte(`{"`+invalid.Hex()+`": 1}`), decoded)
+		require.ErrorContains(t, err, "invalid tag: unsupported tag kind")
+
+		err = json.Unmarshal([]byte(`{"`+RandomLogicIDv0().Hex()+`": 1}`), decoded)
+		require.EqualError(t, err, "unsupported tag kind: logic identifiers are not allowed in map")
+
+		// Existing entries are retained on failure
+		require.Equal(t, idmap, decoded)
+	})
+}
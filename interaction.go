@@ -0,0 +1,279 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// InteractionID is a unique identifier for an interaction in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the interaction identifier.
+//   - Flags: The second byte contains flags for the interaction identifier.
+//   - Metadata: As of v0, InteractionID has no metadata.
+//
+// Like all identifiers, the InteractionID also contains a Fingerprint and a Variant ID.
+// Flags of an InteractionID are specific to a version and are invalid if set in an unsupported version.
+type InteractionID [32]byte
+
+// NewInteractionID creates a new InteractionID from the 32-byte value.
+// It returns an error if the given data is not a valid InteractionID.
+func NewInteractionID(data [32]byte) (InteractionID, error) {
+	// Convert the data into an InteractionID
+	interactionID := InteractionID(data)
+	// Validate the InteractionID
+	if err := interactionID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return interactionID, nil
+}
+
+// NewInteractionIDFromBytes creates a new InteractionID from the given byte slice.
+// The given value must have a length of 32 and validate into an InteractionID.
+func NewInteractionIDFromBytes(data []byte) (InteractionID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: interaction id must be 32 bytes")
+	}
+
+	return NewInteractionID([32]byte(data))
+}
+
+// NewInteractionIDFromHex creates a new InteractionID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into an InteractionID.
+func NewInteractionIDFromHex(data string) (InteractionID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindInteraction {
+		return InteractionID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new InteractionID from the decoded value
+	// Length check is performed in NewInteractionIDFromBytes
+	converted, err := NewInteractionIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustInteractionID is an enforced version of NewInteractionID.
+// Panics if an error occurs. Use with caution.
+func MustInteractionID(data [32]byte) InteractionID { return must(NewInteractionID(data)) }
+
+// MustInteractionIDFromBytes is an enforced version of NewInteractionIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustInteractionIDFromBytes(data []byte) InteractionID {
+	return must(NewInteractionIDFromBytes(data))
+}
+
+// MustInteractionIDFromHex is an enforced version of NewInteractionIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustInteractionIDFromHex(data string) InteractionID { return must(NewInteractionIDFromHex(data)) }
+
+// Bytes returns the InteractionID as a []byte
+func (interaction InteractionID) Bytes() []byte { return interaction[:] }
+
+// String returns the InteractionID as a hex-encoded string.
+// This is identical to InteractionID.Hex() but is required for the fmt.Stringer interface
+func (interaction InteractionID) String() string { return interaction.Hex() }
+
+// Hex returns the InteractionID as a hex-encoded string with the 0x prefix
+func (interaction InteractionID) Hex() string {
+	return prefix0xString + hex.EncodeToString(interaction[:])
+}
+
+// IsNil returns if the InteractionID is nil, i.e., 0x000..000
+func (interaction InteractionID) IsNil() bool { return interaction == InteractionID{} }
+
+// AsIdentifier returns the InteractionID as an Identifier.
+func (interaction InteractionID) AsIdentifier() Identifier {
+	return Identifier(interaction)
+}
+
+// Tag returns the IdentifierTag for the InteractionID.
+func (interaction InteractionID) Tag() IdentifierTag {
+	return IdentifierTag(interaction[0])
+}
+
+// Flags returns the byte of flag bits from the InteractionID
+func (interaction InteractionID) Flags() byte {
+	return interaction[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the InteractionID.
+func (interaction InteractionID) Fingerprint() [24]byte {
+	return trimFingerprint(interaction)
+}
+
+// AccountID returns the 24-byte account ID from the InteractionID.
+// This is identical to InteractionID.Fingerprint()
+func (interaction InteractionID) AccountID() [24]byte {
+	return trimFingerprint(interaction)
+}
+
+// Variant returns the 32-bit variant ID from the InteractionID.
+func (interaction InteractionID) Variant() uint32 {
+	variant := trimVariant(interaction)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the InteractionID has a non-zero variant ID
+func (interaction InteractionID) IsVariant() bool {
+	variant := trimVariant(interaction)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the InteractionID.
+//
+// If the specified flag is not supported by the InteractionID,
+// it will return False, regardless of the actual flag value.
+func (interaction InteractionID) Flag(flag Flag) bool {
+	// Check if the flag is supported by InteractionID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(interaction.Tag()) {
+		return false
+	}
+
+	return getFlag(interaction[1], flag.index)
+}
+
+// Validate returns an error if the InteractionID is invalid.
+// An error is returned if the InteractionID has an invalid tag or contains unsupported flags.
+func (interaction InteractionID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := interaction.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is an interaction tag
+	if interaction.Tag().Kind() != KindInteraction {
+		return ErrNotInteractionID
+	}
+
+	// Check that there are no unsupported flags set
+	if (interaction[1] & interaction.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: interaction.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(interaction)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure InteractionID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*InteractionID)(nil)
+	_ encoding.TextUnmarshaler   = (*InteractionID)(nil)
+	_ encoding.BinaryMarshaler   = (*InteractionID)(nil)
+	_ encoding.BinaryUnmarshaler = (*InteractionID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for InteractionID
+func (interaction InteractionID) MarshalText() ([]byte, error) {
+	return marshal32(interaction)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for InteractionID
+func (interaction *InteractionID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindInteraction)
+	if err != nil {
+		return err
+	}
+
+	*interaction = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for InteractionID.
+// The InteractionID is encoded as its raw 32 bytes.
+func (interaction InteractionID) MarshalBinary() ([]byte, error) {
+	return interaction.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for InteractionID.
+// The data must be 32 bytes long and validate into an InteractionID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (interaction *InteractionID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*interaction = InteractionID{}
+		return nil
+	}
+
+	decoded, err := NewInteractionIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*interaction = decoded
+	return nil
+}
+
+// GenerateInteractionIDv0 creates a new InteractionID for v0 with the given parameters.
+// The fingerprint is deterministically derived from the hash of the sender and the nonce,
+// such that an interaction can be identified before it is submitted to the network.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:7}][reserved:2][fingerprint:24][variant:4]
+func GenerateInteractionIDv0(sender ParticipantID, nonce uint64, flags ...Flag) (InteractionID, error) {
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for InteractionID v0
+	metadata[0] = byte(TagInteractionV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by InteractionID v0
+		if !flag.Supports(TagInteractionV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Derive the fingerprint from the sender and nonce
+	fingerprint := hashFingerprint("moi.interaction", sender.Bytes(), binary.BigEndian.AppendUint64(nil, nonce))
+
+	// Order the interaction ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant (always zero)
+	buffer = append(buffer, make([]byte, 4)...)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return InteractionID(buffer), nil
+}
+
+// RandomInteractionIDv0 creates a random v0 InteractionID
+// with a random sender and nonce and no flags.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomInteractionIDv0() InteractionID {
+	// Safe to ignore error as no flags are used
+	interaction, _ := GenerateInteractionIDv0(RandomParticipantIDv0(), rand.Uint64())
+	return interaction
+}
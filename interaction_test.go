@@ -0,0 +1,302 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteractionID(t *testing.T) {
+	data := [32]byte{
+		byte(TagInteractionV0), // Tag
+		0b10000000,             // Flags
+		0x00, 0x00,             // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test InteractionID
+	interactionID, err := NewInteractionID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagInteractionV0, interactionID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, interactionID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), interactionID.Variant())
+	// Test IsVariant
+	assert.True(t, interactionID.IsVariant())
+
+	// Test Flags
+	assert.True(t, interactionID.Flag(Systemic))
+	assert.False(t, interactionID.Flag(LogicIntrinsic)) // unsupported flag
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, interactionID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsInteractionID()
+	require.NoError(t, err)
+	require.Equal(t, interactionID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], interactionID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x3080000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, interactionID.String())
+	assert.Equal(t, expectedHex, interactionID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestInteractionID_Constructor(t *testing.T) {
+	t.Run("NewInteractionID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			interactionID, err := NewInteractionID([32]byte{
+				byte(TagInteractionV0), // Tag
+				0b00000000,             // Flags
+				0x00, 0x01,             // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, interactionID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewInteractionID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewInteractionID([32]byte{byte(TagInteractionV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewInteractionID([32]byte{byte(TagParticipantV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not an interaction id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewInteractionID([32]byte{
+				byte(TagInteractionV0), // Tag
+				0b11111111,             // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for interaction id")
+		})
+	})
+
+	t.Run("NewInteractionIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewInteractionIDFromBytes([]byte{byte(TagInteractionV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: interaction id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			interactionID, err := NewInteractionIDFromBytes([]byte{
+				byte(TagInteractionV0), // Tag
+				0b00000000,             // Flags
+				0x00, 0x01,             // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, interactionID.Validate())
+			require.Equal(t, InteractionID{
+				byte(TagInteractionV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, interactionID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewInteractionIDFromBytes([]byte{
+				byte(TagInteractionV0), // Tag
+				0b00000000,             // Flags
+				0x00, 0x01,             // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: interaction id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewInteractionIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			interactionID, err := NewInteractionIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagInteractionV0), // Tag
+				0b00000000,             // Flags
+				0x00, 0x01,             // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, interactionID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			interactionID, err := NewInteractionIDFromHex(hex.EncodeToString([]byte{
+				byte(TagInteractionV0), // Tag
+				0b00000000,             // Flags
+				0x00, 0x01,             // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, interactionID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewInteractionIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewInteractionIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustInteractionID", func(t *testing.T) {
+		t.Run("MustInteractionID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustInteractionID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustInteractionIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustInteractionIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustInteractionIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustInteractionIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestInteractionID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		interactionID := RandomInteractionIDv0()
+
+		encoded, err := json.Marshal(interactionID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+interactionID.Hex()+`"`, string(encoded))
+
+		var decoded InteractionID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, interactionID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded InteractionID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded InteractionID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded InteractionID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestInteractionID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			sender := RandomParticipantIDv0()
+			interactionID, err := GenerateInteractionIDv0(sender, 5, Systemic)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagInteractionV0, interactionID.Tag())
+			assert.Equal(t, uint32(0), interactionID.Variant())
+			assert.True(t, interactionID.Flag(Systemic))
+
+			// Test deterministic generation
+			regenerated, err := GenerateInteractionIDv0(sender, 5, Systemic)
+			require.NoError(t, err)
+			assert.Equal(t, interactionID, regenerated)
+
+			// Test that the nonce affects the fingerprint
+			next, err := GenerateInteractionIDv0(sender, 6, Systemic)
+			require.NoError(t, err)
+			assert.NotEqual(t, interactionID.Fingerprint(), next.Fingerprint())
+
+			// Test unsupported flags
+			_, err = GenerateInteractionIDv0(sender, 5, AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			interactionID := RandomInteractionIDv0()
+
+			assert.NoError(t, interactionID.Validate())
+			assert.Equal(t, TagInteractionV0, interactionID.Tag())
+		})
+	})
+}
+
+func TestInteractionID_BinaryMarshal(t *testing.T) {
+	interaction := RandomInteractionIDv0()
+
+	encoded, err := interaction.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, interaction.Bytes(), encoded)
+
+	var decoded InteractionID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, interaction, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: interaction id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not an interaction id",
+	)
+}
@@ -0,0 +1,76 @@
+package identifiers
+
+import "sync"
+
+// InternPool deduplicates equal identifiers into shared values, which reduces the memory footprint
+// of large in-memory structures where the same identifiers appear many times. Interned identifiers
+// must be treated as immutable, since they are shared by all users of the pool.
+//
+// Eviction is generation-based: Rotate starts a new generation, and identifiers that are not
+// interned again during a full generation are evicted from the pool. Evicted identifiers remain
+// valid for existing users, but interning an equal identifier afterwards returns a new value.
+// An InternPool is safe for concurrent use, and must be created with NewInternPool.
+type InternPool struct {
+	mutex sync.Mutex
+
+	generation uint64
+	current    map[Identifier]*Identifier
+	previous   map[Identifier]*Identifier
+}
+
+// NewInternPool creates a new empty InternPool
+func NewInternPool() *InternPool {
+	return &InternPool{
+		current:  make(map[Identifier]*Identifier),
+		previous: make(map[Identifier]*Identifier),
+	}
+}
+
+// Intern returns the shared value for the given Identifier.
+// Equal identifiers return the same pointer while they remain in the pool.
+func (pool *InternPool) Intern(id Identifier) *Identifier {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if interned, ok := pool.current[id]; ok {
+		return interned
+	}
+
+	// Promote identifiers from the previous generation
+	interned, ok := pool.previous[id]
+	if ok {
+		delete(pool.previous, id)
+	} else {
+		interned = &id
+	}
+
+	pool.current[id] = interned
+
+	return interned
+}
+
+// Rotate starts a new generation in the pool, evicting all identifiers
+// that were not interned during the previous generation.
+func (pool *InternPool) Rotate() {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	pool.previous, pool.current = pool.current, make(map[Identifier]*Identifier, len(pool.current))
+	pool.generation++
+}
+
+// Generation returns the number of times the pool has been rotated
+func (pool *InternPool) Generation() uint64 {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	return pool.generation
+}
+
+// Len returns the number of identifiers in the pool
+func (pool *InternPool) Len() int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	return len(pool.current) + len(pool.previous)
+}
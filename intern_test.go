@@ -0,0 +1,39 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternPool(t *testing.T) {
+	pool := NewInternPool()
+
+	a := RandomAssetIDv0().AsIdentifier()
+	b := RandomLogicIDv0().AsIdentifier()
+
+	internedA := pool.Intern(a)
+	require.Equal(t, a, *internedA)
+	require.Same(t, internedA, pool.Intern(a))
+
+	internedB := pool.Intern(b)
+	require.NotSame(t, internedA, internedB)
+	require.Equal(t, 2, pool.Len())
+
+	// Identifiers interned in the previous generation are retained
+	pool.Rotate()
+	require.Equal(t, uint64(1), pool.Generation())
+	require.Equal(t, 2, pool.Len())
+	require.Same(t, internedA, pool.Intern(a))
+
+	// Identifiers not interned during a full generation are evicted
+	pool.Rotate()
+	require.Equal(t, uint64(2), pool.Generation())
+	require.Equal(t, 1, pool.Len())
+	require.Same(t, internedA, pool.Intern(a))
+
+	reinterned := pool.Intern(b)
+	require.NotSame(t, internedB, reinterned)
+	require.Equal(t, b, *reinterned)
+	require.Equal(t, b, *internedB)
+}
@@ -0,0 +1,107 @@
+// Package keccak implements the Keccak-256 hash function with the original Keccak padding (as used by
+// Ethereum), which differs from the standardized SHA3-256. It is used for the domain-separated hashes
+// of identifiers, and is implemented here as it is not available in the standard library.
+package keccak
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// rate is the rate of the Keccak-256 sponge in bytes
+const rate = 136
+
+// roundConstants are the round constants of the Keccak-f[1600] permutation
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotations are the rotation offsets of the rho step, in the order of the pi step
+var rotations = [24]int{1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14, 27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44}
+
+// lanes are the lanes visited by the pi step, starting after lane 0
+var lanes = [24]int{10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4, 15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1}
+
+// Sum256 returns the Keccak-256 digest of the concatenation of the given parts
+func Sum256(parts ...[]byte) [32]byte {
+	var (
+		state  [25]uint64
+		block  [rate]byte
+		filled int
+	)
+
+	for _, part := range parts {
+		for len(part) > 0 {
+			copied := copy(block[filled:], part)
+			filled, part = filled+copied, part[copied:]
+
+			if filled == rate {
+				absorb(&state, &block)
+				block, filled = [rate]byte{}, 0
+			}
+		}
+	}
+
+	// Pad the final block with the Keccak padding
+	block[filled] ^= 0x01
+	block[rate-1] ^= 0x80
+	absorb(&state, &block)
+
+	var digest [32]byte
+	for lane := 0; lane < 4; lane++ {
+		binary.LittleEndian.PutUint64(digest[lane*8:], state[lane])
+	}
+
+	return digest
+}
+
+// absorb absorbs the given block into the state and applies the permutation
+func absorb(state *[25]uint64, block *[rate]byte) {
+	for lane := 0; lane < rate/8; lane++ {
+		state[lane] ^= binary.LittleEndian.Uint64(block[lane*8:])
+	}
+
+	permute(state)
+}
+
+// permute applies the Keccak-f[1600] permutation to the state
+func permute(state *[25]uint64) {
+	var columns [5]uint64
+
+	for round := 0; round < 24; round++ {
+		// Theta step
+		for x := 0; x < 5; x++ {
+			columns[x] = state[x] ^ state[x+5] ^ state[x+10] ^ state[x+15] ^ state[x+20]
+		}
+
+		for x := 0; x < 5; x++ {
+			parity := columns[(x+4)%5] ^ bits.RotateLeft64(columns[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				state[y+x] ^= parity
+			}
+		}
+
+		// Rho and pi steps
+		current := state[1]
+		for step, lane := range lanes {
+			state[lane], current = bits.RotateLeft64(current, rotations[step]), state[lane]
+		}
+
+		// Chi step
+		for y := 0; y < 25; y += 5 {
+			copy(columns[:], state[y:y+5])
+
+			for x := 0; x < 5; x++ {
+				state[y+x] = columns[x] ^ (^columns[(x+1)%5] & columns[(x+2)%5])
+			}
+		}
+
+		// Iota step
+		state[0] ^= roundConstants[round]
+	}
+}
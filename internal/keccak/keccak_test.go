@@ -0,0 +1,34 @@
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		digest string
+	}{
+		{"Empty", nil, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"Short", []byte("abc"), "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{"Block", bytes.Repeat([]byte("a"), 136), "a6c4d403279fe3e0af03729caada8374b5ca54d8065329a3ebcaeb4b60aa386e"},
+		{"Blocks", bytes.Repeat([]byte("a"), 300), "5b7e0e47a96f32a88b4f14ca177982790807c40e1a105742ba0fc1babe1ef826"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			digest := Sum256(test.input)
+			assert.Equal(t, test.digest, hex.EncodeToString(digest[:]))
+
+			// Test that splitting the input into parts produces the same digest
+			for split := 0; split <= len(test.input); split += 17 {
+				assert.Equal(t, digest, Sum256(test.input[:split], nil, test.input[split:]))
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// KeyID is a unique identifier for a cryptographic key registered under a participant in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the key identifier.
+//   - Flags: The second byte contains flags for the key identifier.
+//   - Metadata: As of v0, KeyID has no metadata.
+//
+// Like all identifiers, the KeyID also contains a Fingerprint and a Variant ID.
+// The fingerprint of a KeyID is that of the ParticipantID the key is registered
+// under, while the variant ID contains the index of the key for that participant.
+// Flags of a KeyID are specific to a version and are invalid if set in an unsupported version.
+type KeyID [32]byte
+
+// NewKeyID creates a new KeyID from the 32-byte value.
+// It returns an error if the given data is not a valid KeyID.
+func NewKeyID(data [32]byte) (KeyID, error) {
+	// Convert the data into a KeyID
+	keyID := KeyID(data)
+	// Validate the KeyID
+	if err := keyID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return keyID, nil
+}
+
+// NewKeyIDFromBytes creates a new KeyID from the given byte slice.
+// The given value must have a length of 32 and validate into a KeyID.
+func NewKeyIDFromBytes(data []byte) (KeyID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: key id must be 32 bytes")
+	}
+
+	return NewKeyID([32]byte(data))
+}
+
+// NewKeyIDFromHex creates a new KeyID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a KeyID.
+func NewKeyIDFromHex(data string) (KeyID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindKey {
+		return KeyID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new KeyID from the decoded value
+	// Length check is performed in NewKeyIDFromBytes
+	converted, err := NewKeyIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustKeyID is an enforced version of NewKeyID.
+// Panics if an error occurs. Use with caution.
+func MustKeyID(data [32]byte) KeyID { return must(NewKeyID(data)) }
+
+// MustKeyIDFromBytes is an enforced version of NewKeyIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustKeyIDFromBytes(data []byte) KeyID { return must(NewKeyIDFromBytes(data)) }
+
+// MustKeyIDFromHex is an enforced version of NewKeyIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustKeyIDFromHex(data string) KeyID { return must(NewKeyIDFromHex(data)) }
+
+// Bytes returns the KeyID as a []byte
+func (key KeyID) Bytes() []byte { return key[:] }
+
+// String returns the KeyID as a hex-encoded string.
+// This is identical to KeyID.Hex() but is required for the fmt.Stringer interface
+func (key KeyID) String() string { return key.Hex() }
+
+// Hex returns the KeyID as a hex-encoded string with the 0x prefix
+func (key KeyID) Hex() string {
+	return prefix0xString + hex.EncodeToString(key[:])
+}
+
+// IsNil returns if the KeyID is nil, i.e., 0x000..000
+func (key KeyID) IsNil() bool { return key == KeyID{} }
+
+// AsIdentifier returns the KeyID as an Identifier.
+func (key KeyID) AsIdentifier() Identifier {
+	return Identifier(key)
+}
+
+// Tag returns the IdentifierTag for the KeyID.
+func (key KeyID) Tag() IdentifierTag {
+	return IdentifierTag(key[0])
+}
+
+// Flags returns the byte of flag bits from the KeyID
+func (key KeyID) Flags() byte {
+	return key[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the KeyID.
+func (key KeyID) Fingerprint() [24]byte {
+	return trimFingerprint(key)
+}
+
+// AccountID returns the 24-byte account ID from the KeyID.
+// This is identical to KeyID.Fingerprint()
+func (key KeyID) AccountID() [24]byte {
+	return trimFingerprint(key)
+}
+
+// Variant returns the 32-bit variant ID from the KeyID.
+func (key KeyID) Variant() uint32 {
+	variant := trimVariant(key)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the KeyID has a non-zero variant ID
+func (key KeyID) IsVariant() bool {
+	variant := trimVariant(key)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Index returns the variant ID of the KeyID as the index of the key for its participant,
+// and whether the variant ID of its kind is interpreted as an index (see VariantSemantics).
+func (key KeyID) Index() (uint32, bool) {
+	return variantAs(key.AsIdentifier(), IndexVariant)
+}
+
+// Flag returns if the given Flag is set on the KeyID.
+//
+// If the specified flag is not supported by the KeyID,
+// it will return False, regardless of the actual flag value.
+func (key KeyID) Flag(flag Flag) bool {
+	// Check if the flag is supported by KeyID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(key.Tag()) {
+		return false
+	}
+
+	return getFlag(key[1], flag.index)
+}
+
+// Validate returns an error if the KeyID is invalid.
+// An error is returned if the KeyID has an invalid tag or contains unsupported flags.
+func (key KeyID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := key.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a key tag
+	if key.Tag().Kind() != KindKey {
+		return ErrNotKeyID
+	}
+
+	// Check that there are no unsupported flags set
+	if (key[1] & key.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: key.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(key)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure KeyID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*KeyID)(nil)
+	_ encoding.TextUnmarshaler   = (*KeyID)(nil)
+	_ encoding.BinaryMarshaler   = (*KeyID)(nil)
+	_ encoding.BinaryUnmarshaler = (*KeyID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for KeyID
+func (key KeyID) MarshalText() ([]byte, error) {
+	return marshal32(key)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for KeyID
+func (key *KeyID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindKey)
+	if err != nil {
+		return err
+	}
+
+	*key = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for KeyID.
+// The KeyID is encoded as its raw 32 bytes.
+func (key KeyID) MarshalBinary() ([]byte, error) {
+	return key.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for KeyID.
+// The data must be 32 bytes long and validate into a KeyID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (key *KeyID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*key = KeyID{}
+		return nil
+	}
+
+	decoded, err := NewKeyIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*key = decoded
+	return nil
+}
+
+// GenerateKeyIDv0 creates a new KeyID for v0 with the given parameters.
+// The fingerprint is inherited from the given ParticipantID and the
+// index of the key for that participant is encoded as the variant ID.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:5}{encryption}{signing}][reserved:2][fingerprint:24][key index:4]
+func GenerateKeyIDv0(participant ParticipantID, keyIndex uint32, flags ...Flag) (KeyID, error) {
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for KeyID v0
+	metadata[0] = byte(TagKeyV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by KeyID v0
+		if !flag.Supports(TagKeyV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Obtain the fingerprint of the participant
+	fingerprint := participant.Fingerprint()
+
+	// Order the key ID buffer
+	// [metadata][fingerprint][key index]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the key index into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], keyIndex)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return KeyID(buffer), nil
+}
+
+// RandomKeyIDv0 creates a random v0 KeyID with
+// a random participant, key index and flags.
+//   - There is a 50% chance that the KeySigning flag will be set.
+//   - There is a 50% chance that the KeyEncryption flag will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomKeyIDv0() KeyID {
+	flags := make([]Flag, 0, 2)
+
+	if rand.Int64() > 0 {
+		flags = append(flags, KeySigning)
+	}
+
+	if rand.Int64() > 0 {
+		flags = append(flags, KeyEncryption)
+	}
+
+	// Safe to ignore error as the flags are supported
+	key, _ := GenerateKeyIDv0(RandomParticipantIDv0(), rand.Uint32(), flags...)
+
+	return key
+}
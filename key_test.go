@@ -0,0 +1,297 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyID(t *testing.T) {
+	data := [32]byte{
+		byte(TagKeyV0), // Tag
+		0b00000001,     // Flags
+		0x00, 0x00,     // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test KeyID
+	keyID, err := NewKeyID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagKeyV0, keyID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, keyID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), keyID.Variant())
+	// Test IsVariant
+	assert.True(t, keyID.IsVariant())
+
+	// Test Flags
+	assert.True(t, keyID.Flag(KeySigning))
+	assert.False(t, keyID.Flag(KeyEncryption))
+	assert.False(t, keyID.Flag(Systemic))
+	assert.False(t, keyID.Flag(AssetStateful)) // unsupported flag on set bit
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, keyID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsKeyID()
+	require.NoError(t, err)
+	require.Equal(t, keyID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], keyID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x9001000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, keyID.String())
+	assert.Equal(t, expectedHex, keyID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestKeyID_Constructor(t *testing.T) {
+	t.Run("NewKeyID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			keyID, err := NewKeyID([32]byte{
+				byte(TagKeyV0), // Tag
+				0b00000000,     // Flags
+				0x00, 0x01,     // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, keyID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewKeyID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewKeyID([32]byte{byte(TagKeyV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewKeyID([32]byte{byte(TagParticipantV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a key id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewKeyID([32]byte{
+				byte(TagKeyV0), // Tag
+				0b11111111,     // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for key id")
+		})
+	})
+
+	t.Run("NewKeyIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewKeyIDFromBytes([]byte{byte(TagKeyV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: key id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			keyID, err := NewKeyIDFromBytes([]byte{
+				byte(TagKeyV0), // Tag
+				0b00000000,     // Flags
+				0x00, 0x01,     // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, keyID.Validate())
+			require.Equal(t, KeyID{
+				byte(TagKeyV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, keyID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewKeyIDFromBytes([]byte{
+				byte(TagKeyV0), // Tag
+				0b00000000,     // Flags
+				0x00, 0x01,     // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: key id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewKeyIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			keyID, err := NewKeyIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagKeyV0), // Tag
+				0b00000000,     // Flags
+				0x00, 0x01,     // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, keyID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			keyID, err := NewKeyIDFromHex(hex.EncodeToString([]byte{
+				byte(TagKeyV0), // Tag
+				0b00000000,     // Flags
+				0x00, 0x01,     // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, keyID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewKeyIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewKeyIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustKeyID", func(t *testing.T) {
+		t.Run("MustKeyID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustKeyID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustKeyIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustKeyIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustKeyIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustKeyIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestKeyID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		keyID := RandomKeyIDv0()
+
+		encoded, err := json.Marshal(keyID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+keyID.Hex()+`"`, string(encoded))
+
+		var decoded KeyID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, keyID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded KeyID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded KeyID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded KeyID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestKeyID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			participant := RandomParticipantIDv0()
+			keyID, err := GenerateKeyIDv0(participant, 2, KeySigning, KeyEncryption)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagKeyV0, keyID.Tag())
+			assert.Equal(t, participant.Fingerprint(), keyID.Fingerprint())
+			assert.Equal(t, uint32(2), keyID.Variant())
+			assert.True(t, keyID.Flag(KeySigning))
+			assert.True(t, keyID.Flag(KeyEncryption))
+			assert.False(t, keyID.Flag(Systemic))
+
+			// Test unsupported flags
+			_, err = GenerateKeyIDv0(participant, 2, LogicAuxiliary)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			keyID := RandomKeyIDv0()
+
+			assert.NoError(t, keyID.Validate())
+			assert.Equal(t, TagKeyV0, keyID.Tag())
+		})
+	})
+}
+
+func TestKeyID_BinaryMarshal(t *testing.T) {
+	key := RandomKeyIDv0()
+
+	encoded, err := key.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, key.Bytes(), encoded)
+
+	var decoded KeyID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, key, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: key id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a key id",
+	)
+}
@@ -0,0 +1,116 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// AllowLegacy enables the acceptance of the legacy LogicID and AssetID string formats when
+// decoding a LogicID or an AssetID from text or JSON. Legacy strings are 70 (LogicID) or
+// 72 (AssetID) hex characters long, and are converted with ConvertLegacyLogicID and
+// ConvertLegacyAssetID. It is disabled by default and should only be set during initialization.
+var AllowLegacy = false
+
+const (
+	// legacyLogicIDLength is the length of a legacy LogicID in bytes.
+	legacyLogicIDLength = 35
+	// legacyAssetIDLength is the length of a legacy AssetID in bytes.
+	legacyAssetIDLength = 36
+)
+
+// ConvertLegacyLogicID converts a legacy LogicID string into a LogicID with the TagLogicV0 tag.
+// The legacy LogicID is a hex-encoded string (0x prefix is optional) of 35 bytes with the layout:
+//
+//	[head:1][edition:2][address:32]
+//
+// The upper 4 bits of the head are the version (which must be 0) and the lower 4 bits are the
+// flags of the legacy LogicID. The fields of the legacy LogicID are converted as follows:
+//   - Flags: The intrinsic (bit 0), extrinsic (bit 1) and auxiliary (bit 2) flags map into
+//     LogicIntrinsic, LogicExtrinsic and LogicAuxiliary while the systemic flag (bit 3) maps into Systemic.
+//   - Edition: The 16-bit edition (big-endian) is used as the variant of the LogicID.
+//   - Address: The account ID of the address (see AccountIDFromAddress) is used as the fingerprint.
+//
+// Returns an error if the legacy LogicID is malformed, or if the converted LogicID is
+// rejected by the flag rules or metadata validators that are registered for its kind.
+func ConvertLegacyLogicID(s string) (LogicID, error) {
+	decoded, err := decodeHexString(s)
+	if err != nil {
+		return Nil, err
+	}
+
+	if len(decoded) != legacyLogicIDLength {
+		return Nil, errors.New("invalid length: legacy logic id must be 35 bytes")
+	}
+
+	head := decoded[0]
+	if version := head >> 4; version != 0 {
+		return Nil, fmt.Errorf("%w: legacy logic id version %d", ErrUnsupportedVersion, version)
+	}
+
+	// Map the legacy flags into their equivalent flags
+	flags := make([]Flag, 0, 4)
+
+	for index, flag := range []Flag{LogicIntrinsic, LogicExtrinsic, LogicAuxiliary, Systemic} {
+		if getFlag(head, uint8(index)) {
+			flags = append(flags, flag)
+		}
+	}
+
+	edition := binary.BigEndian.Uint16(decoded[1:3])
+	address := Address(decoded[3:])
+
+	return GenerateLogicIDv0(AccountIDFromAddress(address), uint32(edition), 0, flags...)
+}
+
+// ConvertLegacyAssetID converts a legacy AssetID string into an AssetID with the TagAssetV0 tag.
+// The legacy AssetID is a hex-encoded string (0x prefix is optional) of 36 bytes with the layout:
+//
+//	[head:1][dimension:1][standard:2][address:32]
+//
+// The upper 4 bits of the head are the version (which must be 0) and the lower 4 bits are the
+// flags of the legacy AssetID. The fields of the legacy AssetID are converted as follows:
+//   - Flags: The stateful (bit 0) and logical (bit 1) flags map into AssetStateful and AssetLogical
+//     while the systemic flag (bit 3) maps into Systemic. Bit 2 is not defined and must not be set.
+//   - Standard: The 16-bit standard (big-endian) is used as the standard of the AssetID.
+//   - Address: The account ID of the address (see AccountIDFromAddress) is used as the fingerprint.
+//   - Dimension: AssetID v0 has no room for the dimension in its metadata, so it is used as the
+//     variant of the AssetID instead. Legacy assets that only differ by their dimension would otherwise
+//     collide after conversion, and legacy assets never had variants, which leaves the variant free to use.
+//
+// Returns an error if the legacy AssetID is malformed, or if the converted AssetID is
+// rejected by the flag rules or metadata validators that are registered for its kind.
+func ConvertLegacyAssetID(s string) (AssetID, error) {
+	decoded, err := decodeHexString(s)
+	if err != nil {
+		return Nil, err
+	}
+
+	if len(decoded) != legacyAssetIDLength {
+		return Nil, errors.New("invalid length: legacy asset id must be 36 bytes")
+	}
+
+	head := decoded[0]
+	if version := head >> 4; version != 0 {
+		return Nil, fmt.Errorf("%w: legacy asset id version %d", ErrUnsupportedVersion, version)
+	}
+
+	if getFlag(head, 2) {
+		return Nil, fmt.Errorf("%w: legacy asset id flag at bit 2", ErrUnsupportedFlag)
+	}
+
+	// Map the legacy flags into their equivalent flags
+	flags := make([]Flag, 0, 3)
+
+	for index, flag := range map[uint8]Flag{0: AssetStateful, 1: AssetLogical, 3: Systemic} {
+		if getFlag(head, index) {
+			flags = append(flags, flag)
+		}
+	}
+
+	dimension := decoded[1]
+	standard := binary.BigEndian.Uint16(decoded[2:4])
+	address := Address(decoded[4:])
+
+	return GenerateAssetIDv0(AccountIDFromAddress(address), uint32(dimension), standard, flags...)
+}
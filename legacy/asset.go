@@ -0,0 +1,137 @@
+package legacy
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// AssetIDLength is the length of a legacy AssetID in bytes.
+const AssetIDLength = 36
+
+// AssetID is a legacy identifier for an asset in the MOI Protocol.
+// It is the hex-encoded string (without a 0x prefix) of 36 bytes with the layout:
+//
+//	[head:1][dimension:1][standard:2][address:32]
+//
+// The upper 4 bits of the head are the version (always 0) and the lower 4 bits are the
+// flags: stateful (bit 0), logical (bit 1) and systemic (bit 3). Bit 2 is not defined.
+type AssetID string
+
+// NewAssetIDv0 creates a new v0 legacy AssetID with the given flags, dimension, standard and address.
+func NewAssetIDv0(stateful, logical, systemic bool, dimension uint8, standard uint16, address identifiers.Address) AssetID {
+	buffer := make([]byte, AssetIDLength)
+
+	// Set the version (0) and flags into the head (bit 2 is not defined)
+	buffer[0] = encodeFlags(stateful, logical, false, systemic)
+	buffer[1] = dimension
+
+	binary.BigEndian.PutUint16(buffer[2:4], standard)
+	copy(buffer[4:], address[:])
+
+	return AssetID(hex.EncodeToString(buffer))
+}
+
+// FromAssetID converts an AssetID into a legacy AssetID.
+// The variant of the AssetID is used as the dimension and must fit into 8 bits,
+// which is the inverse of the dimension policy of identifiers.ConvertLegacyAssetID.
+//
+// This conversion is lossy, as the AssetID only contains the 24 bytes in the middle of the legacy
+// address as its fingerprint. The first and last 4 bytes of the resulting address are always zero.
+func FromAssetID(asset identifiers.AssetID) (AssetID, error) {
+	if err := asset.Validate(); err != nil {
+		return "", err
+	}
+
+	if asset.Variant() > 0xFF {
+		return "", fmt.Errorf("variant %d does not fit into a legacy dimension", asset.Variant())
+	}
+
+	return NewAssetIDv0(
+		asset.Flag(identifiers.AssetStateful),
+		asset.Flag(identifiers.AssetLogical),
+		asset.Flag(identifiers.Systemic),
+		uint8(asset.Variant()),
+		asset.Standard(),
+		expandFingerprint(asset.Fingerprint()),
+	), nil
+}
+
+// ToAssetID converts the legacy AssetID into an AssetID with identifiers.ConvertLegacyAssetID
+func (asset AssetID) ToAssetID() (identifiers.AssetID, error) {
+	return identifiers.ConvertLegacyAssetID(string(asset))
+}
+
+// Bytes returns the decoded bytes of the legacy AssetID.
+// Returns an error if the legacy AssetID is not valid.
+func (asset AssetID) Bytes() ([]byte, error) {
+	decoded, err := decode(string(asset), AssetIDLength, "asset")
+	if err != nil {
+		return nil, err
+	}
+
+	// Bit 2 of the head is not defined, and is rejected like in identifiers.ConvertLegacyAssetID
+	if getFlag(decoded[0], 2) {
+		return nil, fmt.Errorf("%w: legacy asset id flag at bit 2", identifiers.ErrUnsupportedFlag)
+	}
+
+	return decoded, nil
+}
+
+// Validate returns an error if the legacy AssetID is not valid.
+// The version must be 0 and bit 2 of the head (which is not defined) must not be set.
+func (asset AssetID) Validate() error {
+	_, err := asset.Bytes()
+	return err
+}
+
+// Dimension returns the dimension of the legacy AssetID (0 if it is invalid)
+func (asset AssetID) Dimension() uint8 {
+	decoded, err := asset.Bytes()
+	if err != nil {
+		return 0
+	}
+
+	return decoded[1]
+}
+
+// Standard returns the standard of the legacy AssetID (0 if it is invalid)
+func (asset AssetID) Standard() uint16 {
+	decoded, err := asset.Bytes()
+	if err != nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint16(decoded[2:4])
+}
+
+// Address returns the address of the legacy AssetID (zero if it is invalid)
+func (asset AssetID) Address() identifiers.Address {
+	decoded, err := asset.Bytes()
+	if err != nil {
+		return identifiers.Address{}
+	}
+
+	return identifiers.Address(decoded[4:])
+}
+
+// IsStateful returns if the stateful flag is set on the legacy AssetID
+func (asset AssetID) IsStateful() bool { return asset.flag(0) }
+
+// IsLogical returns if the logical flag is set on the legacy AssetID
+func (asset AssetID) IsLogical() bool { return asset.flag(1) }
+
+// IsSystemic returns if the systemic flag is set on the legacy AssetID
+func (asset AssetID) IsSystemic() bool { return asset.flag(3) }
+
+// flag returns if the flag at the given bit index of the head is set (false if it is invalid)
+func (asset AssetID) flag(index uint8) bool {
+	decoded, err := asset.Bytes()
+	if err != nil {
+		return false
+	}
+
+	return getFlag(decoded[0], index)
+}
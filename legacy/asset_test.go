@@ -0,0 +1,82 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+func TestAssetID(t *testing.T) {
+	fingerprint := identifiers.RandomFingerprint()
+	address := expandFingerprint(fingerprint)
+	address[0], address[31] = 0xAA, 0xBB
+
+	asset := NewAssetIDv0(true, false, true, 4, 0x0102, address)
+	require.NoError(t, asset.Validate())
+	assert.Len(t, string(asset), AssetIDLength*2)
+
+	assert.Equal(t, uint8(4), asset.Dimension())
+	assert.Equal(t, uint16(0x0102), asset.Standard())
+	assert.Equal(t, address, asset.Address())
+	assert.True(t, asset.IsStateful())
+	assert.False(t, asset.IsLogical())
+	assert.True(t, asset.IsSystemic())
+
+	t.Run("ToAssetID", func(t *testing.T) {
+		converted, err := asset.ToAssetID()
+		require.NoError(t, err)
+
+		expected, err := identifiers.GenerateAssetIDv0(
+			fingerprint, 4, 0x0102, identifiers.AssetStateful, identifiers.Systemic,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, expected, converted)
+
+		// Converting back loses the outer bytes of the address
+		reverted, err := FromAssetID(converted)
+		require.NoError(t, err)
+		assert.Equal(t, NewAssetIDv0(true, false, true, 4, 0x0102, expandFingerprint(fingerprint)), reverted)
+	})
+
+	t.Run("FromAssetID", func(t *testing.T) {
+		source, err := identifiers.GenerateAssetIDv1(fingerprint, 9, 20, 0, identifiers.AssetLogical)
+		require.NoError(t, err)
+
+		converted, err := FromAssetID(source)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(9), converted.Dimension())
+		assert.Equal(t, uint16(20), converted.Standard())
+		assert.True(t, converted.IsLogical())
+		assert.False(t, converted.IsStateful())
+
+		_, err = FromAssetID(identifiers.AssetID{0xF0})
+		require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+		_, err = FromAssetID(identifiers.MustAssetID([32]byte{0x10, 30: 0x01}))
+		require.EqualError(t, err, "variant 256 does not fit into a legacy dimension")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []struct {
+			asset AssetID
+			err   string
+		}{
+			{"zz", "encoding/hex: invalid byte: U+007A 'z'"},
+			{"0x0000", "invalid length: legacy asset id must be 36 bytes"},
+			{AssetID("2" + string(asset)[1:]), "unsupported tag version: legacy asset id version 2"},
+			{AssetID("04" + string(asset)[2:]), "unsupported flag: legacy asset id flag at bit 2"},
+		}
+
+		for _, tt := range tests {
+			require.EqualError(t, tt.asset.Validate(), tt.err)
+
+			assert.Zero(t, tt.asset.Dimension())
+			assert.Zero(t, tt.asset.Standard())
+			assert.Zero(t, tt.asset.Address())
+			assert.False(t, tt.asset.IsStateful())
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package legacy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// decode decodes the given legacy identifier string (0x prefix is optional) and checks that
+// it has the given length and a v0 head. The name is used to describe the identifier in errors.
+func decode(data string, length int, name string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) != length {
+		return nil, fmt.Errorf("invalid length: legacy %v id must be %d bytes", name, length)
+	}
+
+	if version := decoded[0] >> 4; version != 0 {
+		return nil, fmt.Errorf("%w: legacy %v id version %d", identifiers.ErrUnsupportedVersion, name, version)
+	}
+
+	return decoded, nil
+}
+
+// encodeFlags encodes the given flags into the lower 4 bits of a legacy head,
+// with the first flag at bit 0. The version in the upper 4 bits is always 0.
+func encodeFlags(flags ...bool) byte {
+	var head byte
+
+	for index, flag := range flags {
+		if flag {
+			head |= 1 << index
+		}
+	}
+
+	return head
+}
+
+// getFlag returns if the flag at the given bit index of a legacy head is set
+func getFlag(head byte, index uint8) bool {
+	return head&(1<<index) != 0
+}
+
+// expandFingerprint expands the given fingerprint into a legacy address by placing
+// it in the 24 bytes in the middle of the address. The first and last 4 bytes are zero.
+func expandFingerprint(fingerprint [24]byte) identifiers.Address {
+	var address identifiers.Address
+
+	copy(address[4:28], fingerprint[:])
+
+	return address
+}
@@ -0,0 +1,5 @@
+// Package legacy implements the legacy LogicID and AssetID string formats that predate the
+// 32-byte tagged identifiers, for consumers that still need to speak the old wire format.
+// Legacy identifiers can be converted into their tagged equivalents and back, but the
+// conversion into a legacy identifier is lossy (see FromLogicID and FromAssetID).
+package legacy
@@ -0,0 +1,145 @@
+package legacy
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// LogicIDLength is the length of a legacy LogicID in bytes.
+const LogicIDLength = 35
+
+// LogicID is a legacy identifier for a logic in the MOI Protocol.
+// It is the hex-encoded string (without a 0x prefix) of 35 bytes with the layout:
+//
+//	[head:1][edition:2][address:32]
+//
+// The upper 4 bits of the head are the version (always 0) and the lower 4 bits are the
+// flags: intrinsic (bit 0), extrinsic (bit 1), auxiliary (bit 2) and systemic (bit 3).
+type LogicID string
+
+// NewLogicIDv0 creates a new v0 legacy LogicID with the given flags, edition and address.
+func NewLogicIDv0(intrinsic, extrinsic, auxiliary, systemic bool, edition uint16, address identifiers.Address) LogicID {
+	buffer := make([]byte, LogicIDLength)
+
+	// Set the version (0) and flags into the head
+	buffer[0] = encodeFlags(intrinsic, extrinsic, auxiliary, systemic)
+
+	binary.BigEndian.PutUint16(buffer[1:3], edition)
+	copy(buffer[3:], address[:])
+
+	return LogicID(hex.EncodeToString(buffer))
+}
+
+// FromLogicID converts a LogicID into a legacy LogicID.
+// The edition of the LogicID is used as the edition (see identifiers.LogicID.Edition),
+// which is the variant for v0 LogicIDs and must fit into 16 bits.
+//
+// This conversion is lossy, as the LogicID only contains the 24 bytes in the middle of the legacy
+// address as its fingerprint. The first and last 4 bytes of the resulting address are always zero.
+// Returns an error if any other part of the LogicID cannot be represented in the legacy format:
+// if it has no edition, a standard, a variant distinct from its edition (v1) or any flag other
+// than the intrinsic, extrinsic, auxiliary and systemic flags.
+func FromLogicID(logic identifiers.LogicID) (LogicID, error) {
+	if err := logic.Validate(); err != nil {
+		return "", err
+	}
+
+	// The variant of LogicID v0 is interpreted as its edition (if the variant semantics of
+	// the kind define it as such), which must fit into the 16 bits of a legacy edition
+	edition, ok := logic.Edition()
+	if !ok || edition > 0xFFFF {
+		return "", fmt.Errorf("variant %d does not fit into a legacy edition", logic.Variant())
+	}
+
+	if standard := logic.Standard(); standard != 0 {
+		return "", fmt.Errorf("standard %d cannot be represented in a legacy logic id", standard)
+	}
+
+	// The variant of LogicID v1 is distinct from its edition, and legacy LogicIDs have no variant
+	if logic.Tag().Version() >= 1 && logic.Variant() != 0 {
+		return "", fmt.Errorf("variant %d cannot be represented in a legacy logic id", logic.Variant())
+	}
+
+	for _, flag := range logic.ListFlags() {
+		if !slices.Contains(legacyLogicFlags, flag) {
+			return "", fmt.Errorf("%w: %v cannot be represented in a legacy logic id", identifiers.ErrUnsupportedFlag, flag)
+		}
+	}
+
+	return NewLogicIDv0(
+		logic.Flag(identifiers.LogicIntrinsic),
+		logic.Flag(identifiers.LogicExtrinsic),
+		logic.Flag(identifiers.LogicAuxiliary),
+		logic.Flag(identifiers.Systemic),
+		uint16(edition),
+		expandFingerprint(logic.Fingerprint()),
+	), nil
+}
+
+// legacyLogicFlags are the flags of a LogicID that can be represented in a legacy LogicID
+var legacyLogicFlags = []identifiers.Flag{
+	identifiers.LogicIntrinsic, identifiers.LogicExtrinsic, identifiers.LogicAuxiliary, identifiers.Systemic,
+}
+
+// ToLogicID converts the legacy LogicID into a LogicID with identifiers.ConvertLegacyLogicID
+func (logic LogicID) ToLogicID() (identifiers.LogicID, error) {
+	return identifiers.ConvertLegacyLogicID(string(logic))
+}
+
+// Bytes returns the decoded bytes of the legacy LogicID.
+// Returns an error if the legacy LogicID is not valid.
+func (logic LogicID) Bytes() ([]byte, error) {
+	return decode(string(logic), LogicIDLength, "logic")
+}
+
+// Validate returns an error if the legacy LogicID is not valid.
+func (logic LogicID) Validate() error {
+	_, err := logic.Bytes()
+	return err
+}
+
+// Edition returns the edition of the legacy LogicID (0 if it is invalid)
+func (logic LogicID) Edition() uint16 {
+	decoded, err := logic.Bytes()
+	if err != nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint16(decoded[1:3])
+}
+
+// Address returns the address of the legacy LogicID (zero if it is invalid)
+func (logic LogicID) Address() identifiers.Address {
+	decoded, err := logic.Bytes()
+	if err != nil {
+		return identifiers.Address{}
+	}
+
+	return identifiers.Address(decoded[3:])
+}
+
+// IsIntrinsic returns if the intrinsic flag is set on the legacy LogicID
+func (logic LogicID) IsIntrinsic() bool { return logic.flag(0) }
+
+// IsExtrinsic returns if the extrinsic flag is set on the legacy LogicID
+func (logic LogicID) IsExtrinsic() bool { return logic.flag(1) }
+
+// IsAuxiliary returns if the auxiliary flag is set on the legacy LogicID
+func (logic LogicID) IsAuxiliary() bool { return logic.flag(2) }
+
+// IsSystemic returns if the systemic flag is set on the legacy LogicID
+func (logic LogicID) IsSystemic() bool { return logic.flag(3) }
+
+// flag returns if the flag at the given bit index of the head is set (false if it is invalid)
+func (logic LogicID) flag(index uint8) bool {
+	decoded, err := logic.Bytes()
+	if err != nil {
+		return false
+	}
+
+	return getFlag(decoded[0], index)
+}
@@ -0,0 +1,122 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+func TestLogicID(t *testing.T) {
+	fingerprint := identifiers.RandomFingerprint()
+	address := expandFingerprint(fingerprint)
+	address[0], address[31] = 0xAA, 0xBB
+
+	logic := NewLogicIDv0(true, false, true, false, 300, address)
+	require.NoError(t, logic.Validate())
+	assert.Len(t, string(logic), LogicIDLength*2)
+
+	assert.Equal(t, uint16(300), logic.Edition())
+	assert.Equal(t, address, logic.Address())
+	assert.True(t, logic.IsIntrinsic())
+	assert.False(t, logic.IsExtrinsic())
+	assert.True(t, logic.IsAuxiliary())
+	assert.False(t, logic.IsSystemic())
+
+	t.Run("ToLogicID", func(t *testing.T) {
+		converted, err := logic.ToLogicID()
+		require.NoError(t, err)
+
+		expected, err := identifiers.GenerateLogicIDv0(
+			fingerprint, 300, 0, identifiers.LogicIntrinsic, identifiers.LogicAuxiliary,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, expected, converted)
+
+		// Converting back loses the outer bytes of the address
+		reverted, err := FromLogicID(converted)
+		require.NoError(t, err)
+		assert.Equal(t, NewLogicIDv0(true, false, true, false, 300, expandFingerprint(fingerprint)), reverted)
+	})
+
+	t.Run("FromLogicID", func(t *testing.T) {
+		source, err := identifiers.GenerateLogicIDv1(
+			fingerprint, 7, 0, identifiers.LogicExtrinsic, identifiers.Systemic,
+		)
+		require.NoError(t, err)
+
+		converted, err := FromLogicID(source)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(7), converted.Edition())
+		assert.True(t, converted.IsExtrinsic())
+		assert.True(t, converted.IsSystemic())
+
+		_, err = FromLogicID(identifiers.LogicID{0xF0})
+		require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+		_, err = FromLogicID(identifiers.MustLogicID([32]byte{0x20, 28: 0x00, 29: 0x01}))
+		require.EqualError(t, err, "variant 65536 does not fit into a legacy edition")
+	})
+
+	t.Run("Unrepresentable", func(t *testing.T) {
+		generate := func(logic identifiers.LogicID, err error) identifiers.LogicID {
+			require.NoError(t, err)
+			return logic
+		}
+
+		tests := []struct {
+			name  string
+			logic identifiers.LogicID
+			err   string
+		}{
+			{
+				"Standard",
+				generate(identifiers.GenerateLogicIDv0(fingerprint, 1, 2)),
+				"standard 2 cannot be represented in a legacy logic id",
+			},
+			{
+				"Variant",
+				generate(identifiers.GenerateLogicIDv1(fingerprint, 7, 9)),
+				"variant 9 cannot be represented in a legacy logic id",
+			},
+			{
+				"Immutable",
+				generate(identifiers.GenerateLogicIDv0(fingerprint, 1, 0, identifiers.LogicImmutable)),
+				"unsupported flag: logic-immutable cannot be represented in a legacy logic id",
+			},
+			{
+				"Nested",
+				generate(identifiers.GenerateLogicIDv1(fingerprint, 7, 0, identifiers.Nested)),
+				"unsupported flag: nested cannot be represented in a legacy logic id",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := FromLogicID(tt.logic)
+				require.EqualError(t, err, tt.err)
+			})
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []struct {
+			logic LogicID
+			err   string
+		}{
+			{"zz", "encoding/hex: invalid byte: U+007A 'z'"},
+			{"0x0000", "invalid length: legacy logic id must be 35 bytes"},
+			{LogicID("1" + string(logic)[1:]), "unsupported tag version: legacy logic id version 1"},
+		}
+
+		for _, tt := range tests {
+			require.EqualError(t, tt.logic.Validate(), tt.err)
+
+			assert.Zero(t, tt.logic.Edition())
+			assert.Zero(t, tt.logic.Address())
+			assert.False(t, tt.logic.IsIntrinsic())
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyLogicID encodes a legacy LogicID from the given head, edition and address
+func legacyLogicID(head byte, edition uint16, address [32]byte) string {
+	encoded := append([]byte{head, byte(edition >> 8), byte(edition)}, address[:]...)
+	return "0x" + hex.EncodeToString(encoded)
+}
+
+// legacyAssetID encodes a legacy AssetID from the given head, dimension, standard and address
+func legacyAssetID(head, dimension byte, standard uint16, address [32]byte) string {
+	encoded := append([]byte{head, dimension, byte(standard >> 8), byte(standard)}, address[:]...)
+	return "0x" + hex.EncodeToString(encoded)
+}
+
+func TestConvertLegacyLogicID(t *testing.T) {
+	var address [32]byte
+
+	fingerprint := RandomFingerprint()
+	copy(address[4:28], fingerprint[:])
+	copy(address[28:], []byte{1, 2, 3, 4})
+
+	tests := []struct {
+		name    string
+		input   string
+		flags   []Flag
+		variant uint32
+	}{
+		{"NoFlags", legacyLogicID(0x00, 0, address), nil, 0},
+		{"Intrinsic", legacyLogicID(0x01, 5, address), []Flag{LogicIntrinsic}, 5},
+		{"Extrinsic", legacyLogicID(0x02, 300, address), []Flag{LogicExtrinsic}, 300},
+		{"Auxiliary", legacyLogicID(0x04, 1, address), []Flag{LogicAuxiliary}, 1},
+		{"Systemic", legacyLogicID(0x08, 1, address), []Flag{Systemic}, 1},
+		{
+			"AllFlags", legacyLogicID(0x0F, 0xFFFF, address)[2:],
+			[]Flag{LogicIntrinsic, LogicExtrinsic, LogicAuxiliary, Systemic}, 0xFFFF,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic, err := ConvertLegacyLogicID(tt.input)
+			require.NoError(t, err)
+			require.NoError(t, logic.Validate())
+
+			assert.Equal(t, TagLogicV0, logic.Tag())
+			assert.Equal(t, fingerprint, logic.Fingerprint())
+			assert.Equal(t, tt.variant, logic.Variant())
+			assert.Equal(t, must(GenerateLogicIDv0(logic.Fingerprint(), tt.variant, 0, tt.flags...)), logic)
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := ConvertLegacyLogicID("0xZZ")
+		require.EqualError(t, err, "encoding/hex: invalid byte: U+005A 'Z'")
+
+		_, err = ConvertLegacyLogicID(RandomLogicIDv0().String())
+		require.EqualError(t, err, "invalid length: legacy logic id must be 35 bytes")
+
+		_, err = ConvertLegacyLogicID(legacyLogicID(0x10, 0, address))
+		require.EqualError(t, err, "unsupported tag version: legacy logic id version 1")
+	})
+
+	t.Run("MetadataValidators", func(t *testing.T) {
+		errRejected := errors.New("rejected")
+
+		require.NoError(t, RegisterMetadataValidator(KindLogic, func(Identifier) error { return errRejected }))
+		t.Cleanup(func() { unregisterMetadataValidators(t, KindLogic) })
+
+		// Test that the error of the generator is returned instead of Nil
+		logic, err := ConvertLegacyLogicID(legacyLogicID(0x00, 1, address))
+		require.ErrorIs(t, err, errRejected)
+		require.Equal(t, LogicID(Nil), logic)
+	})
+}
+
+func TestConvertLegacyAssetID(t *testing.T) {
+	var address [32]byte
+
+	fingerprint := RandomFingerprint()
+	copy(address[4:28], fingerprint[:])
+	copy(address[28:], []byte{1, 2, 3, 4})
+
+	tests := []struct {
+		name     string
+		input    string
+		flags    []Flag
+		standard uint16
+		variant  uint32
+	}{
+		{"NoFlags", legacyAssetID(0x00, 0, 0, address), nil, 0, 0},
+		{"Stateful", legacyAssetID(0x01, 0, 1, address), []Flag{AssetStateful}, 1, 0},
+		{"Logical", legacyAssetID(0x02, 3, 0x1234, address), []Flag{AssetLogical}, 0x1234, 3},
+		{"Systemic", legacyAssetID(0x08, 255, 0, address), []Flag{Systemic}, 0, 255},
+		{
+			"AllFlags", legacyAssetID(0x0B, 1, 2, address)[2:],
+			[]Flag{AssetStateful, AssetLogical, Systemic}, 2, 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asset, err := ConvertLegacyAssetID(tt.input)
+			require.NoError(t, err)
+			require.NoError(t, asset.Validate())
+
+			assert.Equal(t, TagAssetV0, asset.Tag())
+			assert.Equal(t, fingerprint, asset.Fingerprint())
+			assert.Equal(t, tt.standard, asset.Standard())
+			assert.Equal(t, tt.variant, asset.Variant())
+			assert.Equal(t, must(GenerateAssetIDv0(fingerprint, tt.variant, tt.standard, tt.flags...)), asset)
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := ConvertLegacyAssetID("0xZZ")
+		require.EqualError(t, err, "encoding/hex: invalid byte: U+005A 'Z'")
+
+		_, err = ConvertLegacyAssetID(legacyLogicID(0x00, 0, address))
+		require.EqualError(t, err, "invalid length: legacy asset id must be 36 bytes")
+
+		_, err = ConvertLegacyAssetID(legacyAssetID(0x20, 0, 0, address))
+		require.EqualError(t, err, "unsupported tag version: legacy asset id version 2")
+
+		_, err = ConvertLegacyAssetID(legacyAssetID(0x04, 0, 0, address))
+		require.EqualError(t, err, "unsupported flag: legacy asset id flag at bit 2")
+	})
+
+	t.Run("MetadataValidators", func(t *testing.T) {
+		errStandard := errors.New("standards are not allowed")
+
+		require.NoError(t, RegisterMetadataValidator(KindAsset, func(id Identifier) error {
+			if AssetID(id).Standard() != 0 {
+				return errStandard
+			}
+
+			return nil
+		}))
+		t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+		// Test that the error of the generator is returned instead of Nil
+		asset, err := ConvertLegacyAssetID(legacyAssetID(0x00, 0, 1, address))
+		require.ErrorIs(t, err, errStandard)
+		require.Equal(t, AssetID(Nil), asset)
+
+		_, err = ConvertLegacyAssetID(legacyAssetID(0x00, 0, 0, address))
+		require.NoError(t, err)
+	})
+}
@@ -6,14 +6,17 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand/v2"
+	"time"
 )
 
 // LogicID is a unique identifier for a logic in the MOI Protocol.
 // It is 32 bytes long and its first 4 bytes are structured as follows:
 //   - Tag: The first byte contains the tag for the logic identifier.
 //   - Flags: The second byte contains flags for the logic identifier.
-//   - Metadata: As of v0, LogicID has no metadata.
+//   - Metadata: The next 2 bytes are reserved for the standard of the logic.
+//     As of v1, they contain the edition of the logic instead.
 //
 // Like all identifiers, the LogicID also contains an Fingerprint and a Variant ID.
 // Flags of a LogicID are specific to a version and are invalid if set in an unsupported version.
@@ -47,6 +50,12 @@ func NewLogicIDFromBytes(data []byte) (LogicID, error) {
 // The given value must decode as hexadecimal string (0x prefix is optional),
 // with a length of 64 characters (32 bytes) and validate into an LogicID.
 func NewLogicIDFromHex(data string) (LogicID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindLogic {
+		return LogicID(id), nil
+	}
+
 	// Decode the given hex string into []byte
 	decoded, err := decodeHexString(data)
 	if err != nil {
@@ -55,7 +64,15 @@ func NewLogicIDFromHex(data string) (LogicID, error) {
 
 	// Create a new LogicID from the decoded value
 	// Length check is performed in NewLogicIDFromBytes
-	return NewLogicIDFromBytes(decoded)
+	converted, err := NewLogicIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
 }
 
 // MustLogicID is an enforced version of NewLogicID.
@@ -82,6 +99,9 @@ func (logic LogicID) Hex() string {
 	return prefix0xString + hex.EncodeToString(logic[:])
 }
 
+// IsNil returns if the LogicID is nil, i.e., 0x000..000
+func (logic LogicID) IsNil() bool { return logic == LogicID{} }
+
 // AsIdentifier returns the LogicID as an Identifier.
 func (logic LogicID) AsIdentifier() Identifier {
 	return Identifier(logic)
@@ -92,11 +112,28 @@ func (logic LogicID) Tag() IdentifierTag {
 	return IdentifierTag(logic[0])
 }
 
+// Flags returns the byte of flag bits from the LogicID
+func (logic LogicID) Flags() byte {
+	return logic[1]
+}
+
+// ListFlags returns the flags (including custom flags) that are set on the LogicID, ordered by their bit index.
+// Set bits that are not supported by the LogicID are not listed (see FlagSet.List).
+func (logic LogicID) ListFlags() []Flag {
+	return NewFlagSet(logic.Tag(), logic.Flags()).List()
+}
+
 // Fingerprint returns the 24-byte fingerprint ID from the LogicID.
 func (logic LogicID) Fingerprint() [24]byte {
 	return trimFingerprint(logic)
 }
 
+// AccountID returns the 24-byte account ID from the LogicID.
+// This is identical to LogicID.Fingerprint()
+func (logic LogicID) AccountID() [24]byte {
+	return trimFingerprint(logic)
+}
+
 // Variant returns the 32-bit variant ID from the LogicID.
 func (logic LogicID) Variant() uint32 {
 	variant := trimVariant(logic)
@@ -109,6 +146,30 @@ func (logic LogicID) IsVariant() bool {
 	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
 }
 
+// Edition returns the edition of the logic and whether the LogicID defines its edition.
+// As of v1, the edition is encoded in the metadata and is distinct from the variant ID. For v0,
+// the variant ID is returned as the edition if the variant ID of its kind is interpreted as an
+// edition (see VariantSemantics).
+func (logic LogicID) Edition() (uint32, bool) {
+	if logic.Tag().Version() >= 1 {
+		// get the edition from the 2nd and 3rd bytes
+		return uint32(binary.BigEndian.Uint16(logic[2:4])), true
+	}
+
+	return variantAs(logic.AsIdentifier(), EditionVariant)
+}
+
+// Standard returns the 16-bit standard for the LogicID.
+// Returns 0 for v1 LogicIDs, which contain the edition of the logic in their metadata instead.
+func (logic LogicID) Standard() uint16 {
+	if logic.Tag().Version() >= 1 {
+		return 0
+	}
+
+	// get the standard from the 2nd and 3rd bytes
+	return binary.BigEndian.Uint16(logic[2:4])
+}
+
 // Flag returns if the given Flag is set on the LogicID.
 //
 // If the specified flag is not supported by the LogicID,
@@ -128,26 +189,39 @@ func (logic LogicID) Flag(flag Flag) bool {
 func (logic LogicID) Validate() error {
 	// Check basic validity of the identifier tag
 	if err := logic.Tag().Validate(); err != nil {
-		return fmt.Errorf("invalid tag: %w", err)
+		return invalidTag(err)
 	}
 
 	// Check if the tag is a logic tag
 	if logic.Tag().Kind() != KindLogic {
-		return errors.New("invalid tag: not a logic id")
+		return ErrNotLogicID
 	}
 
 	// Check that there are no unsupported flags set
-	if (logic[1] & flagMasks[logic.Tag()]) != 0 {
-		return errors.New("invalid flags: unsupported flags for logic id")
+	if (logic[1] & logic.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: logic.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(logic)); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// DeriveVariant returns a new LogicID with the given variant ID and specified flags set/unset.
+// Returns an error if the given flags are not supported for the LogicID.
+func (logic LogicID) DeriveVariant(variant uint32, set []Flag, unset []Flag) (LogicID, error) {
+	return deriveVariant(logic, variant, set, unset, NewLogicID)
+}
+
 var (
-	// Ensure LogicID implements text marshaling interfaces
-	_ encoding.TextMarshaler   = (*LogicID)(nil)
-	_ encoding.TextUnmarshaler = (*LogicID)(nil)
+	// Ensure LogicID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*LogicID)(nil)
+	_ encoding.TextUnmarshaler   = (*LogicID)(nil)
+	_ encoding.BinaryMarshaler   = (*LogicID)(nil)
+	_ encoding.BinaryUnmarshaler = (*LogicID)(nil)
 )
 
 // MarshalText implements the encoding.TextMarshaler interface for LogicID
@@ -155,9 +229,45 @@ func (logic LogicID) MarshalText() ([]byte, error) {
 	return marshal32(logic)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for LogicID
+// UnmarshalText implements the encoding.TextUnmarshaler interface for LogicID.
+// Legacy LogicID strings (with the 0x prefix) are also accepted if AllowLegacy is enabled.
 func (logic *LogicID) UnmarshalText(data []byte) error {
-	decoded, err := unmarshal32(data)
+	// Convert the legacy LogicID format if allowed
+	if AllowLegacy && has0xPrefixBytes(data) && len(trim0xPrefixBytes(data)) == legacyLogicIDLength*2 {
+		converted, err := ConvertLegacyLogicID(string(data))
+		if err != nil {
+			return err
+		}
+
+		*logic = converted
+		return nil
+	}
+
+	decoded, err := unmarshalCached(data, KindLogic)
+	if err != nil {
+		return err
+	}
+
+	*logic = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for LogicID.
+// The LogicID is encoded as its raw 32 bytes.
+func (logic LogicID) MarshalBinary() ([]byte, error) {
+	return logic.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for LogicID.
+// The data must be 32 bytes long and validate into a LogicID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (logic *LogicID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*logic = LogicID{}
+		return nil
+	}
+
+	decoded, err := NewLogicIDFromBytes(data)
 	if err != nil {
 		return err
 	}
@@ -167,10 +277,12 @@ func (logic *LogicID) UnmarshalText(data []byte) error {
 }
 
 // GenerateLogicIDv0 creates a new LogicID for v0 with the given parameters.
+// The standard is encoded into the metadata and can be zero if the logic has no standard.
 // Returns an error if unsupported flags are used.
 //
-// [tag:1][{systemic}{reserved:4}{auxiliary}{extrinsic}{intrinsic}][standard:2][fingerprint:24][variant:4]
-func GenerateLogicIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (LogicID, error) {
+// [tag:1][flags:1][standard:2][fingerprint:24][variant:4]
+// [flags] = [{systemic}{nested}{reserved:2}{immutable}{auxiliary}{extrinsic}{intrinsic}]
+func GenerateLogicIDv0(fingerprint [24]byte, variant uint32, standard uint16, flags ...Flag) (LogicID, error) {
 	// Create the metadata buffer
 	// [tag][flags][standard]
 	metadata := make([]byte, 4)
@@ -188,6 +300,9 @@ func GenerateLogicIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (Log
 		metadata[1] = setFlag(metadata[1], flag.index, true)
 	}
 
+	// Encode and attach the standard to the metadata
+	binary.BigEndian.PutUint16(metadata[2:], standard)
+
 	// Order the logic ID buffer
 	// [metadata][fingerprint][variant]
 	buffer := make([]byte, 0, 32)
@@ -197,6 +312,11 @@ func GenerateLogicIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (Log
 	buffer = append(buffer, make([]byte, 4)...)
 	binary.BigEndian.PutUint32(buffer[28:], variant)
 
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
 	return LogicID(buffer), nil
 }
 
@@ -205,9 +325,119 @@ func GenerateLogicIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (Log
 //   - There is a 50% chance that the LogicIntrinsic flag will be set.
 //   - There is a 50% chance that the LogicExtrinsic flag will be set.
 //   - There is a 50% chance that the LogicAuxiliary flag will be set.
+//   - There is a 50% chance that the LogicImmutable flag will be set.
 //   - There is a 0% chance that the Systemic flag will be set.
 func RandomLogicIDv0() LogicID {
-	flags := make([]Flag, 0, 3)
+	flags := make([]Flag, 0, 4)
+
+	if rand.Int64() > 0 {
+		flags = append(flags, LogicIntrinsic)
+	}
+
+	if rand.Int64() > 0 {
+		flags = append(flags, LogicExtrinsic)
+	}
+
+	if rand.Int64() > 0 {
+		flags = append(flags, LogicAuxiliary)
+	}
+
+	if rand.Int64() > 0 {
+		flags = append(flags, LogicImmutable)
+	}
+
+	// Safe to ignore error as the flags are supported
+	logic, _ := GenerateLogicIDv0(RandomFingerprint(), rand.Uint32(), 0, flags...)
+
+	return logic
+}
+
+// DeriveLogicIDv0 derives the v0 LogicID of a logic deployed by the given deployer with the given nonce,
+// which allows clients to predict the LogicID of a deployment before it is executed. The fingerprint
+// is derived from a domain-separated hash of the deployer and the nonce, and the variant is always zero.
+// Returns an error if the deployer is invalid or if unsupported flags are used.
+func DeriveLogicIDv0(deployer ParticipantID, nonce uint64, flags ...Flag) (LogicID, error) {
+	// Check that the deployer is a valid participant
+	if err := deployer.Validate(); err != nil {
+		return Nil, fmt.Errorf("invalid deployer: %w", err)
+	}
+
+	// Derive the fingerprint from the deployer and nonce
+	fingerprint := hashFingerprint("moi.logic", deployer.Bytes(), binary.BigEndian.AppendUint64(nil, nonce))
+
+	return GenerateLogicIDv0(fingerprint, 0, 0, flags...)
+}
+
+// DeriveLogicIDv0Salted derives the v0 LogicID of a logic deployed by the given deployer with the given salt
+// and code hash. Unlike DeriveLogicIDv0, the LogicID does not depend on the order of deployments, which allows
+// factories to compute the LogicID of a deployment counterfactually. The fingerprint is derived from a
+// domain-separated hash of the deployer, salt and code hash, and the variant is always zero.
+// Returns an error if the deployer is invalid or if unsupported flags are used.
+func DeriveLogicIDv0Salted(deployer ParticipantID, salt, codeHash [32]byte, flags ...Flag) (LogicID, error) {
+	// Check that the deployer is a valid participant
+	if err := deployer.Validate(); err != nil {
+		return Nil, fmt.Errorf("invalid deployer: %w", err)
+	}
+
+	// Derive the fingerprint from the deployer, salt and code hash
+	fingerprint := hashFingerprint("moi.logic.salted", deployer.Bytes(), salt[:], codeHash[:])
+
+	return GenerateLogicIDv0(fingerprint, 0, 0, flags...)
+}
+
+// GenerateLogicIDv1 creates a new LogicID for v1 with the given parameters.
+// Unlike v0, the metadata contains the edition of the logic, which is distinct from its variant ID.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][flags:1][edition:2][fingerprint:24][variant:4]
+// [flags] = [{systemic}{nested}{reserved:2}{immutable}{auxiliary}{extrinsic}{intrinsic}]
+func GenerateLogicIDv1(fingerprint [24]byte, edition uint16, variant uint32, flags ...Flag) (LogicID, error) {
+	// Create the metadata buffer
+	// [tag][flags][edition]
+	metadata := make([]byte, 4)
+	// Attach the tag for LogicID v1
+	metadata[0] = byte(TagLogicV1)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by LogicID v1
+		if !flag.Supports(TagLogicV1) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Encode and attach the edition to the metadata
+	binary.BigEndian.PutUint16(metadata[2:], edition)
+
+	// Order the logic ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return LogicID(buffer), nil
+}
+
+// RandomLogicIDv1 creates a random v1 LogicID
+// with a random fingerprint, edition, variant ID and flags.
+//   - There is a 50% chance that the LogicIntrinsic flag will be set.
+//   - There is a 50% chance that the LogicExtrinsic flag will be set.
+//   - There is a 50% chance that the LogicAuxiliary flag will be set.
+//   - There is a 50% chance that the LogicImmutable flag will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomLogicIDv1() LogicID {
+	flags := make([]Flag, 0, 4)
 
 	if rand.Int64() > 0 {
 		flags = append(flags, LogicIntrinsic)
@@ -221,8 +451,24 @@ func RandomLogicIDv0() LogicID {
 		flags = append(flags, LogicAuxiliary)
 	}
 
+	if rand.Int64() > 0 {
+		flags = append(flags, LogicImmutable)
+	}
+
 	// Safe to ignore error as the flags are supported
-	logic, _ := GenerateLogicIDv0(RandomFingerprint(), rand.Uint32(), flags...)
+	logic, _ := GenerateLogicIDv1(RandomFingerprint(), uint16(rand.UintN(math.MaxUint16)), rand.Uint32(), flags...)
 
 	return logic
 }
+
+// GenerateLogicIDv1Timed creates a new LogicID for v1 with the given edition and a time-sortable variant for
+// the given time (see TimeVariant), so that the logics deployed by the same account sort chronologically.
+// Returns an error if the time is outside the range of time variants or if unsupported flags are used.
+func GenerateLogicIDv1Timed(fingerprint [24]byte, edition uint16, t time.Time, flags ...Flag) (LogicID, error) {
+	variant, err := TimeVariant(t)
+	if err != nil {
+		return Nil, err
+	}
+
+	return GenerateLogicIDv1(fingerprint, edition, variant, flags...)
+}
@@ -42,6 +42,9 @@ func TestLogicID(t *testing.T) {
 	// Test IsVariant
 	assert.True(t, logicID.IsVariant())
 
+	// Test Standard
+	assert.Equal(t, uint16(0x10), logicID.Standard())
+
 	// Test Flags
 	assert.True(t, logicID.Flag(LogicIntrinsic))
 	assert.False(t, logicID.Flag(LogicExtrinsic))
@@ -264,6 +267,29 @@ func TestLogicID_TextMarshal(t *testing.T) {
 			"encoding/hex: invalid byte: U+0059 'Y'",
 		)
 	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		legacy := legacyLogicID(0x03, 9, [32]byte{})[2:]
+
+		var decoded LogicID
+
+		// Legacy strings are rejected unless allowed
+		require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"`+legacy+`"`), &decoded))
+
+		AllowLegacy = true
+		t.Cleanup(func() { AllowLegacy = false })
+
+		require.NoError(t, json.Unmarshal([]byte(`"0x`+legacy+`"`), &decoded))
+		require.Equal(t, must(ConvertLegacyLogicID(legacy)), decoded)
+
+		// Legacy strings must have the 0x prefix, like tagged LogicIDs
+		require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"`+legacy+`"`), &decoded))
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"`+legacyLogicID(0x10, 0, [32]byte{})+`"`), &decoded),
+			"unsupported tag version: legacy logic id version 1",
+		)
+	})
 }
 
 func TestLogicID_Generation(t *testing.T) {
@@ -273,6 +299,7 @@ func TestLogicID_Generation(t *testing.T) {
 			logicID, err := GenerateLogicIDv0(
 				fingerprint,
 				42,
+				0x0114,
 				LogicIntrinsic,
 				LogicExtrinsic,
 			)
@@ -280,12 +307,88 @@ func TestLogicID_Generation(t *testing.T) {
 
 			assert.Equal(t, TagLogicV0, logicID.Tag())
 			assert.Equal(t, uint32(42), logicID.Variant())
+			assert.Equal(t, uint16(0x0114), logicID.Standard())
 			assert.True(t, logicID.Flag(LogicIntrinsic))
 			assert.True(t, logicID.Flag(LogicExtrinsic))
 			assert.False(t, logicID.Flag(LogicAuxiliary))
 
 			// Test unsupported flags
-			_, err = GenerateLogicIDv0(fingerprint, 42, AssetLogical)
+			_, err = GenerateLogicIDv0(fingerprint, 42, 0, AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Derive", func(t *testing.T) {
+			deployer := RandomParticipantIDv0()
+			logicID, err := DeriveLogicIDv0(deployer, 7, LogicIntrinsic)
+			require.NoError(t, err)
+			require.NoError(t, logicID.Validate())
+
+			assert.Equal(t, TagLogicV0, logicID.Tag())
+			assert.Equal(t, uint32(0), logicID.Variant())
+			assert.True(t, logicID.Flag(LogicIntrinsic))
+			assert.Equal(t, hashFingerprint("moi.logic", deployer.Bytes(), []byte{0, 0, 0, 0, 0, 0, 0, 7}),
+				logicID.Fingerprint())
+
+			// Test deterministic derivation
+			rederived, err := DeriveLogicIDv0(deployer, 7, LogicIntrinsic)
+			require.NoError(t, err)
+			assert.Equal(t, logicID, rederived)
+
+			// Test that the nonce and deployer affect the fingerprint
+			next, err := DeriveLogicIDv0(deployer, 8, LogicIntrinsic)
+			require.NoError(t, err)
+			assert.NotEqual(t, logicID.Fingerprint(), next.Fingerprint())
+
+			other, err := DeriveLogicIDv0(RandomParticipantIDv0(), 7, LogicIntrinsic)
+			require.NoError(t, err)
+			assert.NotEqual(t, logicID.Fingerprint(), other.Fingerprint())
+
+			// Test invalid deployers and unsupported flags
+			_, err = DeriveLogicIDv0(ParticipantID(RandomAssetIDv0()), 7)
+			assert.EqualError(t, err, "invalid deployer: invalid tag: not a participant id")
+
+			_, err = DeriveLogicIDv0(deployer, 7, AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("DeriveSalted", func(t *testing.T) {
+			deployer := RandomParticipantIDv0()
+			salt, codeHash := [32]byte{1}, [32]byte{2}
+
+			logicID, err := DeriveLogicIDv0Salted(deployer, salt, codeHash, LogicExtrinsic)
+			require.NoError(t, err)
+			require.NoError(t, logicID.Validate())
+
+			assert.Equal(t, TagLogicV0, logicID.Tag())
+			assert.Equal(t, uint32(0), logicID.Variant())
+			assert.True(t, logicID.Flag(LogicExtrinsic))
+			assert.Equal(t, hashFingerprint("moi.logic.salted", deployer.Bytes(), salt[:], codeHash[:]),
+				logicID.Fingerprint())
+
+			// Test deterministic derivation
+			rederived, err := DeriveLogicIDv0Salted(deployer, salt, codeHash, LogicExtrinsic)
+			require.NoError(t, err)
+			assert.Equal(t, logicID, rederived)
+
+			// Test that the deployer, salt and code hash affect the fingerprint
+			for _, other := range []LogicID{
+				must(DeriveLogicIDv0Salted(RandomParticipantIDv0(), salt, codeHash)),
+				must(DeriveLogicIDv0Salted(deployer, [32]byte{3}, codeHash)),
+				must(DeriveLogicIDv0Salted(deployer, salt, [32]byte{3})),
+			} {
+				assert.NotEqual(t, logicID.Fingerprint(), other.Fingerprint())
+			}
+
+			// Test that salted and nonce based derivations do not collide
+			nonced, err := DeriveLogicIDv0(deployer, 0)
+			require.NoError(t, err)
+			assert.NotEqual(t, nonced, must(DeriveLogicIDv0Salted(deployer, [32]byte{}, [32]byte{})))
+
+			// Test invalid deployers and unsupported flags
+			_, err = DeriveLogicIDv0Salted(ParticipantID(RandomAssetIDv0()), salt, codeHash)
+			assert.EqualError(t, err, "invalid deployer: invalid tag: not a participant id")
+
+			_, err = DeriveLogicIDv0Salted(deployer, salt, codeHash, AssetLogical)
 			assert.Equal(t, err, ErrUnsupportedFlag)
 		})
 
@@ -296,4 +399,112 @@ func TestLogicID_Generation(t *testing.T) {
 			assert.Equal(t, TagLogicV0, logicID.Tag())
 		})
 	})
+
+	t.Run("v1", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			logicID, err := GenerateLogicIDv1(fingerprint, 0, 42, LogicAuxiliary)
+			require.NoError(t, err)
+			require.NoError(t, logicID.Validate())
+
+			assert.Equal(t, TagLogicV1, logicID.Tag())
+			assert.Equal(t, uint32(42), logicID.Variant())
+			assert.True(t, logicID.Flag(LogicAuxiliary))
+
+			// Test unsupported flags
+			_, err = GenerateLogicIDv1(fingerprint, 0, 42, AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Edition", func(t *testing.T) {
+			logicID, err := GenerateLogicIDv1(RandomFingerprint(), 0x0102, 42)
+			require.NoError(t, err)
+			require.NoError(t, logicID.Validate())
+
+			// The edition is encoded in the metadata, distinct from the variant
+			edition, ok := logicID.Edition()
+			assert.True(t, ok)
+			assert.Equal(t, uint32(0x0102), edition)
+			assert.Equal(t, uint32(42), logicID.Variant())
+			assert.Equal(t, [2]byte{0x01, 0x02}, logicID.AsIdentifier().Metadata())
+
+			// LogicID v1 has no standard
+			assert.Zero(t, logicID.Standard())
+		})
+
+		t.Run("Immutable", func(t *testing.T) {
+			for _, logicID := range []LogicID{
+				must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicImmutable)),
+				must(GenerateLogicIDv1(RandomFingerprint(), 0, 0, LogicImmutable)),
+			} {
+				require.NoError(t, logicID.Validate())
+				assert.Equal(t, byte(0b00001000), logicID.Flags())
+				assert.True(t, logicID.Flag(LogicImmutable))
+
+				// The flag is retained by derivation unless it is unset
+				edition, err := logicID.DeriveVariant(1, nil, nil)
+				require.NoError(t, err)
+				assert.True(t, edition.Flag(LogicImmutable))
+
+				edition, err = logicID.Derive(WithVariant(1), WithFlagsUnset(LogicImmutable))
+				require.NoError(t, err)
+				assert.False(t, edition.Flag(LogicImmutable))
+			}
+
+			salted, err := DeriveLogicIDv0Salted(RandomParticipantIDv0(), [32]byte{1}, [32]byte{2}, LogicImmutable)
+			require.NoError(t, err)
+			assert.True(t, salted.Flag(LogicImmutable))
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			logicID := RandomLogicIDv1()
+
+			assert.NoError(t, logicID.Validate())
+			assert.Equal(t, TagLogicV1, logicID.Tag())
+		})
+	})
+}
+
+func TestLogicID_ListFlags(t *testing.T) {
+	logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicExtrinsic, LogicIntrinsic))
+	assert.Equal(t, []Flag{LogicIntrinsic, LogicExtrinsic}, logic.ListFlags())
+	assert.Empty(t, must(GenerateLogicIDv0(RandomFingerprint(), 0, 0)).ListFlags())
+}
+
+func TestLogicID_DeriveVariant(t *testing.T) {
+	logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicIntrinsic))
+
+	derived, err := logic.DeriveVariant(42, []Flag{LogicExtrinsic}, []Flag{LogicIntrinsic})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(42), derived.Variant())
+	assert.Equal(t, logic.AccountID(), derived.AccountID())
+	assert.True(t, derived.Flag(LogicExtrinsic))
+	assert.False(t, derived.Flag(LogicIntrinsic))
+
+	// Test unsupported flags
+	_, err = logic.DeriveVariant(42, nil, []Flag{AssetLogical})
+	assert.Equal(t, ErrUnsupportedFlag, err)
+
+	// Test that invalid logic IDs cannot be derived
+	_, err = LogicID(RandomAssetIDv0()).DeriveVariant(42, nil, nil)
+	assert.Equal(t, ErrNotLogicID, err)
+}
+
+func TestLogicID_BinaryMarshal(t *testing.T) {
+	logic := RandomLogicIDv0()
+
+	encoded, err := logic.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, logic.Bytes(), encoded)
+
+	var decoded LogicID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, logic, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: logic id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a logic id",
+	)
 }
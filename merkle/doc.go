@@ -0,0 +1,19 @@
+// Package merkle implements a canonical Merkle tree over a set of identifiers, with inclusion proofs,
+// exclusion proofs and compact multi-proofs, for light clients to verify whether identifiers are in
+// a committed set (such as an allowlist of assets) with only the root of the tree.
+//
+// The tree is canonical, so the same set of identifiers always produces the same root, regardless of
+// the order or duplication of the identifiers it was built from. The identifiers are sorted in
+// ascending order of their bytes and deduplicated, and their hashes form the leaves of the tree:
+//   - Leaf: The domain-separated hash of the identifier with the moi.merkle.leaf domain
+//     (see Identifier.HashWithDomain)
+//   - Node: The Keccak-256 hash of the moi.merkle.node domain, followed by its left and right children.
+//     A node without a right child (the last node of a level with an odd number of nodes) is promoted
+//     to the next level unchanged.
+//   - Root: The Keccak-256 hash of the moi.merkle.root domain, followed by the number of leaves
+//     (8 bytes, big-endian) and the topmost node of the tree (32 zero bytes for an empty tree).
+//
+// Committing to the number of leaves in the root binds the shape of the tree, so that the position
+// of a leaf in a proof cannot be forged. This allows exclusion proofs to prove the absence of an
+// identifier with the inclusion proofs of its adjacent leaves.
+package merkle
@@ -0,0 +1,86 @@
+package merkle
+
+import (
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// Proof is an inclusion proof of an identifier in a Tree
+type Proof struct {
+	// Index is the position of the identifier in the leaves of the tree
+	Index int
+	// Count is the number of leaves in the tree
+	Count int
+	// Siblings are the hashes of the siblings on the path from the leaf to the root
+	Siblings [][32]byte
+}
+
+// Verify returns whether the Proof proves the inclusion of the given identifier in the tree with the given root
+func (proof Proof) Verify(root [32]byte, id identifiers.Identifier) bool {
+	return verifyRoot(root, proof.Count, []int{proof.Index}, [][32]byte{leafHash(id)}, proof.Siblings)
+}
+
+// Neighbor is a leaf adjacent to an excluded identifier, with its inclusion Proof
+type Neighbor struct {
+	ID    identifiers.Identifier
+	Proof Proof
+}
+
+// ExclusionProof is a proof that an identifier is not in a Tree. It proves the inclusion of the
+// adjacent leaves that the identifier would be between if it were in the tree. The Lower neighbor
+// is nil if the identifier would be the first leaf, and the Upper neighbor is nil if it would be the
+// last leaf. Both neighbors are nil for an empty tree.
+type ExclusionProof struct {
+	Lower *Neighbor
+	Upper *Neighbor
+}
+
+// Verify returns whether the ExclusionProof proves that the given identifier
+// is not in the tree with the given root
+func (proof ExclusionProof) Verify(root [32]byte, id identifiers.Identifier) bool {
+	lower, upper := proof.Lower, proof.Upper
+
+	// Check that the neighbors are in the tree and on either side of the identifier
+	if lower != nil && (compareIdentifiers(lower.ID, id) >= 0 || !lower.Proof.Verify(root, lower.ID)) {
+		return false
+	}
+
+	if upper != nil && (compareIdentifiers(upper.ID, id) <= 0 || !upper.Proof.Verify(root, upper.ID)) {
+		return false
+	}
+
+	// Check that the neighbors are adjacent or at the edges of the tree.
+	// The number of leaves in both proofs is bound by the root, so they cannot be forged.
+	switch {
+	case lower != nil && upper != nil:
+		return upper.Proof.Index == lower.Proof.Index+1
+	case lower != nil:
+		return lower.Proof.Index == lower.Proof.Count-1
+	case upper != nil:
+		return upper.Proof.Index == 0
+	default:
+		return root == rootHash(0, [32]byte{})
+	}
+}
+
+// MultiProof is a compact inclusion proof of multiple identifiers in a Tree.
+// Siblings that can be computed from the proven identifiers are omitted, so
+// it is smaller than the individual proofs of the identifiers.
+type MultiProof struct {
+	// Indices are the positions of the identifiers in the leaves of the tree, in ascending order
+	Indices []int
+	// Count is the number of leaves in the tree
+	Count int
+	// Siblings are the hashes of the siblings required to compute the root, level by level from the leaves
+	Siblings [][32]byte
+}
+
+// Verify returns whether the MultiProof proves the inclusion of the given identifiers in the tree with
+// the given root. The identifiers must be in the order of the indices of the proof (ascending order).
+func (proof MultiProof) Verify(root [32]byte, ids []identifiers.Identifier) bool {
+	hashes := make([][32]byte, len(ids))
+	for position, id := range ids {
+		hashes[position] = leafHash(id)
+	}
+
+	return verifyRoot(root, proof.Count, proof.Indices, hashes, proof.Siblings)
+}
@@ -0,0 +1,179 @@
+package merkle
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// maxIdentifier is the greatest possible identifier
+var maxIdentifier = identifiers.Identifier{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
+
+// successor returns the identifier immediately after the given identifier
+func successor(id identifiers.Identifier) identifiers.Identifier {
+	for index := len(id) - 1; index >= 0; index-- {
+		if id[index]++; id[index] != 0 {
+			break
+		}
+	}
+
+	return id
+}
+
+func TestProof_Verify(t *testing.T) {
+	for count := 1; count <= 17; count++ {
+		tree := New(randomIDs(count))
+		root := tree.Root()
+
+		for _, id := range tree.Leaves() {
+			proof, err := tree.Prove(id)
+			require.NoError(t, err)
+			require.True(t, proof.Verify(root, id), "count %d, index %d", count, proof.Index)
+
+			// Test that the proof fails for other identifiers and roots
+			assert.False(t, proof.Verify(root, successor(id)))
+			assert.False(t, proof.Verify(New(randomIDs(count)).Root(), id))
+
+			// Test that the proof fails with a forged position or number of leaves
+			assert.False(t, Proof{Index: proof.Index + 1, Count: count, Siblings: proof.Siblings}.Verify(root, id))
+			assert.False(t, Proof{Index: proof.Index, Count: count + 1, Siblings: proof.Siblings}.Verify(root, id))
+			assert.False(t, Proof{Index: -1, Count: count, Siblings: proof.Siblings}.Verify(root, id))
+
+			// Test that the proof fails with missing or extra siblings
+			if len(proof.Siblings) > 0 {
+				truncated := Proof{Index: proof.Index, Count: count, Siblings: proof.Siblings[1:]}
+				assert.False(t, truncated.Verify(root, id))
+			}
+
+			extended := Proof{Index: proof.Index, Count: count, Siblings: append(proof.Siblings, root)}
+			assert.False(t, extended.Verify(root, id))
+		}
+	}
+}
+
+func TestExclusionProof_Verify(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		empty := New(nil)
+		id := identifiers.RandomAssetIDv0().AsIdentifier()
+
+		proof, err := empty.ProveExclusion(id)
+		require.NoError(t, err)
+		assert.True(t, proof.Verify(empty.Root(), id))
+
+		// Test that an empty proof fails for a non-empty tree
+		assert.False(t, proof.Verify(New(randomIDs(1)).Root(), id))
+	})
+
+	for count := 1; count <= 9; count++ {
+		tree := New(randomIDs(count))
+		root, leaves := tree.Root(), tree.Leaves()
+
+		excluded := []identifiers.Identifier{identifiers.Nil, maxIdentifier}
+		for _, leaf := range leaves[:count-1] {
+			excluded = append(excluded, successor(leaf))
+		}
+
+		for _, id := range excluded {
+			proof, err := tree.ProveExclusion(id)
+			require.NoError(t, err)
+			require.True(t, proof.Verify(root, id), "count %d", count)
+
+			// Test that the proof fails for a neighbor and for another root
+			if proof.Lower != nil {
+				assert.False(t, proof.Verify(root, proof.Lower.ID))
+			}
+
+			if proof.Upper != nil {
+				assert.False(t, proof.Verify(root, proof.Upper.ID))
+			}
+
+			assert.False(t, proof.Verify(New(randomIDs(count)).Root(), id))
+		}
+	}
+
+	tree := New(randomIDs(6))
+	root, leaves := tree.Root(), tree.Leaves()
+	neighbor := func(index int) *Neighbor {
+		proof, _ := tree.Prove(leaves[index])
+		return &Neighbor{ID: leaves[index], Proof: proof}
+	}
+
+	// Test that non-adjacent neighbors fail
+	assert.False(t, ExclusionProof{Lower: neighbor(1), Upper: neighbor(3)}.Verify(root, successor(leaves[1])))
+	// Test that neighbors that are not at the edges fail
+	assert.False(t, ExclusionProof{Lower: neighbor(4)}.Verify(root, maxIdentifier))
+	assert.False(t, ExclusionProof{Upper: neighbor(1)}.Verify(root, identifiers.Nil))
+	// Test that neighbors on the wrong side of the identifier fail
+	assert.False(t, ExclusionProof{Lower: neighbor(2), Upper: neighbor(3)}.Verify(root, successor(leaves[3])))
+	assert.False(t, ExclusionProof{Lower: neighbor(2), Upper: neighbor(3)}.Verify(root, leaves[2]))
+	// Test that an empty proof fails for a non-empty tree
+	assert.False(t, ExclusionProof{}.Verify(root, successor(leaves[1])))
+}
+
+func TestMultiProof_Verify(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for count := 1; count <= 17; count++ {
+		tree := New(randomIDs(count))
+		root, leaves := tree.Root(), tree.Leaves()
+
+		for trial := 0; trial < 8; trial++ {
+			// Select a random non-empty subset of the leaves
+			var subset []identifiers.Identifier
+
+			for _, leaf := range leaves {
+				if random.IntN(2) == 0 {
+					subset = append(subset, leaf)
+				}
+			}
+
+			if len(subset) == 0 {
+				subset = leaves[:1]
+			}
+
+			proof, err := tree.ProveMulti(subset)
+			require.NoError(t, err)
+			require.True(t, proof.Verify(root, subset), "count %d, indices %v", count, proof.Indices)
+
+			// Test that the multi-proof is no larger than a single proof of each identifier
+			single, _ := tree.Prove(subset[0])
+			assert.LessOrEqual(t, len(proof.Siblings), len(single.Siblings)*len(subset))
+
+			// Test that the proof fails for other identifiers and roots
+			forged := append([]identifiers.Identifier{successor(subset[0])}, subset[1:]...)
+			assert.False(t, proof.Verify(root, forged))
+			assert.False(t, proof.Verify(New(randomIDs(count)).Root(), subset))
+		}
+	}
+
+	tree := New(randomIDs(8))
+	root, leaves := tree.Root(), tree.Leaves()
+
+	proof, err := tree.ProveMulti(leaves[2:5])
+	require.NoError(t, err)
+	require.True(t, proof.Verify(root, leaves[2:5]))
+
+	// Test that the identifiers must match the number and order of the indices
+	assert.False(t, proof.Verify(root, leaves[2:4]))
+	assert.False(t, proof.Verify(root, []identifiers.Identifier{leaves[3], leaves[2], leaves[4]}))
+
+	// Test that the indices must be ascending and within the tree
+	unordered := MultiProof{Indices: []int{3, 2, 4}, Count: 8, Siblings: proof.Siblings}
+	assert.False(t, unordered.Verify(root, []identifiers.Identifier{leaves[3], leaves[2], leaves[4]}))
+
+	outside := MultiProof{Indices: []int{2, 3, 8}, Count: 8, Siblings: proof.Siblings}
+	assert.False(t, outside.Verify(root, leaves[2:5]))
+
+	assert.False(t, MultiProof{Count: 8}.Verify(root, nil))
+
+	// Test that the proof fails with missing siblings
+	truncated := MultiProof{Indices: proof.Indices, Count: 8, Siblings: proof.Siblings[1:]}
+	assert.False(t, truncated.Verify(root, leaves[2:5]))
+}
@@ -0,0 +1,240 @@
+package merkle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+	"github.com/sarvalabs/go-moi-identifiers/internal/keccak"
+)
+
+const (
+	// The hashing domains of the leaves, nodes and root of a tree
+	leafDomain = "moi.merkle.leaf"
+	nodeDomain = "moi.merkle.node"
+	rootDomain = "moi.merkle.root"
+)
+
+var (
+	ErrNotFound      = errors.New("identifier not found in tree")
+	ErrFound         = errors.New("identifier found in tree")
+	ErrNoIdentifiers = errors.New("no identifiers to prove")
+)
+
+// Tree is a canonical Merkle tree over a set of identifiers.
+// A Tree is immutable once built with New, and is safe for concurrent use.
+type Tree struct {
+	leaves identifiers.IdentifierList
+	// levels contains the hashes of each level of the tree,
+	// from the hashes of the leaves to the topmost node
+	levels [][][32]byte
+	root   [32]byte
+}
+
+// New builds a Tree from the given identifiers, which are sorted and deduplicated.
+// The given slice is not modified and the Tree does not retain it.
+func New(ids []identifiers.Identifier) *Tree {
+	leaves := slices.Clone(identifiers.IdentifierList(ids))
+	leaves.Sort()
+	leaves.Dedup()
+
+	hashes := make([][32]byte, len(leaves))
+	for index, id := range leaves {
+		hashes[index] = leafHash(id)
+	}
+
+	levels := [][][32]byte{hashes}
+
+	for len(hashes) > 1 {
+		next := make([][32]byte, 0, (len(hashes)+1)/2)
+
+		for index := 0; index < len(hashes); index += 2 {
+			// Promote the last node of the level if it has no sibling
+			if index+1 == len(hashes) {
+				next = append(next, hashes[index])
+				break
+			}
+
+			next = append(next, nodeHash(hashes[index], hashes[index+1]))
+		}
+
+		levels, hashes = append(levels, next), next
+	}
+
+	var top [32]byte
+	if len(hashes) == 1 {
+		top = hashes[0]
+	}
+
+	return &Tree{leaves: leaves, levels: levels, root: rootHash(len(leaves), top)}
+}
+
+// Root returns the root hash of the Tree
+func (tree *Tree) Root() [32]byte { return tree.root }
+
+// Len returns the number of identifiers in the Tree
+func (tree *Tree) Len() int { return len(tree.leaves) }
+
+// Leaves returns the identifiers in the Tree in ascending order
+func (tree *Tree) Leaves() []identifiers.Identifier { return slices.Clone(tree.leaves) }
+
+// Index returns the position of the given identifier in the leaves of the Tree and whether it was found
+func (tree *Tree) Index(id identifiers.Identifier) (int, bool) { return tree.leaves.SearchBinary(id) }
+
+// Prove returns the inclusion Proof of the given identifier in the Tree.
+// Returns ErrNotFound if the identifier is not in the Tree.
+func (tree *Tree) Prove(id identifiers.Identifier) (Proof, error) {
+	index, found := tree.Index(id)
+	if !found {
+		return Proof{}, ErrNotFound
+	}
+
+	return Proof{Index: index, Count: tree.Len(), Siblings: tree.siblings([]int{index})}, nil
+}
+
+// ProveExclusion returns the ExclusionProof of the given identifier from the Tree.
+// Returns ErrFound if the identifier is in the Tree.
+func (tree *Tree) ProveExclusion(id identifiers.Identifier) (ExclusionProof, error) {
+	index, found := tree.Index(id)
+	if found {
+		return ExclusionProof{}, ErrFound
+	}
+
+	var proof ExclusionProof
+
+	// The leaf before the position of the identifier is its lower neighbor
+	if index > 0 {
+		lower, _ := tree.Prove(tree.leaves[index-1])
+		proof.Lower = &Neighbor{ID: tree.leaves[index-1], Proof: lower}
+	}
+
+	// The leaf at the position of the identifier is its upper neighbor
+	if index < tree.Len() {
+		upper, _ := tree.Prove(tree.leaves[index])
+		proof.Upper = &Neighbor{ID: tree.leaves[index], Proof: upper}
+	}
+
+	return proof, nil
+}
+
+// ProveMulti returns the MultiProof of the given identifiers in the Tree, which are sorted and deduplicated.
+// Returns ErrNoIdentifiers if no identifiers are given, or ErrNotFound if any identifier is not in the Tree.
+func (tree *Tree) ProveMulti(ids []identifiers.Identifier) (MultiProof, error) {
+	if len(ids) == 0 {
+		return MultiProof{}, ErrNoIdentifiers
+	}
+
+	indices := make([]int, 0, len(ids))
+
+	for _, id := range ids {
+		index, found := tree.Index(id)
+		if !found {
+			return MultiProof{}, ErrNotFound
+		}
+
+		indices = append(indices, index)
+	}
+
+	slices.Sort(indices)
+	indices = slices.Compact(indices)
+
+	return MultiProof{Indices: indices, Count: tree.Len(), Siblings: tree.siblings(indices)}, nil
+}
+
+// siblings returns the sibling hashes required to compute the root from the leaves at the given
+// ascending indices, level by level from the leaves. Siblings that can be computed from the given
+// leaves are omitted, which is the order in which they are consumed by verifyRoot.
+func (tree *Tree) siblings(indices []int) [][32]byte {
+	var siblings [][32]byte
+
+	known := slices.Clone(indices)
+
+	for _, nodes := range tree.levels[:len(tree.levels)-1] {
+		next := make([]int, 0, len(known))
+
+		for position := 0; position < len(known); position++ {
+			index := known[position]
+
+			if sibling := index ^ 1; sibling < len(nodes) {
+				if position+1 < len(known) && known[position+1] == sibling {
+					// The sibling is computed from the given leaves
+					position++
+				} else {
+					siblings = append(siblings, nodes[sibling])
+				}
+			}
+
+			next = append(next, index/2)
+		}
+
+		known = next
+	}
+
+	return siblings
+}
+
+// verifyRoot returns whether the given leaf hashes at the given indices, with the given siblings,
+// compute the given root of a tree with the given number of leaves. The indices must be ascending.
+func verifyRoot(root [32]byte, count int, indices []int, hashes, siblings [][32]byte) bool {
+	if len(indices) == 0 || len(indices) != len(hashes) || indices[0] < 0 || indices[len(indices)-1] >= count {
+		return false
+	}
+
+	for position := 1; position < len(indices); position++ {
+		if indices[position] <= indices[position-1] {
+			return false
+		}
+	}
+
+	known, hashes := slices.Clone(indices), slices.Clone(hashes)
+
+	for width := count; width > 1; width = (width + 1) / 2 {
+		next := 0
+
+		for position := 0; position < len(known); position++ {
+			index, hash := known[position], hashes[position]
+
+			switch sibling := index ^ 1; {
+			case sibling >= width:
+				// The node is promoted without a sibling
+			case position+1 < len(known) && known[position+1] == sibling:
+				// The sibling is the next known node
+				hash = nodeHash(hash, hashes[position+1])
+				position++
+			case len(siblings) == 0:
+				return false
+			case index%2 == 1:
+				hash, siblings = nodeHash(siblings[0], hash), siblings[1:]
+			default:
+				hash, siblings = nodeHash(hash, siblings[0]), siblings[1:]
+			}
+
+			known[next], hashes[next] = index/2, hash
+			next++
+		}
+
+		known, hashes = known[:next], hashes[:next]
+	}
+
+	return len(siblings) == 0 && rootHash(count, hashes[0]) == root
+}
+
+// leafHash returns the hash of the leaf for the given identifier
+func leafHash(id identifiers.Identifier) [32]byte {
+	return id.HashWithDomain(leafDomain)
+}
+
+// nodeHash returns the hash of the node with the given children
+func nodeHash(left, right [32]byte) [32]byte {
+	return keccak.Sum256([]byte(nodeDomain), left[:], right[:])
+}
+
+// rootHash returns the root hash of a tree with the given number of leaves and topmost node
+func rootHash(count int, top [32]byte) [32]byte {
+	return keccak.Sum256([]byte(rootDomain), binary.BigEndian.AppendUint64(nil, uint64(count)), top[:])
+}
+
+// compareIdentifiers compares two identifiers lexicographically by their bytes
+func compareIdentifiers(a, b identifiers.Identifier) int { return bytes.Compare(a[:], b[:]) }
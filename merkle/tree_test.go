@@ -0,0 +1,153 @@
+package merkle
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	identifiers "github.com/sarvalabs/go-moi-identifiers"
+)
+
+// randomIDs returns the given number of random identifiers
+func randomIDs(count int) []identifiers.Identifier {
+	ids := make([]identifiers.Identifier, count)
+	for index := range ids {
+		ids[index] = identifiers.RandomAssetIDv0().AsIdentifier()
+	}
+
+	return ids
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		tree := New(nil)
+
+		assert.Equal(t, 0, tree.Len())
+		assert.Empty(t, tree.Leaves())
+		assert.Equal(t, rootHash(0, [32]byte{}), tree.Root())
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		id := identifiers.RandomParticipantIDv0().AsIdentifier()
+		tree := New([]identifiers.Identifier{id})
+
+		assert.Equal(t, 1, tree.Len())
+		assert.Equal(t, rootHash(1, id.HashWithDomain("moi.merkle.leaf")), tree.Root())
+	})
+
+	t.Run("Shape", func(t *testing.T) {
+		tree := New(randomIDs(3))
+		leaves := tree.Leaves()
+
+		// Test that the last node of a level with an odd number of nodes is promoted
+		top := nodeHash(nodeHash(leafHash(leaves[0]), leafHash(leaves[1])), leafHash(leaves[2]))
+		assert.Equal(t, rootHash(3, top), tree.Root())
+	})
+
+	t.Run("Canonical", func(t *testing.T) {
+		ids := randomIDs(10)
+		tree := New(ids)
+
+		// Test that the leaves are sorted and the given identifiers are not modified
+		assert.True(t, slices.IsSortedFunc(tree.Leaves(), compareIdentifiers))
+		assert.ElementsMatch(t, ids, tree.Leaves())
+		assert.NotEqual(t, ids, tree.Leaves())
+
+		// Test that the order and duplication of identifiers does not affect the root
+		shuffled := append(slices.Clone(ids), ids[3], ids[7])
+		slices.Reverse(shuffled)
+		assert.Equal(t, tree.Root(), New(shuffled).Root())
+		assert.Equal(t, tree.Len(), New(shuffled).Len())
+
+		// Test that the set of identifiers affects the root
+		assert.NotEqual(t, tree.Root(), New(ids[1:]).Root())
+		assert.NotEqual(t, tree.Root(), New(append(ids, randomIDs(1)...)).Root())
+	})
+
+	t.Run("Leaves", func(t *testing.T) {
+		tree := New(randomIDs(4))
+
+		leaves := tree.Leaves()
+		leaves[0] = identifiers.Nil
+
+		assert.NotEqual(t, leaves, tree.Leaves())
+	})
+}
+
+func TestTree_Index(t *testing.T) {
+	tree := New(randomIDs(8))
+
+	for position, id := range tree.Leaves() {
+		index, found := tree.Index(id)
+		assert.True(t, found)
+		assert.Equal(t, position, index)
+	}
+
+	_, found := tree.Index(identifiers.Nil)
+	assert.False(t, found)
+}
+
+func TestTree_Prove(t *testing.T) {
+	tree := New(randomIDs(5))
+
+	proof, err := tree.Prove(tree.Leaves()[2])
+	require.NoError(t, err)
+	assert.Equal(t, 2, proof.Index)
+	assert.Equal(t, 5, proof.Count)
+	assert.Len(t, proof.Siblings, 3)
+
+	_, err = tree.Prove(identifiers.Nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTree_ProveExclusion(t *testing.T) {
+	tree := New(randomIDs(5))
+	leaves := tree.Leaves()
+
+	// Test an identifier before the first leaf
+	proof, err := tree.ProveExclusion(identifiers.Nil)
+	require.NoError(t, err)
+	assert.Nil(t, proof.Lower)
+	assert.Equal(t, leaves[0], proof.Upper.ID)
+
+	// Test an identifier after the last leaf
+	proof, err = tree.ProveExclusion(maxIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, leaves[4], proof.Lower.ID)
+	assert.Nil(t, proof.Upper)
+
+	// Test an identifier between two leaves
+	proof, err = tree.ProveExclusion(successor(leaves[1]))
+	require.NoError(t, err)
+	assert.Equal(t, leaves[1], proof.Lower.ID)
+	assert.Equal(t, leaves[2], proof.Upper.ID)
+
+	// Test an empty tree
+	proof, err = New(nil).ProveExclusion(leaves[0])
+	require.NoError(t, err)
+	assert.Equal(t, ExclusionProof{}, proof)
+
+	_, err = tree.ProveExclusion(leaves[3])
+	assert.ErrorIs(t, err, ErrFound)
+}
+
+func TestTree_ProveMulti(t *testing.T) {
+	tree := New(randomIDs(8))
+	leaves := tree.Leaves()
+
+	proof, err := tree.ProveMulti([]identifiers.Identifier{leaves[5], leaves[0], leaves[1], leaves[5]})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 5}, proof.Indices)
+	assert.Equal(t, 8, proof.Count)
+
+	// Siblings of [0,1] at the first level are computed, leaving {4}, {2,3} and {6,7}
+	assert.Len(t, proof.Siblings, 3)
+
+	_, err = tree.ProveMulti(nil)
+	assert.ErrorIs(t, err, ErrNoIdentifiers)
+
+	_, err = tree.ProveMulti([]identifiers.Identifier{leaves[0], identifiers.Nil})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
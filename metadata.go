@@ -0,0 +1,71 @@
+package identifiers
+
+import (
+	"encoding/binary"
+)
+
+// The 2 metadata bytes of an identifier (index 2 and 3) are specific to its kind.
+// Identifier.Metadata returns them as opaque bytes, while the typed views below describe
+// the metadata of the kinds that use them. A view can be encoded back into the metadata
+// bytes with its Bytes method, to build identifiers with the WithMetadata DeriveOption.
+
+// AssetMetadata is the typed view of the metadata of an AssetID
+type AssetMetadata struct {
+	// Standard is the standard of the asset, which is at most 255 as of v1
+	Standard uint16
+	// Dimension is the dimension of the asset, which is always 0 for v0
+	Dimension uint8
+}
+
+// Bytes returns the 2-byte metadata encoding of the AssetMetadata for v0,
+// which only contains the 16-bit standard. The dimension is not encoded.
+func (metadata AssetMetadata) Bytes() [2]byte {
+	var encoded [2]byte
+
+	binary.BigEndian.PutUint16(encoded[:], metadata.Standard)
+
+	return encoded
+}
+
+// BytesV1 returns the 2-byte metadata encoding of the AssetMetadata for v1, which contains
+// the dimension and the 8-bit standard. Returns an error if the standard does not fit into 8 bits.
+func (metadata AssetMetadata) BytesV1() ([2]byte, error) {
+	if err := checkStandardV1(metadata.Standard); err != nil {
+		return [2]byte{}, err
+	}
+
+	return [2]byte{metadata.Dimension, uint8(metadata.Standard)}, nil
+}
+
+// Metadata returns the typed AssetMetadata of the AssetID
+func (asset AssetID) Metadata() AssetMetadata {
+	return AssetMetadata{Standard: asset.Standard(), Dimension: asset.Dimension()}
+}
+
+// MultisigMetadata is the typed view of the metadata of a multisig ParticipantID
+type MultisigMetadata struct {
+	// Threshold is the number of member signatures (m) required by the participant
+	Threshold uint8
+	// Members is the number of members (n) of the participant
+	Members uint8
+}
+
+// Bytes returns the 2-byte metadata encoding of the MultisigMetadata
+func (metadata MultisigMetadata) Bytes() [2]byte {
+	return [2]byte{metadata.Threshold, metadata.Members}
+}
+
+// Validate returns an error if the threshold of the MultisigMetadata is not between 1 and its member count
+func (metadata MultisigMetadata) Validate() error {
+	if metadata.Threshold == 0 || metadata.Threshold > metadata.Members {
+		return errInvalidMultisigMetadata
+	}
+
+	return nil
+}
+
+// Metadata returns the typed MultisigMetadata of the ParticipantID.
+// Returns the zero MultisigMetadata if the ParticipantID does not have the ParticipantMultisig flag set.
+func (participant ParticipantID) Metadata() MultisigMetadata {
+	return MultisigMetadata{Threshold: participant.Threshold(), Members: participant.MemberCount()}
+}
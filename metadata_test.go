@@ -0,0 +1,62 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetMetadata(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0x0114))
+
+	metadata := asset.Metadata()
+	assert.Equal(t, AssetMetadata{Standard: 0x0114}, metadata)
+	assert.Equal(t, [2]byte{0x01, 0x14}, metadata.Bytes())
+	assert.Equal(t, asset.AsIdentifier().Metadata(), metadata.Bytes())
+
+	derived, err := asset.Derive(WithMetadata(AssetMetadata{Standard: 20}.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(20), derived.Metadata().Standard)
+
+	t.Run("V1", func(t *testing.T) {
+		asset := must(GenerateAssetIDv1(RandomFingerprint(), 0, 20, 4))
+
+		metadata := asset.Metadata()
+		assert.Equal(t, AssetMetadata{Standard: 20, Dimension: 4}, metadata)
+		assert.Equal(t, [2]byte{4, 20}, must(metadata.BytesV1()))
+		assert.Equal(t, asset.AsIdentifier().Metadata(), must(metadata.BytesV1()))
+
+		derived, err := asset.Derive(WithMetadata(must(AssetMetadata{Standard: 1, Dimension: 9}.BytesV1())))
+		require.NoError(t, err)
+		assert.Equal(t, AssetMetadata{Standard: 1, Dimension: 9}, derived.Metadata())
+
+		// The standard must fit into 8 bits for v1
+		_, err = AssetMetadata{Standard: 300}.BytesV1()
+		require.EqualError(t, err, "invalid asset standard: 300 does not fit into 8 bits for v1")
+		require.ErrorIs(t, err, ErrInvalidAssetStandard)
+	})
+}
+
+func TestMultisigMetadata(t *testing.T) {
+	members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0(), RandomParticipantIDv0()}
+
+	multisig := must(GenerateMultisigParticipantID(members, 2, 0))
+	assert.Equal(t, MultisigMetadata{Threshold: 2, Members: 3}, multisig.Metadata())
+	assert.Equal(t, multisig.AsIdentifier().Metadata(), multisig.Metadata().Bytes())
+
+	// The metadata of participants that are not multisig is not interpreted
+	assert.Equal(t, MultisigMetadata{}, RandomParticipantIDv0().Metadata())
+
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, MultisigMetadata{Threshold: 3, Members: 3}.Validate())
+		require.ErrorIs(t, MultisigMetadata{Threshold: 0, Members: 3}.Validate(), errInvalidMultisigMetadata)
+		require.ErrorIs(t, MultisigMetadata{Threshold: 4, Members: 3}.Validate(), errInvalidMultisigMetadata)
+	})
+
+	t.Run("Derive", func(t *testing.T) {
+		derived, err := multisig.Derive(WithMetadata(MultisigMetadata{Threshold: 3, Members: 3}.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, uint8(3), derived.Metadata().Threshold)
+	})
+}
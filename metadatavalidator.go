@@ -0,0 +1,78 @@
+package identifiers
+
+import (
+	"slices"
+)
+
+// MetadataValidator validates the 2-byte metadata of an identifier of a specific kind, such as checking
+// that the standard of an AssetID is not above a maximum. Validators are registered for a kind with
+// RegisterMetadataValidator, so that malformed metadata is rejected when an identifier is validated.
+// It must return an error that describes why the metadata is invalid, and must not modify the registry
+// or validate the identifier (such as with AsAssetID), which would invoke the validator recursively.
+type MetadataValidator func(id Identifier) error
+
+// RegisterMetadataValidator registers the given MetadataValidator for the given kind. Once registered,
+// identifiers of the kind whose metadata is rejected by the validator are rejected with a BadMetadataError
+// by their Validate methods and by generators. Validators of a kind are invoked in the order they were
+// registered, after the flags of the identifier have been checked. Like flag rules, metadata validators
+// are intended to be registered during initialization.
+//
+// Returns an error if the validator is nil or if the kind is not recognized.
+func RegisterMetadataValidator(kind IdentifierKind, validator MetadataValidator) error {
+	if validator == nil {
+		return ErrInvalidMetadataValidator
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	return updateRegistry(func(tables *registryTables) error {
+		if !tables.supports(kind) {
+			return ErrUnsupportedKind
+		}
+
+		tables.metadataValidators[kind] = append(slices.Clone(tables.metadataValidators[kind]), validator)
+
+		return nil
+	})
+}
+
+// checkKindRules returns an error if the given identifier violates any flag rule of its kind (see FlagRuleError),
+// if its metadata is rejected by any metadata validator of its kind (see BadMetadataError), or if it is
+// a multisig participant with an invalid threshold.
+// The flags of the identifier must be supported by its tag.
+func checkKindRules(id Identifier) error {
+	return registry.Load().checkKindRules(id)
+}
+
+// checkKindRules returns an error if the given identifier violates any flag rule
+// or metadata validator of its kind. The flags of the identifier must be supported by its tag.
+func (tables *registryTables) checkKindRules(id Identifier) error {
+	// Check the builtin metadata of multisig participants before any custom rules
+	if err := checkMultisigMetadata(id); err != nil {
+		return err
+	}
+
+	if err := tables.checkFlagRules(id); err != nil {
+		return err
+	}
+
+	for _, validate := range tables.metadataValidators[id.Tag().Kind()] {
+		if err := validate(id); err != nil {
+			return BadMetadataError{Tag: id.Tag(), Err: err}
+		}
+	}
+
+	return nil
+}
+
+// checksMetadata returns if checking the kind rules of the given identifier depends on more than its tag
+// and flags, which is the case for kinds with flag rules or metadata validators and for multisig participants
+func (tables *registryTables) checksMetadata(id Identifier) bool {
+	kind := id.Tag().Kind()
+	if len(tables.flagRules[kind]) != 0 || len(tables.metadataValidators[kind]) != 0 {
+		return true
+	}
+
+	return kind == KindParticipant && getFlag(id[1], ParticipantMultisig.index)
+}
@@ -0,0 +1,91 @@
+package identifiers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unregisterMetadataValidators removes the metadata validators registered with RegisterMetadataValidator
+// for a kind. For use in tests to restore the registry to its original state.
+func unregisterMetadataValidators(t *testing.T, kind IdentifierKind) {
+	t.Helper()
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	_ = updateRegistry(func(tables *registryTables) error {
+		tables.metadataValidators[kind] = nil
+		return nil
+	})
+}
+
+func TestRegisterMetadataValidator(t *testing.T) {
+	errMaxStandard := errors.New("standard is above the maximum")
+
+	require.NoError(t, RegisterMetadataValidator(KindAsset, func(id Identifier) error {
+		if standard := AssetID(id).Standard(); standard > 20 {
+			return fmt.Errorf("%w: %d", errMaxStandard, standard)
+		}
+
+		return nil
+	}))
+	t.Cleanup(func() { unregisterMetadataValidators(t, KindAsset) })
+
+	t.Run("Validate", func(t *testing.T) {
+		valid := must(GenerateAssetIDv0(RandomFingerprint(), 0, 20))
+		require.NoError(t, valid.Validate())
+		require.NoError(t, valid.AsIdentifier().Validate())
+
+		invalid := AssetID{byte(TagAssetV0), 0, 0x01, 0x14}
+
+		err := invalid.Validate()
+		require.EqualError(t, err, "invalid metadata for asset id: standard is above the maximum: 276")
+		require.ErrorIs(t, err, ErrBadMetadata)
+		require.ErrorIs(t, err, errMaxStandard)
+
+		var metadataErr BadMetadataError
+
+		require.ErrorAs(t, err, &metadataErr)
+		assert.Equal(t, TagAssetV0, metadataErr.Tag)
+
+		require.ErrorIs(t, invalid.AsIdentifier().Validate(), ErrBadMetadata)
+
+		_, err = NewAssetID(invalid)
+		require.ErrorIs(t, err, errMaxStandard)
+
+		// Other kinds are not affected
+		require.NoError(t, LogicID{byte(TagLogicV0), 0, 0x01, 0x14}.Validate())
+	})
+
+	t.Run("Generate", func(t *testing.T) {
+		_, err := GenerateAssetIDv0(RandomFingerprint(), 0, 21)
+		require.ErrorIs(t, err, errMaxStandard)
+
+		_, err = GenerateAssetIDv1(RandomFingerprint(), 0, 21, 0)
+		require.ErrorIs(t, err, ErrBadMetadata)
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		errSecond := errors.New("second validator")
+
+		require.NoError(t, RegisterMetadataValidator(KindAsset, func(Identifier) error { return errSecond }))
+
+		// Validators are invoked in the order they were registered
+		require.ErrorIs(t, AssetID{byte(TagAssetV0), 0, 0x01, 0x14}.Validate(), errMaxStandard)
+		require.ErrorIs(t, AssetID{byte(TagAssetV0)}.Validate(), errSecond)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.ErrorIs(t, RegisterMetadataValidator(KindAsset, nil), ErrInvalidMetadataValidator)
+		require.ErrorIs(t, RegisterMetadataValidator(0x0E, func(Identifier) error { return nil }), ErrUnsupportedKind)
+	})
+
+	t.Run("CustomKind", func(t *testing.T) {
+		err := BadMetadataError{Tag: 0xE0, Err: errMaxStandard}
+		assert.EqualError(t, err, "invalid metadata for identifier: standard is above the maximum")
+	})
+}
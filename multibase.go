@@ -0,0 +1,200 @@
+package identifiers
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Identifiers can be represented with multiformats so that they can be embedded into CIDs and IPLD
+// structures. The multicodec representation of an identifier is its 32 bytes prefixed with the
+// unsigned varint of the multicodec code for its kind, and the multibase representation is the
+// multicodec representation encoded with a multibase encoding and prefixed with its code character.
+//
+// The multicodec code for each kind is in the private use range of the multicodec table, starting
+// at MulticodecBase and offset by the kind. This covers all 16 kinds, including registered custom kinds.
+
+// MulticodecBase is the multicodec code for identifiers of KindParticipant.
+// The codes for the other kinds are offset from this code by their kind.
+const MulticodecBase uint64 = 0x300000
+
+// MulticodecCode returns the multicodec code for identifiers of the given kind
+func MulticodecCode(kind IdentifierKind) uint64 {
+	return MulticodecBase + uint64(kind)
+}
+
+// Multibase is a multibase encoding, represented by its code character
+type Multibase byte
+
+// Supported multibase encodings
+const (
+	MultibaseBase16    Multibase = 'f' // Lowercase hexadecimal
+	MultibaseBase32    Multibase = 'b' // Lowercase RFC-4648 base32 without padding
+	MultibaseBase58BTC Multibase = 'z' // Base58 with the bitcoin alphabet
+	MultibaseBase64    Multibase = 'm' // RFC-4648 base64 without padding
+	MultibaseBase64URL Multibase = 'u' // RFC-4648 base64 with the URL-safe alphabet without padding
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base32Lower is the lowercase RFC-4648 base32 encoding without padding
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// encode encodes the given data with the multibase encoding.
+// Returns an error if the multibase encoding is not supported.
+func (base Multibase) encode(data []byte) (string, error) {
+	switch base {
+	case MultibaseBase16:
+		return hex.EncodeToString(data), nil
+	case MultibaseBase32:
+		return base32Lower.EncodeToString(data), nil
+	case MultibaseBase58BTC:
+		return encodeBase58(data), nil
+	case MultibaseBase64:
+		return base64.RawStdEncoding.EncodeToString(data), nil
+	case MultibaseBase64URL:
+		return base64.RawURLEncoding.EncodeToString(data), nil
+	default:
+		return "", fmt.Errorf("unsupported multibase encoding %q", byte(base))
+	}
+}
+
+// decode decodes the given data with the multibase encoding.
+// Hexadecimal and base32 data is decoded case-insensitively.
+func (base Multibase) decode(data string) ([]byte, error) {
+	switch base {
+	case MultibaseBase16, 'F':
+		return hex.DecodeString(data)
+	case MultibaseBase32, 'B':
+		return base32Lower.DecodeString(strings.ToLower(data))
+	case MultibaseBase58BTC:
+		return decodeBase58(data)
+	case MultibaseBase64:
+		return base64.RawStdEncoding.DecodeString(data)
+	case MultibaseBase64URL:
+		return base64.RawURLEncoding.DecodeString(data)
+	default:
+		return nil, fmt.Errorf("unsupported multibase encoding %q", byte(base))
+	}
+}
+
+// Multicodec returns the Identifier prefixed with the
+// unsigned varint of the multicodec code for its kind
+func (id Identifier) Multicodec() []byte {
+	buffer := make([]byte, 0, binary.MaxVarintLen64+32)
+	buffer = binary.AppendUvarint(buffer, MulticodecCode(id.Tag().Kind()))
+
+	return append(buffer, id[:]...)
+}
+
+// NewIdentifierFromMulticodec decodes an Identifier from its multicodec representation.
+// The multicodec code must match the kind of the decoded identifier, which must be valid.
+func NewIdentifierFromMulticodec(data []byte) (Identifier, error) {
+	code, size := binary.Uvarint(data)
+	if size <= 0 {
+		return Nil, errors.New("invalid multicodec: malformed code")
+	}
+
+	if len(data)-size != 32 {
+		return Nil, fmt.Errorf("invalid multicodec: %w", ErrInvalidLength)
+	}
+
+	id := Identifier(data[size:])
+	if err := id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	if code != MulticodecCode(id.Tag().Kind()) {
+		return Nil, fmt.Errorf("invalid multicodec: code 0x%x does not match %v identifier", code, id.Tag().Kind())
+	}
+
+	return id, nil
+}
+
+// Multibase returns the multicodec representation of the Identifier encoded with the given multibase encoding.
+// Returns an error if the multibase encoding is not supported.
+func (id Identifier) Multibase(base Multibase) (string, error) {
+	encoded, err := base.encode(id.Multicodec())
+	if err != nil {
+		return "", err
+	}
+
+	return string(base) + encoded, nil
+}
+
+// ParseMultibase decodes an Identifier from its multibase representation.
+// The multibase encoding is determined by the first character, and the decoded
+// data must be a valid multicodec representation (see NewIdentifierFromMulticodec).
+func ParseMultibase(s string) (Identifier, error) {
+	if len(s) == 0 {
+		return Nil, fmt.Errorf("invalid multibase: %w", ErrInvalidLength)
+	}
+
+	decoded, err := Multibase(s[0]).decode(s[1:])
+	if err != nil {
+		return Nil, fmt.Errorf("invalid multibase: %w", err)
+	}
+
+	return NewIdentifierFromMulticodec(decoded)
+}
+
+// encodeBase58 encodes the given data with the base58 bitcoin alphabet.
+// Leading zero bytes are encoded as leading '1' characters.
+func encodeBase58(data []byte) string {
+	var (
+		number    = new(big.Int).SetBytes(data)
+		radix     = big.NewInt(58)
+		remainder = new(big.Int)
+		encoded   = make([]byte, 0, len(data)*138/100+1)
+	)
+
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, remainder)
+		encoded = append(encoded, base58Alphabet[remainder.Int64()])
+	}
+
+	for _, value := range data {
+		if value != 0 {
+			break
+		}
+
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	// Reverse the encoded characters
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// decodeBase58 decodes the given string with the base58 bitcoin alphabet
+func decodeBase58(data string) ([]byte, error) {
+	var (
+		number = new(big.Int)
+		radix  = big.NewInt(58)
+		zeroes int
+	)
+
+	for _, char := range []byte(data) {
+		value := strings.IndexByte(base58Alphabet, char)
+		if value < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", char)
+		}
+
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(value)))
+	}
+
+	for zeroes < len(data) && data[zeroes] == base58Alphabet[0] {
+		zeroes++
+	}
+
+	return append(make([]byte, zeroes), number.Bytes()...), nil
+}
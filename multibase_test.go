@@ -0,0 +1,115 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticodecCode(t *testing.T) {
+	assert.Equal(t, uint64(0x300000), MulticodecCode(KindParticipant))
+	assert.Equal(t, uint64(0x300001), MulticodecCode(KindAsset))
+	assert.Equal(t, uint64(0x300002), MulticodecCode(KindLogic))
+}
+
+func TestIdentifier_Multicodec(t *testing.T) {
+	logic := RandomLogicIDv0().AsIdentifier()
+
+	encoded := logic.Multicodec()
+	require.Len(t, encoded, 4+32)
+
+	code, size := binary.Uvarint(encoded)
+	require.Equal(t, MulticodecCode(KindLogic), code)
+	require.Equal(t, logic.Bytes(), encoded[size:])
+
+	decoded, err := NewIdentifierFromMulticodec(encoded)
+	require.NoError(t, err)
+	require.Equal(t, logic, decoded)
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := NewIdentifierFromMulticodec(nil)
+		require.EqualError(t, err, "invalid multicodec: malformed code")
+
+		_, err = NewIdentifierFromMulticodec(encoded[:len(encoded)-1])
+		require.EqualError(t, err, "invalid multicodec: invalid length")
+
+		invalid := logic
+		invalid[0] = 0xF0
+
+		_, err = NewIdentifierFromMulticodec(invalid.Multicodec())
+		require.ErrorContains(t, err, "unsupported tag kind")
+
+		mismatched := append(binary.AppendUvarint(nil, MulticodecCode(KindAsset)), logic[:]...)
+
+		_, err = NewIdentifierFromMulticodec(mismatched)
+		require.EqualError(t, err, "invalid multicodec: code 0x300001 does not match logic identifier")
+	})
+}
+
+func TestIdentifier_Multibase(t *testing.T) {
+	asset := RandomAssetIDv0().AsIdentifier()
+
+	for _, base := range []Multibase{
+		MultibaseBase16, MultibaseBase32, MultibaseBase58BTC, MultibaseBase64, MultibaseBase64URL,
+	} {
+		t.Run(string(base), func(t *testing.T) {
+			encoded, err := asset.Multibase(base)
+			require.NoError(t, err)
+			require.Equal(t, byte(base), encoded[0])
+
+			decoded, err := ParseMultibase(encoded)
+			require.NoError(t, err)
+			require.Equal(t, asset, decoded)
+		})
+	}
+
+	t.Run("Uppercase", func(t *testing.T) {
+		encoded, _ := asset.Multibase(MultibaseBase16)
+		decoded, err := ParseMultibase("F" + encoded[1:])
+		require.NoError(t, err)
+		require.Equal(t, asset, decoded)
+
+		encoded, _ = asset.Multibase(MultibaseBase32)
+		decoded, err = ParseMultibase("B" + encoded[1:])
+		require.NoError(t, err)
+		require.Equal(t, asset, decoded)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := asset.Multibase('x')
+		require.EqualError(t, err, "unsupported multibase encoding 'x'")
+	})
+}
+
+func TestParseMultibase(t *testing.T) {
+	_, err := ParseMultibase("")
+	require.EqualError(t, err, "invalid multibase: invalid length")
+
+	_, err = ParseMultibase("x1234")
+	require.EqualError(t, err, "invalid multibase: unsupported multibase encoding 'x'")
+
+	_, err = ParseMultibase("z0OIl")
+	require.EqualError(t, err, "invalid multibase: invalid base58 character '0'")
+
+	_, err = ParseMultibase("fzz")
+	require.ErrorContains(t, err, "invalid multibase: encoding/hex")
+}
+
+func TestBase58(t *testing.T) {
+	assert.Equal(t, "2NEpo7TZRRrLZSi2U", encodeBase58([]byte("Hello World!")))
+	assert.Equal(t, "", encodeBase58(nil))
+
+	data := []byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}
+	encoded := encodeBase58(data)
+	require.Equal(t, "11", encoded[:2])
+
+	decoded, err := decodeBase58(encoded)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	decoded, err = decodeBase58("2NEpo7TZRRrLZSi2U")
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello World!"), decoded)
+}
@@ -0,0 +1,79 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+)
+
+// IsNested returns whether the Identifier is a child of another identifier, i.e., whether
+// the Nested flag is set. Always returns false for tags that do not support the Nested flag.
+func (id Identifier) IsNested() bool {
+	return Nested.Supports(id.Tag()) && getFlag(id[1], Nested.index)
+}
+
+// Parent returns the parent of the Identifier and whether the Identifier is nested.
+// The parent is recovered from the upper 16 bits of the variant ID of the child, with the
+// Nested flag unset. Returns Nil and false if the Identifier is not nested (see IsNested).
+func (id Identifier) Parent() (Identifier, bool) {
+	if !id.IsNested() {
+		return Nil, false
+	}
+
+	parent := id
+	parent[1] = setFlag(parent[1], Nested.index, false)
+	binary.BigEndian.PutUint32(parent[28:], id.Variant()>>16)
+
+	return parent, true
+}
+
+// ChildIndex returns the child index of the Identifier from the lower 16 bits of its
+// variant ID and whether the Identifier is nested (see IsNested).
+func (id Identifier) ChildIndex() (uint16, bool) {
+	if !id.IsNested() {
+		return 0, false
+	}
+
+	return uint16(id.Variant()), true
+}
+
+// Child derives the child of the Identifier with the given (non-zero) index. The child retains the tag, flags,
+// metadata and account ID of the Identifier, with the Nested flag set and the variant ID of the Identifier in
+// the upper 16 bits of its variant ID and the index in the lower 16 bits.
+//
+// Returns ErrUnsupportedFlag if the Nested flag is not supported by the identifier tag,
+// or an error if the index is zero, if the Identifier is already nested or if its variant ID
+// does not fit in 16 bits. Only a single level of nesting is supported.
+func (id Identifier) Child(index uint16) (Identifier, error) {
+	if !Nested.Supports(id.Tag()) {
+		return Nil, ErrUnsupportedFlag
+	}
+
+	if index == 0 {
+		return Nil, errors.New("invalid child index: must be non-zero")
+	}
+
+	if id.IsNested() {
+		return Nil, errors.New("invalid parent: identifier is nested")
+	}
+
+	if id.Variant() > math.MaxUint16 {
+		return Nil, fmt.Errorf("invalid parent: variant %d does not fit in 16 bits", id.Variant())
+	}
+
+	return id.DeriveVariant(id.Variant()<<16|uint32(index), []Flag{Nested}, nil)
+}
+
+// Children returns an iterator over all the children of the Identifier in ascending order of their
+// child index, from 1 to 65535 (see Identifier.Child). The iterator yields no identifiers if the
+// Identifier cannot have children.
+func (id Identifier) Children() iter.Seq[Identifier] {
+	first, err := id.Child(1)
+	if err != nil {
+		return func(func(Identifier) bool) {}
+	}
+
+	return first.Variants(first.Variant(), first.Variant()|math.MaxUint16)
+}
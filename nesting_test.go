@@ -0,0 +1,97 @@
+package identifiers
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_Child(t *testing.T) {
+	series := must(GenerateAssetIDv0(RandomFingerprint(), 7, 3, AssetStateful)).AsIdentifier()
+
+	child, err := series.Child(42)
+	require.NoError(t, err)
+	require.NoError(t, child.Validate())
+
+	assert.True(t, child.IsNested())
+	assert.False(t, series.IsNested())
+	assert.Equal(t, uint32(7<<16|42), child.Variant())
+	assert.Equal(t, series.Tag(), child.Tag())
+	assert.Equal(t, series.Metadata(), child.Metadata())
+	assert.Equal(t, series.AccountID(), child.AccountID())
+	assert.True(t, must(child.AsAssetID()).Flag(AssetStateful))
+
+	index, ok := child.ChildIndex()
+	require.True(t, ok)
+	assert.Equal(t, uint16(42), index)
+
+	parent, ok := child.Parent()
+	require.True(t, ok)
+	assert.Equal(t, series, parent)
+
+	t.Run("BaseParent", func(t *testing.T) {
+		module := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, LogicIntrinsic)).AsIdentifier()
+
+		child, err := module.Child(1)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), child.Variant())
+
+		parent, ok := child.Parent()
+		require.True(t, ok)
+		assert.Equal(t, module, parent)
+	})
+
+	t.Run("NotNested", func(t *testing.T) {
+		parent, ok := series.Parent()
+		assert.False(t, ok)
+		assert.Equal(t, Identifier(Nil), parent)
+
+		index, ok := series.ChildIndex()
+		assert.False(t, ok)
+		assert.Zero(t, index)
+
+		// The flag bit is ignored for tags that do not support it
+		participant := Identifier{byte(TagParticipantV0), 0b01000000}
+		assert.False(t, participant.IsNested())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		participant := must(GenerateParticipantIDv0(RandomFingerprint(), 0)).AsIdentifier()
+		_, err := participant.Child(1)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+
+		_, err = series.Child(0)
+		require.EqualError(t, err, "invalid child index: must be non-zero")
+
+		_, err = child.Child(1)
+		require.EqualError(t, err, "invalid parent: identifier is nested")
+
+		_, err = must(series.DeriveVariant(math.MaxUint16+1, nil, nil)).Child(1)
+		require.EqualError(t, err, "invalid parent: variant 65536 does not fit in 16 bits")
+	})
+}
+
+func TestIdentifier_Children(t *testing.T) {
+	series := must(GenerateAssetIDv0(RandomFingerprint(), math.MaxUint16, 3)).AsIdentifier()
+
+	children := slices.Collect(series.Children())
+	require.Len(t, children, math.MaxUint16)
+
+	for position, child := range children[:10] {
+		assert.Equal(t, must(series.Child(uint16(position+1))), child)
+	}
+
+	last := children[len(children)-1]
+	assert.Equal(t, uint32(math.MaxUint32), last.Variant())
+
+	parent, ok := last.Parent()
+	require.True(t, ok)
+	assert.Equal(t, series, parent)
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Empty(t, slices.Collect(children[0].Children()))
+	})
+}
@@ -0,0 +1,130 @@
+package identifiers
+
+import (
+	"encoding"
+	"fmt"
+	"strings"
+)
+
+// Network represents a MOI Protocol network that an identifier can be scoped to.
+// The zero value of Network is not a valid network and must not be used for scoping.
+type Network uint8
+
+const (
+	NetworkMainnet Network = iota + 1
+	NetworkTestnet
+	NetworkDevnet
+)
+
+// networkNames is a map of Network to its name used in text encoding
+var networkNames = map[Network]string{
+	NetworkMainnet: "mainnet",
+	NetworkTestnet: "testnet",
+	NetworkDevnet:  "devnet",
+}
+
+// ParseNetwork returns the Network for the given network name.
+// Returns ErrUnknownNetwork if the name is not a recognized network.
+func ParseNetwork(name string) (Network, error) {
+	for network, networkName := range networkNames {
+		if networkName == name {
+			return network, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownNetwork, name)
+}
+
+// String returns the name of the Network.
+// Unknown networks are rendered as "network(<value>)"
+func (network Network) String() string {
+	if name, ok := networkNames[network]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("network(%d)", uint8(network))
+}
+
+// Validate returns ErrUnknownNetwork if the Network is not a recognized network.
+func (network Network) Validate() error {
+	if _, ok := networkNames[network]; !ok {
+		return fmt.Errorf("%w: %v", ErrUnknownNetwork, network)
+	}
+
+	return nil
+}
+
+// ScopedIdentifier is an Identifier bound to the Network that it belongs to.
+// It allows tooling to reject identifiers that are used on a network different
+// from the one they were intended for, such as a testnet AssetID used on mainnet.
+//
+// The Network scope is not encoded into the identifier itself and only exists
+// as a wrapper around it, which has its own text encoding: <network>:0x<identifier>
+type ScopedIdentifier struct {
+	Network    Network
+	Identifier Identifier
+}
+
+// ParseScopedIdentifier creates a new ScopedIdentifier from the given string.
+// The string must be of the form <network>:0x<identifier>, such as "testnet:0x1000...".
+func ParseScopedIdentifier(data string) (ScopedIdentifier, error) {
+	var scoped ScopedIdentifier
+	if err := scoped.UnmarshalText([]byte(data)); err != nil {
+		return ScopedIdentifier{}, err
+	}
+
+	return scoped, nil
+}
+
+// String returns the ScopedIdentifier in its text encoding
+// This is of the form <network>:0x<identifier>
+func (scoped ScopedIdentifier) String() string {
+	return scoped.Network.String() + ":" + scoped.Identifier.Hex()
+}
+
+// Expect returns an error if the ScopedIdentifier does not belong to the given Network.
+// The returned error can be matched against ErrNetworkMismatch.
+func (scoped ScopedIdentifier) Expect(network Network) error {
+	if scoped.Network != network {
+		return fmt.Errorf("%w: expected %v, got %v", ErrNetworkMismatch, network, scoped.Network)
+	}
+
+	return nil
+}
+
+var (
+	// Ensure ScopedIdentifier implements text marshaling interfaces
+	_ encoding.TextMarshaler   = (*ScopedIdentifier)(nil)
+	_ encoding.TextUnmarshaler = (*ScopedIdentifier)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for ScopedIdentifier
+func (scoped ScopedIdentifier) MarshalText() ([]byte, error) {
+	if err := scoped.Network.Validate(); err != nil {
+		return nil, err
+	}
+
+	return []byte(scoped.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ScopedIdentifier
+func (scoped *ScopedIdentifier) UnmarshalText(data []byte) error {
+	name, identifier, found := strings.Cut(string(data), ":")
+	if !found {
+		return ErrMissingNetworkScope
+	}
+
+	network, err := ParseNetwork(name)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := unmarshal32([]byte(identifier))
+	if err != nil {
+		return err
+	}
+
+	*scoped = ScopedIdentifier{Network: network, Identifier: decoded}
+
+	return nil
+}
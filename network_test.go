@@ -0,0 +1,82 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork(t *testing.T) {
+	tests := []struct {
+		network Network
+		name    string
+	}{
+		{NetworkMainnet, "mainnet"},
+		{NetworkTestnet, "testnet"},
+		{NetworkDevnet, "devnet"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.name, tt.network.String())
+		assert.NoError(t, tt.network.Validate())
+
+		parsed, err := ParseNetwork(tt.name)
+		require.NoError(t, err)
+		assert.Equal(t, tt.network, parsed)
+	}
+
+	assert.Equal(t, "network(0)", Network(0).String())
+	assert.EqualError(t, Network(0).Validate(), "unknown network: network(0)")
+
+	_, err := ParseNetwork("moonnet")
+	assert.EqualError(t, err, `unknown network: "moonnet"`)
+}
+
+func TestScopedIdentifier(t *testing.T) {
+	asset := RandomAssetIDv0().AsIdentifier()
+	scoped := ScopedIdentifier{Network: NetworkTestnet, Identifier: asset}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "testnet:"+asset.Hex(), scoped.String())
+
+		parsed, err := ParseScopedIdentifier(scoped.String())
+		require.NoError(t, err)
+		assert.Equal(t, scoped, parsed)
+	})
+
+	t.Run("Expect", func(t *testing.T) {
+		require.NoError(t, scoped.Expect(NetworkTestnet))
+
+		err := scoped.Expect(NetworkMainnet)
+		require.EqualError(t, err, "network mismatch: expected mainnet, got testnet")
+		require.True(t, errors.Is(err, ErrNetworkMismatch))
+	})
+
+	t.Run("TextMarshal", func(t *testing.T) {
+		encoded, err := json.Marshal(scoped)
+		require.NoError(t, err)
+		require.Equal(t, `"testnet:`+asset.Hex()+`"`, string(encoded))
+
+		var decoded ScopedIdentifier
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, scoped, decoded)
+
+		_, err = json.Marshal(ScopedIdentifier{Identifier: asset})
+		require.ErrorIs(t, err, ErrUnknownNetwork)
+	})
+
+	t.Run("InvalidText", func(t *testing.T) {
+		_, err := ParseScopedIdentifier(asset.Hex())
+		require.Equal(t, ErrMissingNetworkScope, err)
+
+		_, err = ParseScopedIdentifier("moonnet:" + asset.Hex())
+		require.EqualError(t, err, `unknown network: "moonnet"`)
+
+		_, err = ParseScopedIdentifier("mainnet:0xffabcd")
+		require.Equal(t, ErrInvalidLength, err)
+	})
+}
@@ -0,0 +1,293 @@
+package identifiers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// nullJSON is the JSON encoding of a null value
+var nullJSON = []byte("null")
+
+// scanBytes decodes the given value from an sql.Scanner into the 32 bytes of an identifier.
+// Values can be raw bytes (32 bytes) or a hex-encoded string (as string or []byte, with the
+// 0x prefix being optional). Returns nil (without an error) if the value is NULL.
+func scanBytes(src any) ([]byte, error) {
+	switch value := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return decodeHexString(value)
+	case []byte:
+		// Raw bytes are used as is
+		if len(value) == 32 {
+			return value, nil
+		}
+
+		return decodeHexString(string(value))
+	default:
+		return nil, fmt.Errorf("unsupported scan type %T for identifier", src)
+	}
+}
+
+// valueOf returns the driver.Value for a nullable identifier, which is its hex-encoded
+// string if it is valid or NULL (nil) otherwise
+func valueOf(hex string, valid bool) (driver.Value, error) {
+	if !valid {
+		return nil, nil
+	}
+
+	return hex, nil
+}
+
+// marshalNull returns the JSON encoding for a nullable identifier with the given value,
+// which is the JSON encoding of the value if it is valid or null otherwise
+func marshalNull(value any, valid bool) ([]byte, error) {
+	if !valid {
+		return nullJSON, nil
+	}
+
+	return json.Marshal(value)
+}
+
+// unmarshalNull decodes the given JSON data into the given value of a nullable identifier.
+// Returns whether the data contained a non-null value.
+func unmarshalNull(data []byte, value any) (bool, error) {
+	if string(data) == string(nullJSON) {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+var (
+	// Ensure nullable identifiers implement the sql interfaces
+	_ sql.Scanner   = (*NullIdentifier)(nil)
+	_ driver.Valuer = NullIdentifier{}
+	_ sql.Scanner   = (*NullParticipantID)(nil)
+	_ driver.Valuer = NullParticipantID{}
+	_ sql.Scanner   = (*NullAssetID)(nil)
+	_ driver.Valuer = NullAssetID{}
+	_ sql.Scanner   = (*NullLogicID)(nil)
+	_ driver.Valuer = NullLogicID{}
+
+	// Ensure nullable identifiers implement the json interfaces
+	_ json.Marshaler   = NullIdentifier{}
+	_ json.Unmarshaler = (*NullIdentifier)(nil)
+	_ json.Marshaler   = NullParticipantID{}
+	_ json.Unmarshaler = (*NullParticipantID)(nil)
+	_ json.Marshaler   = NullAssetID{}
+	_ json.Unmarshaler = (*NullAssetID)(nil)
+	_ json.Marshaler   = NullLogicID{}
+	_ json.Unmarshaler = (*NullLogicID)(nil)
+)
+
+// NullIdentifier represents an Identifier that may be null.
+// It can be used with database/sql and encoding/json to distinguish a missing identifier from Nil.
+// Identifiers are stored in databases as their hex-encoded string, and encoded as null in JSON if not Valid.
+type NullIdentifier struct {
+	Identifier Identifier
+	Valid      bool // Valid is true if Identifier is not NULL
+}
+
+// Scan implements the sql.Scanner interface for NullIdentifier.
+// The scanned Identifier must be valid, see Identifier.Validate
+func (null *NullIdentifier) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil || data == nil {
+		*null = NullIdentifier{}
+		return err
+	}
+
+	if len(data) != 32 {
+		return errors.New("invalid length: identifier must be 32 bytes")
+	}
+
+	id := Identifier(data)
+	if err = id.Validate(); err != nil {
+		return err
+	}
+
+	*null = NullIdentifier{Identifier: id, Valid: true}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface for NullIdentifier
+func (null NullIdentifier) Value() (driver.Value, error) {
+	return valueOf(null.Identifier.Hex(), null.Valid)
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullIdentifier
+func (null NullIdentifier) MarshalJSON() ([]byte, error) {
+	return marshalNull(null.Identifier, null.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullIdentifier
+func (null *NullIdentifier) UnmarshalJSON(data []byte) (err error) {
+	var decoded NullIdentifier
+
+	if decoded.Valid, err = unmarshalNull(data, &decoded.Identifier); err != nil {
+		return err
+	}
+
+	*null = decoded
+
+	return nil
+}
+
+// NullParticipantID represents a ParticipantID that may be null.
+// It can be used with database/sql and encoding/json to distinguish a missing identifier from Nil.
+// Identifiers are stored in databases as their hex-encoded string, and encoded as null in JSON if not Valid.
+type NullParticipantID struct {
+	ParticipantID ParticipantID
+	Valid         bool // Valid is true if ParticipantID is not NULL
+}
+
+// Scan implements the sql.Scanner interface for NullParticipantID.
+// The scanned ParticipantID must be valid, see ParticipantID.Validate
+func (null *NullParticipantID) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil || data == nil {
+		*null = NullParticipantID{}
+		return err
+	}
+
+	participant, err := NewParticipantIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*null = NullParticipantID{ParticipantID: participant, Valid: true}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface for NullParticipantID
+func (null NullParticipantID) Value() (driver.Value, error) {
+	return valueOf(null.ParticipantID.Hex(), null.Valid)
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullParticipantID
+func (null NullParticipantID) MarshalJSON() ([]byte, error) {
+	return marshalNull(null.ParticipantID, null.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullParticipantID
+func (null *NullParticipantID) UnmarshalJSON(data []byte) (err error) {
+	var decoded NullParticipantID
+
+	if decoded.Valid, err = unmarshalNull(data, &decoded.ParticipantID); err != nil {
+		return err
+	}
+
+	*null = decoded
+
+	return nil
+}
+
+// NullAssetID represents an AssetID that may be null.
+// It can be used with database/sql and encoding/json to distinguish a missing identifier from Nil.
+// Identifiers are stored in databases as their hex-encoded string, and encoded as null in JSON if not Valid.
+type NullAssetID struct {
+	AssetID AssetID
+	Valid   bool // Valid is true if AssetID is not NULL
+}
+
+// Scan implements the sql.Scanner interface for NullAssetID.
+// The scanned AssetID must be valid, see AssetID.Validate
+func (null *NullAssetID) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil || data == nil {
+		*null = NullAssetID{}
+		return err
+	}
+
+	asset, err := NewAssetIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*null = NullAssetID{AssetID: asset, Valid: true}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface for NullAssetID
+func (null NullAssetID) Value() (driver.Value, error) {
+	return valueOf(null.AssetID.Hex(), null.Valid)
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullAssetID
+func (null NullAssetID) MarshalJSON() ([]byte, error) {
+	return marshalNull(null.AssetID, null.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullAssetID
+func (null *NullAssetID) UnmarshalJSON(data []byte) (err error) {
+	var decoded NullAssetID
+
+	if decoded.Valid, err = unmarshalNull(data, &decoded.AssetID); err != nil {
+		return err
+	}
+
+	*null = decoded
+
+	return nil
+}
+
+// NullLogicID represents a LogicID that may be null.
+// It can be used with database/sql and encoding/json to distinguish a missing identifier from Nil.
+// Identifiers are stored in databases as their hex-encoded string, and encoded as null in JSON if not Valid.
+type NullLogicID struct {
+	LogicID LogicID
+	Valid   bool // Valid is true if LogicID is not NULL
+}
+
+// Scan implements the sql.Scanner interface for NullLogicID.
+// The scanned LogicID must be valid, see LogicID.Validate
+func (null *NullLogicID) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil || data == nil {
+		*null = NullLogicID{}
+		return err
+	}
+
+	logic, err := NewLogicIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*null = NullLogicID{LogicID: logic, Valid: true}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface for NullLogicID
+func (null NullLogicID) Value() (driver.Value, error) {
+	return valueOf(null.LogicID.Hex(), null.Valid)
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullLogicID
+func (null NullLogicID) MarshalJSON() ([]byte, error) {
+	return marshalNull(null.LogicID, null.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullLogicID
+func (null *NullLogicID) UnmarshalJSON(data []byte) (err error) {
+	var decoded NullLogicID
+
+	if decoded.Valid, err = unmarshalNull(data, &decoded.LogicID); err != nil {
+		return err
+	}
+
+	*null = decoded
+
+	return nil
+}
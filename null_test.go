@@ -0,0 +1,115 @@
+package identifiers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nullable is the common interface of all nullable identifiers
+type nullable interface {
+	sql.Scanner
+	driver.Valuer
+	json.Marshaler
+	json.Unmarshaler
+}
+
+func TestNullTypes(t *testing.T) {
+	participant := RandomParticipantIDv0()
+	asset := RandomAssetIDv0()
+	logic := RandomLogicIDv0()
+	id := RandomTopicIDv0().AsIdentifier()
+
+	tests := []struct {
+		name    string
+		empty   func() nullable
+		valid   nullable
+		hex     string
+		invalid [32]byte
+		err     string
+	}{
+		{
+			"NullIdentifier",
+			func() nullable { return &NullIdentifier{} },
+			&NullIdentifier{Identifier: id, Valid: true},
+			id.Hex(), [32]byte{0xF0}, "invalid tag: unsupported tag kind",
+		},
+		{
+			"NullParticipantID",
+			func() nullable { return &NullParticipantID{} },
+			&NullParticipantID{ParticipantID: participant, Valid: true},
+			participant.Hex(), asset, "invalid tag: not a participant id",
+		},
+		{
+			"NullAssetID",
+			func() nullable { return &NullAssetID{} },
+			&NullAssetID{AssetID: asset, Valid: true},
+			asset.Hex(), logic, "invalid tag: not an asset id",
+		},
+		{
+			"NullLogicID",
+			func() nullable { return &NullLogicID{} },
+			&NullLogicID{LogicID: logic, Valid: true},
+			logic.Hex(), asset, "invalid tag: not a logic id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := decodeHexString(tt.hex)
+			require.NoError(t, err)
+
+			t.Run("Value", func(t *testing.T) {
+				value, err := tt.valid.Value()
+				require.NoError(t, err)
+				assert.Equal(t, tt.hex, value)
+
+				value, err = tt.empty().Value()
+				require.NoError(t, err)
+				assert.Nil(t, value)
+			})
+
+			t.Run("Scan", func(t *testing.T) {
+				for _, src := range []any{tt.hex, []byte(tt.hex), raw, tt.hex[2:]} {
+					scanned := tt.empty()
+					require.NoError(t, scanned.Scan(src))
+					assert.Equal(t, tt.valid, scanned)
+				}
+
+				// Scanning NULL resets the value
+				scanned := tt.empty()
+				require.NoError(t, scanned.Scan(raw))
+				require.NoError(t, scanned.Scan(nil))
+				assert.Equal(t, tt.empty(), scanned)
+
+				require.EqualError(t, tt.empty().Scan(42), "unsupported scan type int for identifier")
+				require.EqualError(t, tt.empty().Scan("0xZZ"), "encoding/hex: invalid byte: U+005A 'Z'")
+				require.ErrorContains(t, tt.empty().Scan("0x0102"), "invalid length")
+				require.EqualError(t, tt.empty().Scan(tt.invalid[:]), tt.err)
+			})
+
+			t.Run("JSON", func(t *testing.T) {
+				encoded, err := json.Marshal(tt.valid)
+				require.NoError(t, err)
+				assert.Equal(t, `"`+tt.hex+`"`, string(encoded))
+
+				decoded := tt.empty()
+				require.NoError(t, json.Unmarshal(encoded, decoded))
+				assert.Equal(t, tt.valid, decoded)
+
+				encoded, err = json.Marshal(tt.empty())
+				require.NoError(t, err)
+				assert.Equal(t, "null", string(encoded))
+
+				require.NoError(t, json.Unmarshal([]byte("null"), decoded))
+				assert.Equal(t, tt.empty(), decoded)
+
+				require.Equal(t, ErrMissingHexPrefix, json.Unmarshal([]byte(`"1234"`), decoded))
+			})
+		})
+	}
+}
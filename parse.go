@@ -0,0 +1,62 @@
+package identifiers
+
+// Parse creates an identifier from the given hex string, detecting its kind from the tag.
+// The given value must decode as hexadecimal string (0x prefix is optional), with a length of 64 characters
+// (32 bytes). The identifier is returned as the concrete type of its kind (such as AssetID), which must
+// validate into that type. Identifiers of custom kinds are returned as an Identifier, which must be valid.
+//
+// This allows handlers that accept an identifier of any kind to parse it once and then
+// use a type switch on the result, instead of attempting each conversion in turn.
+func Parse(input string) (TaggedIdentifier, error) {
+	id, err := NewIdentifierFromHex(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedIdentifier(id)
+}
+
+// typedIdentifier returns the given identifier as the concrete type of its kind, which it must validate into.
+// Identifiers of custom kinds are returned as an Identifier after validation.
+func typedIdentifier(id Identifier) (TaggedIdentifier, error) {
+	switch id.Tag().Kind() {
+	case KindParticipant:
+		return tagged(id.AsParticipantID())
+	case KindAsset:
+		return tagged(id.AsAssetID())
+	case KindLogic:
+		return tagged(id.AsLogicID())
+	case KindInteraction:
+		return tagged(id.AsInteractionID())
+	case KindTesseract:
+		return tagged(id.AsTesseractID())
+	case KindGroup:
+		return tagged(id.AsGroupID())
+	case KindFile:
+		return tagged(id.AsFileID())
+	case KindReceipt:
+		return tagged(id.AsReceiptID())
+	case KindTopic:
+		return tagged(id.AsTopicID())
+	case KindKey:
+		return tagged(id.AsKeyID())
+	case KindDomain:
+		return tagged(id.AsDomainID())
+	default:
+		if err := id.Validate(); err != nil {
+			return nil, err
+		}
+
+		return id, nil
+	}
+}
+
+// tagged returns the given identifier as a TaggedIdentifier,
+// or a nil TaggedIdentifier if the given error is not nil
+func tagged[T TaggedIdentifier](id T, err error) (TaggedIdentifier, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,86 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []TaggedIdentifier{
+		RandomParticipantIDv1(),
+		RandomAssetIDv1(),
+		RandomLogicIDv0(),
+		RandomInteractionIDv0(),
+		RandomTesseractIDv0(),
+		RandomGroupIDv0(),
+		RandomFileIDv0(),
+		RandomReceiptIDv0(),
+		RandomTopicIDv0(),
+		RandomKeyIDv0(),
+		RandomDomainIDv0(),
+	}
+
+	for _, id := range tests {
+		t.Run(id.Tag().Kind().String(), func(t *testing.T) {
+			parsed, err := Parse(id.Hex())
+			require.NoError(t, err)
+
+			// The parsed identifier has the concrete type of its kind
+			assert.IsType(t, id, parsed)
+			assert.Equal(t, id, parsed)
+		})
+	}
+
+	t.Run("TypeSwitch", func(t *testing.T) {
+		asset := RandomAssetIDv0()
+
+		parsed, err := Parse(asset.Hex())
+		require.NoError(t, err)
+
+		switch parsed := parsed.(type) {
+		case AssetID:
+			assert.Equal(t, asset.Standard(), parsed.Standard())
+		default:
+			t.Fatalf("unexpected type %T", parsed)
+		}
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{MaxVersion: 0, FlagMasks: []byte{0b01111111}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		custom := Identifier{byte(kindCustom << 4), 31: 0x01}
+
+		parsed, err := Parse(custom.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, custom, parsed)
+
+		// Custom identifiers must be valid
+		custom[1] = 0b00000001
+
+		_, err = Parse(custom.Hex())
+		require.ErrorIs(t, err, ErrBadFlags)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Parse("0x0102")
+		require.EqualError(t, err, "invalid length: identifier must be 32 bytes")
+
+		_, err = Parse("0xzz")
+		require.Error(t, err)
+
+		// Identifiers must validate into the type of their kind
+		invalid := Identifier{byte(TagAssetV0), 0xFF}
+
+		parsed, err := Parse(invalid.Hex())
+		require.ErrorIs(t, err, ErrBadFlags)
+		assert.Nil(t, parsed)
+
+		_, err = Parse(Identifier{0xF0}.Hex())
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+	})
+}
@@ -6,14 +6,17 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand/v2"
+	"slices"
+	"time"
 )
 
 // ParticipantID is a unique identifier for a participant in the MOI Protocol.
 // It is 32 bytes long and its first 4 bytes are structured as follows:
 //   - Tag: The first byte contains the tag for the participant identifier.
 //   - Flags: The second byte contains flags for the participant identifier.
-//   - Metadata: As of v0, ParticipantID has no metadata.
+//   - Metadata: For multisig participants, the threshold (m) and the member count (n).
 //
 // Like all identifiers, the ParticipantID also contains a Fingerprint and a Variant ID.
 // Flags of a ParticipantID are specific to a version and are invalid if set in an unsupported version.
@@ -47,6 +50,12 @@ func NewParticipantIDFromBytes(data []byte) (ParticipantID, error) {
 // The given value must decode as hexadecimal string (0x prefix is optional),
 // with a length of 64 characters (32 bytes) and validate into a ParticipantID.
 func NewParticipantIDFromHex(data string) (ParticipantID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindParticipant {
+		return ParticipantID(id), nil
+	}
+
 	// Decode the given hex string into []byte
 	decoded, err := decodeHexString(data)
 	if err != nil {
@@ -55,7 +64,15 @@ func NewParticipantIDFromHex(data string) (ParticipantID, error) {
 
 	// Create a new ParticipantID from the decoded value
 	// Length check is performed in NewParticipantIDFromBytes
-	return NewParticipantIDFromBytes(decoded)
+	converted, err := NewParticipantIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
 }
 
 // MustParticipantID is an enforced version of NewParticipantID.
@@ -86,6 +103,9 @@ func (participant ParticipantID) Hex() string {
 	return prefix0xString + hex.EncodeToString(participant[:])
 }
 
+// IsNil returns if the ParticipantID is nil, i.e., 0x000..000
+func (participant ParticipantID) IsNil() bool { return participant == ParticipantID{} }
+
 // AsIdentifier returns the ParticipantID as an Identifier.
 func (participant ParticipantID) AsIdentifier() Identifier {
 	return Identifier(participant)
@@ -96,11 +116,28 @@ func (participant ParticipantID) Tag() IdentifierTag {
 	return IdentifierTag(participant[0])
 }
 
+// Flags returns the byte of flag bits from the ParticipantID
+func (participant ParticipantID) Flags() byte {
+	return participant[1]
+}
+
+// ListFlags returns the flags (including custom flags) that are set on the ParticipantID, ordered by their bit index.
+// Set bits that are not supported by the ParticipantID are not listed (see FlagSet.List).
+func (participant ParticipantID) ListFlags() []Flag {
+	return NewFlagSet(participant.Tag(), participant.Flags()).List()
+}
+
 // Fingerprint returns the 24-byte fingerprint ID from the ParticipantID.
 func (participant ParticipantID) Fingerprint() [24]byte {
 	return trimFingerprint(participant)
 }
 
+// AccountID returns the 24-byte account ID from the ParticipantID.
+// This is identical to ParticipantID.Fingerprint()
+func (participant ParticipantID) AccountID() [24]byte {
+	return trimFingerprint(participant)
+}
+
 // Variant returns the 32-bit variant ID from the ParticipantID.
 func (participant ParticipantID) Variant() uint32 {
 	variant := trimVariant(participant)
@@ -113,6 +150,12 @@ func (participant ParticipantID) IsVariant() bool {
 	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
 }
 
+// SubAccount returns the variant ID of the ParticipantID as the sub-account of the participant,
+// and whether the variant ID of its kind is interpreted as a sub-account (see VariantSemantics).
+func (participant ParticipantID) SubAccount() (uint32, bool) {
+	return variantAs(participant.AsIdentifier(), SubAccountVariant)
+}
+
 // Flag returns if the given Flag is set on the ParticipantID.
 //
 // If the specified flag is not supported by the ParticipantID,
@@ -127,31 +170,87 @@ func (participant ParticipantID) Flag(flag Flag) bool {
 	return getFlag(participant[1], flag.index)
 }
 
+// Threshold returns the number of member signatures (m) required by a multisig ParticipantID.
+// Returns 0 if the ParticipantID does not have the ParticipantMultisig flag set.
+func (participant ParticipantID) Threshold() uint8 {
+	if !participant.Flag(ParticipantMultisig) {
+		return 0
+	}
+
+	return participant[2]
+}
+
+// MemberCount returns the number of members (n) of a multisig ParticipantID.
+// Returns 0 if the ParticipantID does not have the ParticipantMultisig flag set.
+func (participant ParticipantID) MemberCount() uint8 {
+	if !participant.Flag(ParticipantMultisig) {
+		return 0
+	}
+
+	return participant[3]
+}
+
+// errInvalidMultisigMetadata is returned by the Validate methods of ParticipantID and
+// Identifier if the threshold metadata of a multisig participant is invalid
+var errInvalidMultisigMetadata = errors.New("invalid metadata: multisig threshold must be between 1 and member count")
+
+// errMultisigFlag is returned by the plain participant generators if the ParticipantMultisig flag is used,
+// as they cannot encode the threshold metadata of a multisig participant
+var errMultisigFlag = fmt.Errorf(
+	"%w: multisig participants must be generated with GenerateMultisigParticipantID", ErrUnsupportedFlag,
+)
+
+// checkMultisigMetadata returns errInvalidMultisigMetadata if the given identifier is a multisig
+// participant whose threshold is not within its member count. The flags of the identifier must be
+// supported by its tag, so that the multisig flag bit can be checked directly.
+func checkMultisigMetadata(id Identifier) error {
+	if id.Tag().Kind() != KindParticipant || !getFlag(id[1], ParticipantMultisig.index) {
+		return nil
+	}
+
+	return MultisigMetadata{Threshold: id[2], Members: id[3]}.Validate()
+}
+
 // Validate returns an error if the ParticipantID is invalid.
 // An error is returned if the ParticipantID has an invalid tag or contains unsupported flags.
+// For multisig participants, an error is also returned if the threshold metadata is invalid.
 func (participant ParticipantID) Validate() error {
 	// Check basic validity of the identifier tag
 	if err := participant.Tag().Validate(); err != nil {
-		return fmt.Errorf("invalid tag: %w", err)
+		return invalidTag(err)
 	}
 
 	// Check if the tag is a participant tag
 	if participant.Tag().Kind() != KindParticipant {
-		return errors.New("invalid tag: not a participant id")
+		return ErrNotParticipantID
 	}
 
 	// Check that there are no unsupported flags set
-	if (participant[1] & flagMasks[participant.Tag()]) != 0 {
-		return errors.New("invalid flags: unsupported flags for participant id")
+	if (participant[1] & participant.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: participant.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(participant)); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// DeriveVariant returns a new ParticipantID with the given variant ID and specified flags set/unset.
+// Returns an error if the given flags are not supported for the ParticipantID or if the
+// threshold metadata of a derived multisig participant is invalid.
+func (participant ParticipantID) DeriveVariant(variant uint32, set []Flag, unset []Flag) (ParticipantID, error) {
+	return deriveVariant(participant, variant, set, unset, NewParticipantID)
+}
+
 var (
-	// Ensure ParticipantID implements text marshaling interfaces
-	_ encoding.TextMarshaler   = (*ParticipantID)(nil)
-	_ encoding.TextUnmarshaler = (*ParticipantID)(nil)
+	// Ensure ParticipantID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*ParticipantID)(nil)
+	_ encoding.TextUnmarshaler   = (*ParticipantID)(nil)
+	_ encoding.BinaryMarshaler   = (*ParticipantID)(nil)
+	_ encoding.BinaryUnmarshaler = (*ParticipantID)(nil)
 )
 
 // MarshalText implements the encoding.TextMarshaler interface for ParticipantID
@@ -161,7 +260,31 @@ func (participant ParticipantID) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for ParticipantID
 func (participant *ParticipantID) UnmarshalText(data []byte) error {
-	decoded, err := unmarshal32(data)
+	decoded, err := unmarshalCached(data, KindParticipant)
+	if err != nil {
+		return err
+	}
+
+	*participant = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for ParticipantID.
+// The ParticipantID is encoded as its raw 32 bytes.
+func (participant ParticipantID) MarshalBinary() ([]byte, error) {
+	return participant.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for ParticipantID.
+// The data must be 32 bytes long and validate into a ParticipantID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (participant *ParticipantID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*participant = ParticipantID{}
+		return nil
+	}
+
+	decoded, err := NewParticipantIDFromBytes(data)
 	if err != nil {
 		return err
 	}
@@ -171,12 +294,13 @@ func (participant *ParticipantID) UnmarshalText(data []byte) error {
 }
 
 // GenerateParticipantIDv0 creates a new ParticipantID for v0 with the given parameters.
-// Returns an error if unsupported flags are used.
+// Returns an error if unsupported flags are used or if the ParticipantMultisig flag is used,
+// as multisig participants must be generated with GenerateMultisigParticipantID.
 //
-// [tag:1][{systemic}{reserved:7}][standard:2][fingerprint:24][variant:4]
+// [tag:1][{systemic}{reserved:6}{multisig}][metadata:2][fingerprint:24][variant:4]
 func GenerateParticipantIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (ParticipantID, error) {
 	// Create the metadata buffer
-	// [tag][flags][standard]
+	// [tag][flags][metadata]
 	metadata := make([]byte, 4)
 	// Attach the tag for ParticipantID v0
 	metadata[0] = byte(TagParticipantV0)
@@ -188,6 +312,11 @@ func GenerateParticipantIDv0(fingerprint [24]byte, variant uint32, flags ...Flag
 			return Nil, ErrUnsupportedFlag
 		}
 
+		// Multisig participants require threshold metadata, see GenerateMultisigParticipantID
+		if flag.name == ParticipantMultisig.name {
+			return Nil, errMultisigFlag
+		}
+
 		// Set the flag in the metadata
 		metadata[1] = setFlag(metadata[1], flag.index, true)
 	}
@@ -201,6 +330,11 @@ func GenerateParticipantIDv0(fingerprint [24]byte, variant uint32, flags ...Flag
 	buffer = append(buffer, make([]byte, 4)...)
 	binary.BigEndian.PutUint32(buffer[28:], variant)
 
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
 	return ParticipantID(buffer), nil
 }
 
@@ -212,3 +346,154 @@ func RandomParticipantIDv0() ParticipantID {
 	participant, _ := GenerateParticipantIDv0(RandomFingerprint(), rand.Uint32())
 	return participant
 }
+
+// GenerateParticipantIDv1 creates a new ParticipantID for v1 with the given parameters.
+// The v1 layout is identical to v0, with the metadata reserved for the multisig threshold and member count,
+// but additionally supports the ParticipantGuardian and ParticipantContractual flags.
+// Returns an error if unsupported flags are used or if the ParticipantMultisig flag is used.
+//
+// [tag:1][{systemic}{reserved:4}{contractual}{guardian}{multisig}][metadata:2][fingerprint:24][variant:4]
+func GenerateParticipantIDv1(fingerprint [24]byte, variant uint32, flags ...Flag) (ParticipantID, error) {
+	// Create the metadata buffer
+	// [tag][flags][metadata]
+	metadata := make([]byte, 4)
+	// Attach the tag for ParticipantID v1
+	metadata[0] = byte(TagParticipantV1)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by ParticipantID v1
+		if !flag.Supports(TagParticipantV1) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Multisig participants require threshold metadata, see GenerateMultisigParticipantID
+		if flag.name == ParticipantMultisig.name {
+			return Nil, errMultisigFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Order the participant ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return ParticipantID(buffer), nil
+}
+
+// RandomParticipantIDv1 creates a random v1 ParticipantID
+// with a random fingerprint, variant ID and flags.
+//   - There is a 50% chance that the ParticipantGuardian flag will be set.
+//   - There is a 50% chance that the ParticipantContractual flag will be set.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomParticipantIDv1() ParticipantID {
+	flags := make([]Flag, 0, 2)
+
+	if rand.Int64() > 0 {
+		flags = append(flags, ParticipantGuardian)
+	}
+
+	if rand.Int64() > 0 {
+		flags = append(flags, ParticipantContractual)
+	}
+
+	// Safe to ignore error as the flags are supported
+	participant, _ := GenerateParticipantIDv1(RandomFingerprint(), rand.Uint32(), flags...)
+
+	return participant
+}
+
+// GenerateParticipantIDv1Timed creates a new ParticipantID for v1 with a time-sortable variant for the given
+// time (see TimeVariant), so that the participants created under the same account sort chronologically.
+// Returns an error if the time is outside the range of time variants or if unsupported flags are used.
+func GenerateParticipantIDv1Timed(fingerprint [24]byte, t time.Time, flags ...Flag) (ParticipantID, error) {
+	variant, err := TimeVariant(t)
+	if err != nil {
+		return Nil, err
+	}
+
+	return GenerateParticipantIDv1(fingerprint, variant, flags...)
+}
+
+// GenerateMultisigParticipantID creates a new v0 ParticipantID for an m-of-n multisig participant.
+// The fingerprint is derived from the hash of the sorted member set, which makes it independent
+// of the order in which the members are provided. The threshold (m) and member count (n) are
+// encoded in the metadata and the ParticipantMultisig flag is set.
+//
+// Returns an error if there are no members, more than 255 members, duplicate or
+// invalid members or if the threshold is not between 1 and the number of members.
+//
+// [tag:1][{systemic}{reserved:6}{multisig}][m:1][n:1][fingerprint:24][variant:4]
+func GenerateMultisigParticipantID(members []ParticipantID, m uint8, variant uint32) (ParticipantID, error) {
+	// Check that the member count fits into the metadata
+	if len(members) == 0 || len(members) > math.MaxUint8 {
+		return Nil, errors.New("invalid members: multisig must have between 1 and 255 members")
+	}
+
+	// Check that the threshold is within the member count
+	if m == 0 || int(m) > len(members) {
+		return Nil, errors.New("invalid threshold: multisig threshold must be between 1 and member count")
+	}
+
+	// Derive the fingerprint from the canonical member set
+	fingerprint, err := membersFingerprint("moi.multisig", "multisig", members)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Generate a ParticipantID without flags, as the flag rules
+	// of the multisig flag can only be checked with its metadata.
+	// Safe to ignore error as no flags are used
+	participant, _ := GenerateParticipantIDv0(fingerprint, variant)
+
+	// Set the multisig flag and encode the threshold and member count into the metadata
+	participant[1] = setFlag(participant[1], ParticipantMultisig.index, true)
+	participant[2], participant[3] = m, uint8(len(members))
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(participant)); err != nil {
+		return Nil, err
+	}
+
+	return participant, nil
+}
+
+// membersFingerprint derives a fingerprint from the hash of the given domain and the set of members.
+// The members are sorted in ascending byte order before hashing, which makes the fingerprint
+// independent of the order in which they are provided. Returns an error if any of the members
+// is invalid or if there are duplicate members, which are described with the given name.
+func membersFingerprint(domain, name string, members []ParticipantID) ([24]byte, error) {
+	// Sort a copy of the members to obtain a canonical member set
+	sorted := slices.Clone(members)
+	slices.SortFunc(sorted, ParticipantID.Compare)
+
+	parts := make([][]byte, 0, len(sorted))
+
+	for idx, member := range sorted {
+		// Check that the member is a valid participant
+		if err := member.Validate(); err != nil {
+			return [24]byte{}, fmt.Errorf("invalid member: %w", err)
+		}
+
+		// Check that the member is not a duplicate (duplicates are adjacent after sorting)
+		if idx > 0 && member == sorted[idx-1] {
+			return [24]byte{}, fmt.Errorf("invalid members: duplicate %v member", name)
+		}
+
+		parts = append(parts, member.Bytes())
+	}
+
+	return hashFingerprint(domain, parts...), nil
+}
@@ -291,3 +291,187 @@ func TestParticipantID_Generation(t *testing.T) {
 		})
 	})
 }
+
+func TestParticipantID_Multisig(t *testing.T) {
+	members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0(), RandomParticipantIDv0()}
+
+	t.Run("Generate", func(t *testing.T) {
+		participantID, err := GenerateMultisigParticipantID(members, 2, 7)
+		require.NoError(t, err)
+		require.NoError(t, participantID.Validate())
+
+		assert.Equal(t, TagParticipantV0, participantID.Tag())
+		assert.True(t, participantID.Flag(ParticipantMultisig))
+		assert.Equal(t, uint8(2), participantID.Threshold())
+		assert.Equal(t, uint8(3), participantID.MemberCount())
+		assert.Equal(t, uint32(7), participantID.Variant())
+
+		// Member order must not affect the generated identifier
+		reordered, err := GenerateMultisigParticipantID([]ParticipantID{members[2], members[0], members[1]}, 2, 7)
+		require.NoError(t, err)
+		assert.Equal(t, participantID, reordered)
+
+		// Threshold is part of the metadata but not the fingerprint
+		other, err := GenerateMultisigParticipantID(members, 3, 7)
+		require.NoError(t, err)
+		assert.NotEqual(t, participantID, other)
+		assert.Equal(t, participantID.Fingerprint(), other.Fingerprint())
+	})
+
+	t.Run("NotMultisig", func(t *testing.T) {
+		participantID := RandomParticipantIDv0()
+
+		assert.Equal(t, uint8(0), participantID.Threshold())
+		assert.Equal(t, uint8(0), participantID.MemberCount())
+	})
+
+	t.Run("InvalidMembers", func(t *testing.T) {
+		_, err := GenerateMultisigParticipantID(nil, 1, 0)
+		require.EqualError(t, err, "invalid members: multisig must have between 1 and 255 members")
+
+		_, err = GenerateMultisigParticipantID(make([]ParticipantID, 256), 1, 0)
+		require.EqualError(t, err, "invalid members: multisig must have between 1 and 255 members")
+
+		_, err = GenerateMultisigParticipantID([]ParticipantID{members[0], members[1], members[0]}, 2, 0)
+		require.EqualError(t, err, "invalid members: duplicate multisig member")
+
+		_, err = GenerateMultisigParticipantID([]ParticipantID{members[0], ParticipantID{byte(TagAssetV0)}}, 1, 0)
+		require.EqualError(t, err, "invalid member: invalid tag: not a participant id")
+	})
+
+	t.Run("InvalidThreshold", func(t *testing.T) {
+		_, err := GenerateMultisigParticipantID(members, 0, 0)
+		require.EqualError(t, err, "invalid threshold: multisig threshold must be between 1 and member count")
+
+		_, err = GenerateMultisigParticipantID(members, 4, 0)
+		require.EqualError(t, err, "invalid threshold: multisig threshold must be between 1 and member count")
+	})
+
+	t.Run("InvalidMetadata", func(t *testing.T) {
+		_, err := NewParticipantID([32]byte{
+			byte(TagParticipantV0), // Tag
+			0b00000001,             // Flags
+			0x03, 0x02,             // Threshold > Member Count
+		})
+		require.EqualError(t, err, "invalid metadata: multisig threshold must be between 1 and member count")
+
+		_, err = NewParticipantID([32]byte{
+			byte(TagParticipantV0), // Tag
+			0b00000001,             // Flags
+			0x00, 0x02,             // Zero Threshold
+		})
+		require.EqualError(t, err, "invalid metadata: multisig threshold must be between 1 and member count")
+
+		// Identifier validation agrees with ParticipantID validation
+		empty := Identifier{byte(TagParticipantV1), 0b00000001}
+		require.ErrorIs(t, ParticipantID(empty).Validate(), errInvalidMultisigMetadata)
+		require.ErrorIs(t, empty.Validate(), errInvalidMultisigMetadata)
+
+		_, err = Parse(empty.Hex())
+		require.ErrorIs(t, err, errInvalidMultisigMetadata)
+	})
+
+	t.Run("PlainGenerators", func(t *testing.T) {
+		// Multisig participants can only be generated with GenerateMultisigParticipantID
+		_, err := GenerateParticipantIDv0(RandomFingerprint(), 0, ParticipantMultisig)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+		require.EqualError(t, err,
+			"unsupported flag: multisig participants must be generated with GenerateMultisigParticipantID")
+
+		_, err = GenerateParticipantIDv1(RandomFingerprint(), 0, ParticipantGuardian, ParticipantMultisig)
+		require.ErrorIs(t, err, ErrUnsupportedFlag)
+	})
+
+	t.Run("v1", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			participantID, err := GenerateParticipantIDv1(fingerprint, 42, Systemic)
+			require.NoError(t, err)
+			require.NoError(t, participantID.Validate())
+
+			assert.Equal(t, TagParticipantV1, participantID.Tag())
+			assert.Equal(t, uint32(42), participantID.Variant())
+			assert.True(t, participantID.Flag(Systemic))
+
+			// Test unsupported flags
+			_, err = GenerateParticipantIDv1(fingerprint, 42, LogicAuxiliary)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("V1Flags", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			participantID, err := GenerateParticipantIDv1(fingerprint, 0, ParticipantGuardian, ParticipantContractual)
+			require.NoError(t, err)
+			require.NoError(t, participantID.Validate())
+
+			assert.Equal(t, byte(0b00000110), participantID.Flags())
+			assert.Equal(t, []Flag{ParticipantGuardian, ParticipantContractual}, participantID.ListFlags())
+
+			// The flags are not supported by v0
+			for _, flag := range []Flag{ParticipantGuardian, ParticipantContractual} {
+				_, err = GenerateParticipantIDv0(fingerprint, 0, flag)
+				assert.Equal(t, err, ErrUnsupportedFlag)
+			}
+
+			assert.ErrorIs(t, ParticipantID{byte(TagParticipantV0), 0b00000010}.Validate(), ErrBadFlags)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			participantID := RandomParticipantIDv1()
+
+			assert.NoError(t, participantID.Validate())
+			assert.Equal(t, TagParticipantV1, participantID.Tag())
+		})
+	})
+}
+
+func TestParticipantID_ListFlags(t *testing.T) {
+	assert.Equal(t, []Flag{Systemic}, must(GenerateParticipantIDv0(RandomFingerprint(), 0, Systemic)).ListFlags())
+	assert.Empty(t, must(GenerateParticipantIDv0(RandomFingerprint(), 0)).ListFlags())
+}
+
+func TestParticipantID_DeriveVariant(t *testing.T) {
+	participant := RandomParticipantIDv0()
+
+	derived, err := participant.DeriveVariant(42, []Flag{Systemic}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(42), derived.Variant())
+	assert.Equal(t, participant.AccountID(), derived.AccountID())
+	assert.True(t, derived.Flag(Systemic))
+
+	// Test that multisig participants retain their threshold metadata
+	members := []ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0(), RandomParticipantIDv0()}
+	multisig := must(GenerateMultisigParticipantID(members, 2, 0))
+
+	derived, err = multisig.DeriveVariant(42, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(2), derived.Threshold())
+	assert.Equal(t, uint8(3), derived.MemberCount())
+
+	// Test that a multisig participant cannot be derived without threshold metadata
+	_, err = participant.DeriveVariant(42, []Flag{ParticipantMultisig}, nil)
+	assert.EqualError(t, err, "invalid metadata: multisig threshold must be between 1 and member count")
+
+	// Test unsupported flags
+	_, err = participant.DeriveVariant(42, []Flag{AssetLogical}, nil)
+	assert.Equal(t, ErrUnsupportedFlag, err)
+}
+
+func TestParticipantID_BinaryMarshal(t *testing.T) {
+	participant := RandomParticipantIDv0()
+
+	encoded, err := participant.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, participant.Bytes(), encoded)
+
+	var decoded ParticipantID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, participant, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: participant id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a participant id",
+	)
+}
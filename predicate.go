@@ -0,0 +1,77 @@
+package identifiers
+
+import (
+	"slices"
+)
+
+// Predicate is a condition on an Identifier, used to filter collections of identifiers with Filter.
+// Predicates are composable with AllOf, AnyOf and Not, which allows analytics and policy
+// code to declare the identifiers it is interested in, such as systemic assets of a standard.
+type Predicate func(id Identifier) bool
+
+// HasFlag returns a Predicate that matches identifiers that have the given Flag set.
+// Identifiers whose tag does not support the flag are never matched.
+func HasFlag(flag Flag) Predicate {
+	return func(id Identifier) bool { return id.FlagSet().Has(flag) }
+}
+
+// KindIs returns a Predicate that matches identifiers of any of the given kinds
+func KindIs(kinds ...IdentifierKind) Predicate {
+	return func(id Identifier) bool { return slices.Contains(kinds, id.Tag().Kind()) }
+}
+
+// VersionIs returns a Predicate that matches identifiers of any of the given versions
+func VersionIs(versions ...uint8) Predicate {
+	return func(id Identifier) bool { return slices.Contains(versions, id.Tag().Version()) }
+}
+
+// StandardIs returns a Predicate that matches asset identifiers with the given standard.
+// Identifiers that are not asset identifiers are never matched.
+func StandardIs(standard uint16) Predicate {
+	return func(id Identifier) bool {
+		return id.Tag().Kind() == KindAsset && AssetID(id).Standard() == standard
+	}
+}
+
+// AllOf returns a Predicate that matches identifiers that match all the given predicates.
+// It matches all identifiers if no predicates are given.
+func AllOf(preds ...Predicate) Predicate {
+	return func(id Identifier) bool {
+		for _, pred := range preds {
+			if !pred(id) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// AnyOf returns a Predicate that matches identifiers that match any of the given predicates.
+// It matches no identifiers if no predicates are given.
+func AnyOf(preds ...Predicate) Predicate {
+	return func(id Identifier) bool {
+		return slices.ContainsFunc(preds, func(pred Predicate) bool { return pred(id) })
+	}
+}
+
+// Not returns a Predicate that matches identifiers that do not match the given predicate
+func Not(pred Predicate) Predicate {
+	return func(id Identifier) bool { return !pred(id) }
+}
+
+// Filter returns the identifiers from the given slice that match all the given predicates,
+// in their original order. The given slice is not modified. All identifiers are returned
+// if no predicates are given.
+func Filter(ids []Identifier, preds ...Predicate) []Identifier {
+	match := AllOf(preds...)
+	filtered := make([]Identifier, 0, len(ids))
+
+	for _, id := range ids {
+		if match(id) {
+			filtered = append(filtered, id)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,53 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	erc20 := must(GenerateAssetIDv0(RandomFingerprint(), 0, 20, Systemic)).AsIdentifier()
+	mas0 := must(GenerateAssetIDv1(RandomFingerprint(), 0, 0, 0, AssetStateful)).AsIdentifier()
+	logic := must(GenerateLogicIDv0(RandomFingerprint(), 0, 0, Systemic)).AsIdentifier()
+	participant := RandomParticipantIDv1().AsIdentifier()
+
+	ids := []Identifier{erc20, mas0, logic, participant}
+
+	tests := []struct {
+		name     string
+		preds    []Predicate
+		expected []Identifier
+	}{
+		{"NoPredicates", nil, ids},
+		{"HasFlag", []Predicate{HasFlag(Systemic)}, []Identifier{erc20, logic}},
+		{"HasFlag_Unsupported", []Predicate{HasFlag(AssetStateful)}, []Identifier{mas0}},
+		{"KindIs", []Predicate{KindIs(KindAsset, KindParticipant)}, []Identifier{erc20, mas0, participant}},
+		{"VersionIs", []Predicate{VersionIs(1)}, []Identifier{mas0, participant}},
+		{"StandardIs", []Predicate{StandardIs(20)}, []Identifier{erc20}},
+		{"StandardIs_Zero", []Predicate{StandardIs(0)}, []Identifier{mas0}},
+		{"Multiple", []Predicate{KindIs(KindAsset), HasFlag(Systemic)}, []Identifier{erc20}},
+		{"AllOf", []Predicate{AllOf(KindIs(KindLogic), HasFlag(Systemic))}, []Identifier{logic}},
+		{"AllOf_Empty", []Predicate{AllOf()}, ids},
+		{"AnyOf", []Predicate{AnyOf(StandardIs(0), KindIs(KindLogic))}, []Identifier{mas0, logic}},
+		{"AnyOf_Empty", []Predicate{AnyOf()}, []Identifier{}},
+		{"Not", []Predicate{Not(KindIs(KindAsset))}, []Identifier{logic, participant}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Filter(ids, test.preds...))
+		})
+	}
+
+	// The original slice is not modified
+	assert.Equal(t, []Identifier{erc20, mas0, logic, participant}, ids)
+
+	t.Run("StandardIs_V1", func(t *testing.T) {
+		// The standard of v1 assets is encoded in the second metadata byte, after the dimension
+		dimensional := must(GenerateAssetIDv1(RandomFingerprint(), 0, 20, 3)).AsIdentifier()
+
+		assert.Equal(t, []Identifier{dimensional}, Filter([]Identifier{dimensional}, StandardIs(20)))
+		assert.Empty(t, Filter([]Identifier{dimensional}, StandardIs(0x0314)))
+	})
+}
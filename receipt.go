@@ -0,0 +1,286 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// ReceiptID is a unique identifier for an execution receipt in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the receipt identifier.
+//   - Flags: The second byte contains flags for the receipt identifier.
+//   - Metadata: As of v0, ReceiptID has no metadata.
+//
+// Like all identifiers, the ReceiptID also contains a Fingerprint and a Variant ID.
+// The fingerprint of a ReceiptID is that of the InteractionID it was produced for,
+// while the variant ID contains the index of the receipt within that interaction.
+// Flags of a ReceiptID are specific to a version and are invalid if set in an unsupported version.
+type ReceiptID [32]byte
+
+// NewReceiptID creates a new ReceiptID from the 32-byte value.
+// It returns an error if the given data is not a valid ReceiptID.
+func NewReceiptID(data [32]byte) (ReceiptID, error) {
+	// Convert the data into a ReceiptID
+	receiptID := ReceiptID(data)
+	// Validate the ReceiptID
+	if err := receiptID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return receiptID, nil
+}
+
+// NewReceiptIDFromBytes creates a new ReceiptID from the given byte slice.
+// The given value must have a length of 32 and validate into a ReceiptID.
+func NewReceiptIDFromBytes(data []byte) (ReceiptID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: receipt id must be 32 bytes")
+	}
+
+	return NewReceiptID([32]byte(data))
+}
+
+// NewReceiptIDFromHex creates a new ReceiptID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a ReceiptID.
+func NewReceiptIDFromHex(data string) (ReceiptID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindReceipt {
+		return ReceiptID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new ReceiptID from the decoded value
+	// Length check is performed in NewReceiptIDFromBytes
+	converted, err := NewReceiptIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustReceiptID is an enforced version of NewReceiptID.
+// Panics if an error occurs. Use with caution.
+func MustReceiptID(data [32]byte) ReceiptID { return must(NewReceiptID(data)) }
+
+// MustReceiptIDFromBytes is an enforced version of NewReceiptIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustReceiptIDFromBytes(data []byte) ReceiptID { return must(NewReceiptIDFromBytes(data)) }
+
+// MustReceiptIDFromHex is an enforced version of NewReceiptIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustReceiptIDFromHex(data string) ReceiptID { return must(NewReceiptIDFromHex(data)) }
+
+// Bytes returns the ReceiptID as a []byte
+func (receipt ReceiptID) Bytes() []byte { return receipt[:] }
+
+// String returns the ReceiptID as a hex-encoded string.
+// This is identical to ReceiptID.Hex() but is required for the fmt.Stringer interface
+func (receipt ReceiptID) String() string { return receipt.Hex() }
+
+// Hex returns the ReceiptID as a hex-encoded string with the 0x prefix
+func (receipt ReceiptID) Hex() string {
+	return prefix0xString + hex.EncodeToString(receipt[:])
+}
+
+// IsNil returns if the ReceiptID is nil, i.e., 0x000..000
+func (receipt ReceiptID) IsNil() bool { return receipt == ReceiptID{} }
+
+// AsIdentifier returns the ReceiptID as an Identifier.
+func (receipt ReceiptID) AsIdentifier() Identifier {
+	return Identifier(receipt)
+}
+
+// Tag returns the IdentifierTag for the ReceiptID.
+func (receipt ReceiptID) Tag() IdentifierTag {
+	return IdentifierTag(receipt[0])
+}
+
+// Flags returns the byte of flag bits from the ReceiptID
+func (receipt ReceiptID) Flags() byte {
+	return receipt[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the ReceiptID.
+func (receipt ReceiptID) Fingerprint() [24]byte {
+	return trimFingerprint(receipt)
+}
+
+// AccountID returns the 24-byte account ID from the ReceiptID.
+// This is identical to ReceiptID.Fingerprint()
+func (receipt ReceiptID) AccountID() [24]byte {
+	return trimFingerprint(receipt)
+}
+
+// Variant returns the 32-bit variant ID from the ReceiptID.
+func (receipt ReceiptID) Variant() uint32 {
+	variant := trimVariant(receipt)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the ReceiptID has a non-zero variant ID
+func (receipt ReceiptID) IsVariant() bool {
+	variant := trimVariant(receipt)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Index returns the variant ID of the ReceiptID as the index of the receipt within its interaction,
+// and whether the variant ID of its kind is interpreted as an index (see VariantSemantics).
+func (receipt ReceiptID) Index() (uint32, bool) {
+	return variantAs(receipt.AsIdentifier(), IndexVariant)
+}
+
+// Flag returns if the given Flag is set on the ReceiptID.
+//
+// If the specified flag is not supported by the ReceiptID,
+// it will return False, regardless of the actual flag value.
+func (receipt ReceiptID) Flag(flag Flag) bool {
+	// Check if the flag is supported by ReceiptID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(receipt.Tag()) {
+		return false
+	}
+
+	return getFlag(receipt[1], flag.index)
+}
+
+// Validate returns an error if the ReceiptID is invalid.
+// An error is returned if the ReceiptID has an invalid tag or contains unsupported flags.
+func (receipt ReceiptID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := receipt.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a receipt tag
+	if receipt.Tag().Kind() != KindReceipt {
+		return ErrNotReceiptID
+	}
+
+	// Check that there are no unsupported flags set
+	if (receipt[1] & receipt.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: receipt.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(receipt)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure ReceiptID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*ReceiptID)(nil)
+	_ encoding.TextUnmarshaler   = (*ReceiptID)(nil)
+	_ encoding.BinaryMarshaler   = (*ReceiptID)(nil)
+	_ encoding.BinaryUnmarshaler = (*ReceiptID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for ReceiptID
+func (receipt ReceiptID) MarshalText() ([]byte, error) {
+	return marshal32(receipt)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ReceiptID
+func (receipt *ReceiptID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindReceipt)
+	if err != nil {
+		return err
+	}
+
+	*receipt = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for ReceiptID.
+// The ReceiptID is encoded as its raw 32 bytes.
+func (receipt ReceiptID) MarshalBinary() ([]byte, error) {
+	return receipt.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for ReceiptID.
+// The data must be 32 bytes long and validate into a ReceiptID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (receipt *ReceiptID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*receipt = ReceiptID{}
+		return nil
+	}
+
+	decoded, err := NewReceiptIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*receipt = decoded
+	return nil
+}
+
+// GenerateReceiptIDv0 creates a new ReceiptID for v0 with the given parameters.
+// The fingerprint is inherited from the given InteractionID and the index
+// of the receipt within the interaction is encoded as the variant ID.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:7}][reserved:2][fingerprint:24][index:4]
+func GenerateReceiptIDv0(interaction InteractionID, index uint32, flags ...Flag) (ReceiptID, error) {
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for ReceiptID v0
+	metadata[0] = byte(TagReceiptV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by ReceiptID v0
+		if !flag.Supports(TagReceiptV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Obtain the fingerprint of the interaction
+	fingerprint := interaction.Fingerprint()
+
+	// Order the receipt ID buffer
+	// [metadata][fingerprint][index]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the index into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], index)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return ReceiptID(buffer), nil
+}
+
+// RandomReceiptIDv0 creates a random v0 ReceiptID
+// with a random interaction, index and no flags.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomReceiptIDv0() ReceiptID {
+	// Safe to ignore error as no flags are used
+	receipt, _ := GenerateReceiptIDv0(RandomInteractionIDv0(), rand.Uint32())
+	return receipt
+}
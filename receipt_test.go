@@ -0,0 +1,293 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptID(t *testing.T) {
+	data := [32]byte{
+		byte(TagReceiptV0), // Tag
+		0b10000000,         // Flags
+		0x00, 0x00,         // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test ReceiptID
+	receiptID, err := NewReceiptID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagReceiptV0, receiptID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, receiptID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), receiptID.Variant())
+	// Test IsVariant
+	assert.True(t, receiptID.IsVariant())
+
+	// Test Flags
+	assert.True(t, receiptID.Flag(Systemic))
+	assert.False(t, receiptID.Flag(FileImmutable)) // unsupported flag
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, receiptID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsReceiptID()
+	require.NoError(t, err)
+	require.Equal(t, receiptID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], receiptID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x7080000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, receiptID.String())
+	assert.Equal(t, expectedHex, receiptID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestReceiptID_Constructor(t *testing.T) {
+	t.Run("NewReceiptID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			receiptID, err := NewReceiptID([32]byte{
+				byte(TagReceiptV0), // Tag
+				0b00000000,         // Flags
+				0x00, 0x01,         // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, receiptID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewReceiptID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewReceiptID([32]byte{byte(TagReceiptV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewReceiptID([32]byte{byte(TagInteractionV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a receipt id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewReceiptID([32]byte{
+				byte(TagReceiptV0), // Tag
+				0b11111111,         // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for receipt id")
+		})
+	})
+
+	t.Run("NewReceiptIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewReceiptIDFromBytes([]byte{byte(TagReceiptV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: receipt id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			receiptID, err := NewReceiptIDFromBytes([]byte{
+				byte(TagReceiptV0), // Tag
+				0b00000000,         // Flags
+				0x00, 0x01,         // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, receiptID.Validate())
+			require.Equal(t, ReceiptID{
+				byte(TagReceiptV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, receiptID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewReceiptIDFromBytes([]byte{
+				byte(TagReceiptV0), // Tag
+				0b00000000,         // Flags
+				0x00, 0x01,         // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: receipt id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewReceiptIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			receiptID, err := NewReceiptIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagReceiptV0), // Tag
+				0b00000000,         // Flags
+				0x00, 0x01,         // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, receiptID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			receiptID, err := NewReceiptIDFromHex(hex.EncodeToString([]byte{
+				byte(TagReceiptV0), // Tag
+				0b00000000,         // Flags
+				0x00, 0x01,         // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, receiptID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewReceiptIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewReceiptIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustReceiptID", func(t *testing.T) {
+		t.Run("MustReceiptID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustReceiptID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustReceiptIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustReceiptIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustReceiptIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustReceiptIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestReceiptID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		receiptID := RandomReceiptIDv0()
+
+		encoded, err := json.Marshal(receiptID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+receiptID.Hex()+`"`, string(encoded))
+
+		var decoded ReceiptID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, receiptID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded ReceiptID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded ReceiptID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded ReceiptID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestReceiptID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			interaction := RandomInteractionIDv0()
+			receiptID, err := GenerateReceiptIDv0(interaction, 3, Systemic)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagReceiptV0, receiptID.Tag())
+			assert.Equal(t, interaction.Fingerprint(), receiptID.Fingerprint())
+			assert.Equal(t, uint32(3), receiptID.Variant())
+			assert.True(t, receiptID.Flag(Systemic))
+
+			// Test unsupported flags
+			_, err = GenerateReceiptIDv0(interaction, 3, AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			receiptID := RandomReceiptIDv0()
+
+			assert.NoError(t, receiptID.Validate())
+			assert.Equal(t, TagReceiptV0, receiptID.Tag())
+		})
+	})
+}
+
+func TestReceiptID_BinaryMarshal(t *testing.T) {
+	receipt := RandomReceiptIDv0()
+
+	encoded, err := receipt.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, receipt.Bytes(), encoded)
+
+	var decoded ReceiptID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, receipt, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: receipt id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a receipt id",
+	)
+}
@@ -0,0 +1,335 @@
+package identifiers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// registryLock guards access to the kindNames and customFlags tables, both of which can be modified
+// at runtime with RegisterKind and RegisterFlag. It also serializes all modifications of the
+// registry tables (see registryTables).
+var registryLock sync.RWMutex
+
+// registryTables is an immutable snapshot of the version support, flag support and flag mask tables,
+// which are consulted on every validation and generation. The tables are fixed size arrays indexed by kind
+// and tag, so that lookups are branch-only and do not require any locks.
+//
+// Modifications with RegisterKind, RegisterFlag, RegisterFlagRule, RegisterMetadataValidator and SetMaxVersion
+// are performed on a copy of the current snapshot while holding registryLock, which is then published atomically.
+type registryTables struct {
+	// kinds is a bitmask of the recognized kinds, where bit N is set if kind N is recognized
+	kinds uint16
+	// kindSupport is the maximum supported version of each kind, indexed by kind
+	kindSupport [16]uint8
+	// flagMasks is the mask of unsupported flags for each tag, indexed by tag.
+	// Unrecognized tags have a mask of 0xFF, which never occurs for recognized
+	// tags because the Systemic flag (MSB) is supported by all kinds.
+	flagMasks [256]byte
+	// variantSemantics is the VariantSemantics of each kind, indexed by kind
+	variantSemantics [16]VariantSemantics
+	// flagRules are the flag rules of each kind, indexed by kind
+	flagRules [16][]FlagRule
+	// metadataValidators are the metadata validators of each kind, indexed by kind
+	metadataValidators [16][]MetadataValidator
+	// flagSupport is the minimum supported version of each flag for each kind, indexed by the slot of the
+	// flag (see Flag) and then by kind, with flagUnsupported for kinds that do not support the flag.
+	// It is shared between snapshots, so it must be cloned before it is modified.
+	flagSupport [][16]uint8
+}
+
+// registry is the current snapshot of the registry tables
+var registry atomic.Pointer[registryTables]
+
+func init() {
+	tables := &registryTables{}
+	for tag := range tables.flagMasks {
+		tables.flagMasks[tag] = 0xFF
+	}
+
+	for kind, version := range defaultKindSupport {
+		tables.kinds |= 1 << kind
+		tables.kindSupport[kind] = version
+	}
+
+	for tag, mask := range defaultFlagMasks {
+		tables.flagMasks[tag] = mask
+	}
+
+	for kind, semantics := range defaultVariantSemantics {
+		tables.variantSemantics[kind] = semantics
+	}
+
+	tables.flagSupport = slices.Clone(defaultFlagSupport)
+
+	registry.Store(tables)
+}
+
+// supports returns whether the given kind is recognized
+func (tables *registryTables) supports(kind IdentifierKind) bool {
+	return kind <= 0x0F && tables.kinds&(1<<kind) != 0
+}
+
+// supportsFlag returns whether the given flag is supported by the given tag
+func (tables *registryTables) supportsFlag(flag Flag, tag IdentifierTag) bool {
+	version, ok := tables.flagVersion(flag, tag.Kind())
+
+	return ok && tag.Version() >= version
+}
+
+// flagVersion returns the minimum version of the given kind that supports
+// the given flag and whether the flag is supported by the kind at all
+func (tables *registryTables) flagVersion(flag Flag, kind IdentifierKind) (uint8, bool) {
+	if int(flag.slot) >= len(tables.flagSupport) {
+		return 0, false
+	}
+
+	version := tables.flagSupport[flag.slot][kind]
+
+	return version, version != flagUnsupported
+}
+
+// hasMask returns whether the given tag has a flag mask, i.e. has a known layout
+func (tables *registryTables) hasMask(tag IdentifierTag) bool {
+	return tables.flagMasks[tag] != 0xFF
+}
+
+// validateTag returns an error if the kind of the given tag
+// is not recognized or if its version is not supported
+func (tables *registryTables) validateTag(tag IdentifierTag) error {
+	// Check if the kind is a recognized kind
+	if !tables.supports(tag.Kind()) {
+		return ErrUnsupportedKind
+	}
+
+	// Check if the version is supported for the kind
+	if tag.Version() > tables.kindSupport[tag.Kind()] {
+		return ErrUnsupportedVersion
+	}
+
+	return nil
+}
+
+// updateRegistry applies the given modification to a copy of the current registry tables and publishes
+// the copy if the modification succeeds. Must be called while holding the write lock of registryLock.
+func updateRegistry(modify func(tables *registryTables) error) error {
+	tables := *registry.Load()
+	if err := modify(&tables); err != nil {
+		return err
+	}
+
+	registry.Store(&tables)
+
+	return nil
+}
+
+// KindSpec is the specification of an identifier kind for registration with RegisterKind.
+type KindSpec struct {
+	// Name is the name of the kind, used by IdentifierKind.String and ParseIdentifierKind.
+	// It is optional, but must be unique among all kinds if specified.
+	Name string
+	// MaxVersion is the maximum supported version for the kind.
+	MaxVersion uint8
+	// FlagMasks are the masks of unsupported flags for each version of the kind, indexed by version.
+	// It must contain exactly one mask for each version from 0 up to MaxVersion (inclusive).
+	//
+	// A set bit indicates that position is not allowed for the tag,
+	// While an unset bit indicates it is a supported flag for the tag.
+	// The MSB is always unset because the Systemic flag is supported by all kinds.
+	FlagMasks []byte
+	// Variant is the VariantSemantics of the kind, which declares how its variant ID is interpreted.
+	// Defaults to OpaqueVariant, and must be one of the VariantSemantics defined by this package.
+	Variant VariantSemantics
+}
+
+// RegisterKind registers a custom IdentifierKind with the given KindSpec.
+// Once registered, tags of the kind are accepted by IdentifierTag.Validate and Identifier.Validate,
+// and the Systemic flag becomes supported for the kind. Custom kinds are intended to be registered
+// during initialization, before any identifiers of the kind are validated.
+//
+// Returns an error if the kind does not fit in the 4-bit nibble of a tag, is already registered,
+// if the spec has an invalid maximum version or does not have a flag mask for every version,
+// or if the spec has unknown variant semantics.
+func RegisterKind(kind IdentifierKind, spec KindSpec) error {
+	// Check that the kind fits into the upper nibble of a tag
+	if kind > 0x0F {
+		return fmt.Errorf("%w: kind must be between 0 and 15", ErrInvalidKindSpec)
+	}
+
+	// Check that the version fits into the lower nibble of a tag
+	if spec.MaxVersion > 0x0F {
+		return fmt.Errorf("%w: max version must be between 0 and 15", ErrInvalidKindSpec)
+	}
+
+	// Check that there is a mask for each supported version
+	if len(spec.FlagMasks) != int(spec.MaxVersion)+1 {
+		return fmt.Errorf("%w: expected %d flag masks", ErrInvalidKindSpec, spec.MaxVersion+1)
+	}
+
+	// Check that the variant semantics are known
+	if _, ok := variantSemanticsNames[spec.Variant]; !ok {
+		return fmt.Errorf("%w: unknown variant semantics %d", ErrInvalidKindSpec, uint8(spec.Variant))
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if registry.Load().supports(kind) {
+		return fmt.Errorf("%w: %d", ErrKindExists, uint8(kind))
+	}
+
+	if spec.Name != "" {
+		// Check that the name is not used by another kind
+		for _, name := range kindNames {
+			if strings.EqualFold(name, spec.Name) {
+				return fmt.Errorf("%w: name %q is already in use", ErrInvalidKindSpec, spec.Name)
+			}
+		}
+
+		kindNames[kind] = spec.Name
+	}
+
+	_ = updateRegistry(func(tables *registryTables) error {
+		tables.kinds |= 1 << kind
+		tables.kindSupport[kind] = spec.MaxVersion
+		tables.variantSemantics[kind] = spec.Variant
+
+		for version, mask := range spec.FlagMasks {
+			// Clear the MSB of the mask to allow the Systemic flag
+			tables.flagMasks[IdentifierTag((kind<<4)|IdentifierKind(version))] = mask &^ (1 << Systemic.index)
+		}
+
+		// The Systemic flag is supported from v0 of all kinds
+		tables.flagSupport = slices.Clone(tables.flagSupport)
+		tables.flagSupport[Systemic.slot][kind] = 0
+
+		return nil
+	})
+
+	return nil
+}
+
+// MaxVersion returns the maximum supported version for the given
+// kind and whether the kind is recognized. It is safe for concurrent use.
+func MaxVersion(kind IdentifierKind) (uint8, bool) {
+	current := registry.Load()
+	if !current.supports(kind) {
+		return 0, false
+	}
+
+	return current.kindSupport[kind], true
+}
+
+// SetMaxVersion sets the maximum supported version for the given kind. It is safe for concurrent use.
+// Tags with a version greater than the maximum version are rejected by IdentifierTag.Validate,
+// which allows the acceptance of newer versions to be gated (and later enabled) at runtime.
+//
+// The version can only be set to a version known to this package (or registered with RegisterKind).
+// Returns ErrUnsupportedKind if the kind is not recognized and ErrUnsupportedVersion if the version is not known.
+func SetMaxVersion(kind IdentifierKind, version uint8) error {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	return updateRegistry(func(tables *registryTables) error {
+		if !tables.supports(kind) {
+			return ErrUnsupportedKind
+		}
+
+		// Check that the version fits into the lower nibble of a tag
+		if version > 0x0F {
+			return ErrUnsupportedVersion
+		}
+
+		// Only versions with a known layout (which have a flag mask) can be supported
+		if !tables.hasMask(IdentifierTag((kind << 4) | IdentifierKind(version))) {
+			return ErrUnsupportedVersion
+		}
+
+		tables.kindSupport[kind] = version
+
+		return nil
+	})
+}
+
+// customFlags is a map of flag names to the custom flags registered with RegisterFlag.
+var customFlags = map[string]Flag{}
+
+// RegisterFlag registers a custom Flag with the given name at the given bit index of a kind,
+// supported from the given minimum version of the kind. Once registered, the flag bit is unmasked
+// for every known tag of the kind from the minimum version onwards, allowing the flag to be used
+// with generators and accepted by Identifier.Validate. Like custom kinds, custom flags are
+// intended to be registered during initialization.
+//
+// The name of a flag is case-insensitive, and is rendered in lowercase by Flag.Name.
+//
+// Returns an error if the name is empty or already in use by any flag, if the index is not between 0 and 7,
+// if the kind is not recognized or has no flag mask for the minimum version, or if the bit index
+// is already in use by another flag for any of those tags.
+func RegisterFlag(name string, kind IdentifierKind, index, minVersion uint8) (Flag, error) {
+	if name == "" {
+		return Flag{}, fmt.Errorf("%w: name must not be empty", ErrInvalidFlagSpec)
+	}
+
+	if index > 7 {
+		return Flag{}, fmt.Errorf("%w: index must be between 0 and 7", ErrInvalidFlagSpec)
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, exists := lookupFlag(name); exists {
+		return Flag{}, fmt.Errorf("%w: %q", ErrFlagExists, name)
+	}
+
+	var slot uint16
+
+	err := updateRegistry(func(tables *registryTables) error {
+		if !tables.supports(kind) {
+			return ErrUnsupportedKind
+		}
+
+		// Collect the tags of the kind from the minimum version onwards
+		tags := make([]IdentifierTag, 0, 16)
+
+		for version := minVersion; version <= 0x0F; version++ {
+			tag := IdentifierTag((kind << 4) | IdentifierKind(version))
+			if tables.hasMask(tag) {
+				tags = append(tags, tag)
+			}
+		}
+
+		if len(tags) == 0 || tags[0].Version() != minVersion {
+			return fmt.Errorf("%w: no flag mask for version %d", ErrUnsupportedVersion, minVersion)
+		}
+
+		// Check that the bit is not in use by another flag for any of the tags
+		for _, tag := range tags {
+			if !getFlag(tables.flagMasks[tag], index) {
+				return fmt.Errorf("%w: bit %d is already in use for version %d", ErrFlagExists, index, tag.Version())
+			}
+		}
+
+		for _, tag := range tags {
+			tables.flagMasks[tag] = setFlag(tables.flagMasks[tag], index, false)
+		}
+
+		// Add the support of the flag to the flag support table in a new slot
+		support := unsupportedKinds()
+		support[kind] = minVersion
+
+		slot = uint16(len(tables.flagSupport))
+		tables.flagSupport = append(slices.Clip(tables.flagSupport), support)
+
+		return nil
+	})
+	if err != nil {
+		return Flag{}, err
+	}
+
+	flag := Flag{name: strings.ToLower(name), index: index, slot: slot}
+	customFlags[flag.name] = flag
+
+	return flag, nil
+}
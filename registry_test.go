@@ -0,0 +1,239 @@
+package identifiers
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unregisterKind removes a custom kind registered with RegisterKind.
+// For use in tests to restore the registry to its original state.
+func unregisterKind(t *testing.T, kind IdentifierKind) {
+	t.Helper()
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	_ = updateRegistry(func(tables *registryTables) error {
+		for version := uint8(0); version <= 0x0F; version++ {
+			tables.flagMasks[IdentifierTag((kind<<4)|IdentifierKind(version))] = 0xFF
+		}
+
+		tables.kinds &^= 1 << kind
+		tables.kindSupport[kind] = 0
+		tables.variantSemantics[kind] = OpaqueVariant
+
+		tables.flagSupport = slices.Clone(tables.flagSupport)
+		tables.flagSupport[Systemic.slot][kind] = flagUnsupported
+
+		return nil
+	})
+
+	delete(kindNames, kind)
+}
+
+func TestRegisterKind(t *testing.T) {
+	const kindCustom = IdentifierKind(0x0E)
+
+	require.NoError(t, RegisterKind(kindCustom, KindSpec{
+		Name:       "custom",
+		MaxVersion: 1,
+		FlagMasks:  []byte{0b11111110, 0b11111100},
+		Variant:    SeriesVariant,
+	}))
+	t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+	tagV0 := IdentifierTag(kindCustom << 4)
+	tagV1 := IdentifierTag(kindCustom<<4 | 1)
+
+	t.Run("Tag", func(t *testing.T) {
+		assert.NoError(t, tagV0.Validate())
+		assert.NoError(t, tagV1.Validate())
+		assert.Equal(t, ErrUnsupportedVersion, IdentifierTag(kindCustom<<4|2).Validate())
+	})
+
+	t.Run("Flags", func(t *testing.T) {
+		// Systemic flag is supported for custom kinds (even if the mask disallows it)
+		assert.True(t, Systemic.Supports(tagV0))
+		assert.Equal(t, byte(0b01111110), tagV0.FlagMask())
+		assert.Equal(t, byte(0b01111100), tagV1.FlagMask())
+	})
+
+	t.Run("Identifier", func(t *testing.T) {
+		assert.NoError(t, Identifier{byte(tagV0), 0b10000001}.Validate())
+		assert.NoError(t, Identifier{byte(tagV1), 0b00000011}.Validate())
+
+		assert.EqualError(t,
+			Identifier{byte(tagV0), 0b00000010}.Validate(),
+			"invalid flags: unsupported flags for identifier",
+		)
+	})
+
+	t.Run("Name", func(t *testing.T) {
+		assert.Equal(t, "custom", kindCustom.String())
+		assert.Equal(t, kindCustom, must(ParseIdentifierKind("custom")))
+		assert.Contains(t, AllKinds(), kindCustom)
+	})
+
+	t.Run("VariantSemantics", func(t *testing.T) {
+		assert.Equal(t, SeriesVariant, kindCustom.VariantSemantics())
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := RegisterKind(kindCustom, KindSpec{FlagMasks: []byte{0}})
+		require.EqualError(t, err, "kind already registered: 14")
+
+		err = RegisterKind(KindAsset, KindSpec{FlagMasks: []byte{0}})
+		require.ErrorIs(t, err, ErrKindExists)
+	})
+
+	t.Run("InvalidSpec", func(t *testing.T) {
+		err := RegisterKind(0x10, KindSpec{FlagMasks: []byte{0}})
+		require.EqualError(t, err, "invalid kind spec: kind must be between 0 and 15")
+
+		err = RegisterKind(0x0D, KindSpec{MaxVersion: 16})
+		require.EqualError(t, err, "invalid kind spec: max version must be between 0 and 15")
+
+		err = RegisterKind(0x0D, KindSpec{MaxVersion: 1, FlagMasks: []byte{0}})
+		require.EqualError(t, err, "invalid kind spec: expected 2 flag masks")
+
+		err = RegisterKind(0x0D, KindSpec{Name: "Asset", FlagMasks: []byte{0}})
+		require.EqualError(t, err, `invalid kind spec: name "Asset" is already in use`)
+
+		err = RegisterKind(0x0D, KindSpec{FlagMasks: []byte{0}, Variant: 16})
+		require.EqualError(t, err, "invalid kind spec: unknown variant semantics 16")
+	})
+}
+
+func TestRegisterFlag(t *testing.T) {
+	const kindCustom = IdentifierKind(0x0E)
+
+	require.NoError(t, RegisterKind(kindCustom, KindSpec{
+		MaxVersion: 1,
+		FlagMasks:  []byte{0b01111110, 0b01111110},
+	}))
+	t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+	tagV0 := IdentifierTag(kindCustom << 4)
+	tagV1 := IdentifierTag(kindCustom<<4 | 1)
+
+	sealed, err := RegisterFlag("custom-sealed", kindCustom, 3, 1)
+	require.NoError(t, err)
+	t.Cleanup(func() { delete(customFlags, "custom-sealed") })
+
+	t.Run("Support", func(t *testing.T) {
+		assert.False(t, sealed.Supports(tagV0))
+		assert.True(t, sealed.Supports(tagV1))
+		assert.False(t, sealed.Supports(TagAssetV1))
+	})
+
+	t.Run("Masks", func(t *testing.T) {
+		assert.Equal(t, byte(0b01111110), tagV0.FlagMask())
+		assert.Equal(t, byte(0b01110110), tagV1.FlagMask())
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, Identifier{byte(tagV1), 0b00001001}.Validate())
+		assert.EqualError(t,
+			Identifier{byte(tagV0), 0b00001000}.Validate(),
+			"invalid flags: unsupported flags for identifier",
+		)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := RegisterFlag("", kindCustom, 4, 0)
+		require.EqualError(t, err, "invalid flag spec: name must not be empty")
+
+		_, err = RegisterFlag("custom-wide", kindCustom, 8, 0)
+		require.EqualError(t, err, "invalid flag spec: index must be between 0 and 7")
+
+		_, err = RegisterFlag("Custom-Sealed", kindCustom, 4, 0)
+		require.EqualError(t, err, `flag already registered: "Custom-Sealed"`)
+
+		_, err = RegisterFlag("Systemic", kindCustom, 4, 0)
+		require.EqualError(t, err, `flag already registered: "Systemic"`)
+
+		_, err = RegisterFlag("custom-unknown", 0x0D, 4, 0)
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+
+		_, err = RegisterFlag("custom-future", kindCustom, 4, 2)
+		require.EqualError(t, err, "unsupported tag version: no flag mask for version 2")
+
+		// Bits in use by built-in flags, the Systemic flag or other custom flags cannot be registered
+		_, err = RegisterFlag("asset-custom", KindAsset, 1, 0)
+		require.EqualError(t, err, "flag already registered: bit 1 is already in use for version 0")
+
+		_, err = RegisterFlag("custom-systemic", kindCustom, 7, 0)
+		require.EqualError(t, err, "flag already registered: bit 7 is already in use for version 0")
+
+		_, err = RegisterFlag("custom-overlap", kindCustom, 3, 0)
+		require.EqualError(t, err, "flag already registered: bit 3 is already in use for version 1")
+	})
+}
+
+func TestMaxVersion(t *testing.T) {
+	version, ok := MaxVersion(KindAsset)
+	require.True(t, ok)
+	require.Equal(t, uint8(1), version)
+
+	version, ok = MaxVersion(KindInteraction)
+	require.True(t, ok)
+	require.Equal(t, uint8(0), version)
+
+	_, ok = MaxVersion(IdentifierKind(0x0F))
+	require.False(t, ok)
+}
+
+func TestSetMaxVersion(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, SetMaxVersion(KindAsset, 1)) })
+
+	// Gate the acceptance of v1 asset identifiers
+	require.NoError(t, SetMaxVersion(KindAsset, 0))
+
+	version, _ := MaxVersion(KindAsset)
+	assert.Equal(t, uint8(0), version)
+	assert.NoError(t, TagAssetV0.Validate())
+	assert.Equal(t, ErrUnsupportedVersion, TagAssetV1.Validate())
+
+	_, err := NewAssetID(RandomAssetIDv1())
+	require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+	// Enable the acceptance of v1 asset identifiers
+	require.NoError(t, SetMaxVersion(KindAsset, 1))
+	assert.NoError(t, TagAssetV1.Validate())
+
+	t.Run("Unknown", func(t *testing.T) {
+		require.Equal(t, ErrUnsupportedKind, SetMaxVersion(IdentifierKind(0x0F), 0))
+		require.Equal(t, ErrUnsupportedVersion, SetMaxVersion(KindAsset, 2))
+		require.Equal(t, ErrUnsupportedVersion, SetMaxVersion(KindAsset, 0x10))
+		require.Equal(t, ErrUnsupportedVersion, SetMaxVersion(KindInteraction, 1))
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		id := RandomAssetIDv0().AsIdentifier()
+
+		var group sync.WaitGroup
+
+		// Validate identifiers while the registry is being modified (checked with -race)
+		for worker := 0; worker < 4; worker++ {
+			group.Add(1)
+
+			go func() {
+				defer group.Done()
+
+				for i := 0; i < 1000; i++ {
+					assert.NoError(t, id.Validate())
+				}
+			}()
+		}
+
+		for i := 0; i < 100; i++ {
+			require.NoError(t, SetMaxVersion(KindAsset, uint8(i%2)))
+		}
+
+		group.Wait()
+	})
+}
@@ -0,0 +1,273 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+)
+
+// TesseractID is a unique identifier for a tesseract (block) in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the tesseract identifier.
+//   - Flags: The second byte contains flags for the tesseract identifier.
+//   - Metadata: As of v0, TesseractID has no metadata.
+//
+// Like all identifiers, the TesseractID also contains a Fingerprint and a Variant ID.
+// Flags of a TesseractID are specific to a version and are invalid if set in an unsupported version.
+type TesseractID [32]byte
+
+// NewTesseractID creates a new TesseractID from the 32-byte value.
+// It returns an error if the given data is not a valid TesseractID.
+func NewTesseractID(data [32]byte) (TesseractID, error) {
+	// Convert the data into a TesseractID
+	tesseractID := TesseractID(data)
+	// Validate the TesseractID
+	if err := tesseractID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return tesseractID, nil
+}
+
+// NewTesseractIDFromBytes creates a new TesseractID from the given byte slice.
+// The given value must have a length of 32 and validate into a TesseractID.
+func NewTesseractIDFromBytes(data []byte) (TesseractID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: tesseract id must be 32 bytes")
+	}
+
+	return NewTesseractID([32]byte(data))
+}
+
+// NewTesseractIDFromHex creates a new TesseractID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a TesseractID.
+func NewTesseractIDFromHex(data string) (TesseractID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindTesseract {
+		return TesseractID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new TesseractID from the decoded value
+	// Length check is performed in NewTesseractIDFromBytes
+	converted, err := NewTesseractIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustTesseractID is an enforced version of NewTesseractID.
+// Panics if an error occurs. Use with caution.
+func MustTesseractID(data [32]byte) TesseractID { return must(NewTesseractID(data)) }
+
+// MustTesseractIDFromBytes is an enforced version of NewTesseractIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustTesseractIDFromBytes(data []byte) TesseractID { return must(NewTesseractIDFromBytes(data)) }
+
+// MustTesseractIDFromHex is an enforced version of NewTesseractIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustTesseractIDFromHex(data string) TesseractID { return must(NewTesseractIDFromHex(data)) }
+
+// Bytes returns the TesseractID as a []byte
+func (tesseract TesseractID) Bytes() []byte { return tesseract[:] }
+
+// String returns the TesseractID as a hex-encoded string.
+// This is identical to TesseractID.Hex() but is required for the fmt.Stringer interface
+func (tesseract TesseractID) String() string { return tesseract.Hex() }
+
+// Hex returns the TesseractID as a hex-encoded string with the 0x prefix
+func (tesseract TesseractID) Hex() string {
+	return prefix0xString + hex.EncodeToString(tesseract[:])
+}
+
+// IsNil returns if the TesseractID is nil, i.e., 0x000..000
+func (tesseract TesseractID) IsNil() bool { return tesseract == TesseractID{} }
+
+// AsIdentifier returns the TesseractID as an Identifier.
+func (tesseract TesseractID) AsIdentifier() Identifier {
+	return Identifier(tesseract)
+}
+
+// Tag returns the IdentifierTag for the TesseractID.
+func (tesseract TesseractID) Tag() IdentifierTag {
+	return IdentifierTag(tesseract[0])
+}
+
+// Flags returns the byte of flag bits from the TesseractID
+func (tesseract TesseractID) Flags() byte {
+	return tesseract[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the TesseractID.
+func (tesseract TesseractID) Fingerprint() [24]byte {
+	return trimFingerprint(tesseract)
+}
+
+// AccountID returns the 24-byte account ID from the TesseractID.
+// This is identical to TesseractID.Fingerprint()
+func (tesseract TesseractID) AccountID() [24]byte {
+	return trimFingerprint(tesseract)
+}
+
+// Variant returns the 32-bit variant ID from the TesseractID.
+func (tesseract TesseractID) Variant() uint32 {
+	variant := trimVariant(tesseract)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the TesseractID has a non-zero variant ID
+func (tesseract TesseractID) IsVariant() bool {
+	variant := trimVariant(tesseract)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the TesseractID.
+//
+// If the specified flag is not supported by the TesseractID,
+// it will return False, regardless of the actual flag value.
+func (tesseract TesseractID) Flag(flag Flag) bool {
+	// Check if the flag is supported by TesseractID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(tesseract.Tag()) {
+		return false
+	}
+
+	return getFlag(tesseract[1], flag.index)
+}
+
+// Validate returns an error if the TesseractID is invalid.
+// An error is returned if the TesseractID has an invalid tag or contains unsupported flags.
+func (tesseract TesseractID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := tesseract.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a tesseract tag
+	if tesseract.Tag().Kind() != KindTesseract {
+		return ErrNotTesseractID
+	}
+
+	// Check that there are no unsupported flags set
+	if (tesseract[1] & tesseract.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: tesseract.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(tesseract)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure TesseractID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*TesseractID)(nil)
+	_ encoding.TextUnmarshaler   = (*TesseractID)(nil)
+	_ encoding.BinaryMarshaler   = (*TesseractID)(nil)
+	_ encoding.BinaryUnmarshaler = (*TesseractID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for TesseractID
+func (tesseract TesseractID) MarshalText() ([]byte, error) {
+	return marshal32(tesseract)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for TesseractID
+func (tesseract *TesseractID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindTesseract)
+	if err != nil {
+		return err
+	}
+
+	*tesseract = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for TesseractID.
+// The TesseractID is encoded as its raw 32 bytes.
+func (tesseract TesseractID) MarshalBinary() ([]byte, error) {
+	return tesseract.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for TesseractID.
+// The data must be 32 bytes long and validate into a TesseractID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (tesseract *TesseractID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*tesseract = TesseractID{}
+		return nil
+	}
+
+	decoded, err := NewTesseractIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*tesseract = decoded
+	return nil
+}
+
+// GenerateTesseractIDv0 creates a new TesseractID for v0 with the given parameters.
+// Returns an error if unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:7}][reserved:2][fingerprint:24][variant:4]
+func GenerateTesseractIDv0(fingerprint [24]byte, variant uint32, flags ...Flag) (TesseractID, error) {
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for TesseractID v0
+	metadata[0] = byte(TagTesseractV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by TesseractID v0
+		if !flag.Supports(TagTesseractV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Order the tesseract ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant and encode the value into it
+	buffer = append(buffer, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(buffer[28:], variant)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return TesseractID(buffer), nil
+}
+
+// RandomTesseractIDv0 creates a random v0 TesseractID
+// with a random fingerprint, variant ID and no flags.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomTesseractIDv0() TesseractID {
+	// Safe to ignore error as no flags are used
+	tesseract, _ := GenerateTesseractIDv0(RandomFingerprint(), rand.Uint32())
+	return tesseract
+}
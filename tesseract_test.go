@@ -0,0 +1,293 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTesseractID(t *testing.T) {
+	data := [32]byte{
+		byte(TagTesseractV0), // Tag
+		0b10000000,           // Flags
+		0x00, 0x00,           // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test TesseractID
+	tesseractID, err := NewTesseractID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagTesseractV0, tesseractID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, tesseractID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), tesseractID.Variant())
+	// Test IsVariant
+	assert.True(t, tesseractID.IsVariant())
+
+	// Test Flags
+	assert.True(t, tesseractID.Flag(Systemic))
+	assert.False(t, tesseractID.Flag(LogicIntrinsic)) // unsupported flag
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, tesseractID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsTesseractID()
+	require.NoError(t, err)
+	require.Equal(t, tesseractID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], tesseractID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x4080000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, tesseractID.String())
+	assert.Equal(t, expectedHex, tesseractID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestTesseractID_Constructor(t *testing.T) {
+	t.Run("NewTesseractID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			tesseractID, err := NewTesseractID([32]byte{
+				byte(TagTesseractV0), // Tag
+				0b00000000,           // Flags
+				0x00, 0x01,           // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, tesseractID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewTesseractID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewTesseractID([32]byte{byte(TagTesseractV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewTesseractID([32]byte{byte(TagLogicV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a tesseract id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewTesseractID([32]byte{
+				byte(TagTesseractV0), // Tag
+				0b11111111,           // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for tesseract id")
+		})
+	})
+
+	t.Run("NewTesseractIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewTesseractIDFromBytes([]byte{byte(TagTesseractV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: tesseract id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			tesseractID, err := NewTesseractIDFromBytes([]byte{
+				byte(TagTesseractV0), // Tag
+				0b00000000,           // Flags
+				0x00, 0x01,           // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, tesseractID.Validate())
+			require.Equal(t, TesseractID{
+				byte(TagTesseractV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, tesseractID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewTesseractIDFromBytes([]byte{
+				byte(TagTesseractV0), // Tag
+				0b00000000,           // Flags
+				0x00, 0x01,           // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: tesseract id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewTesseractIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			tesseractID, err := NewTesseractIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagTesseractV0), // Tag
+				0b00000000,           // Flags
+				0x00, 0x01,           // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, tesseractID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			tesseractID, err := NewTesseractIDFromHex(hex.EncodeToString([]byte{
+				byte(TagTesseractV0), // Tag
+				0b00000000,           // Flags
+				0x00, 0x01,           // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, tesseractID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewTesseractIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewTesseractIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustTesseractID", func(t *testing.T) {
+		t.Run("MustTesseractID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTesseractID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustTesseractIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTesseractIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustTesseractIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTesseractIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestTesseractID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tesseractID := RandomTesseractIDv0()
+
+		encoded, err := json.Marshal(tesseractID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+tesseractID.Hex()+`"`, string(encoded))
+
+		var decoded TesseractID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, tesseractID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded TesseractID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded TesseractID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded TesseractID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestTesseractID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			fingerprint := RandomFingerprint()
+			tesseractID, err := GenerateTesseractIDv0(fingerprint, 42, Systemic)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagTesseractV0, tesseractID.Tag())
+			assert.Equal(t, fingerprint, tesseractID.Fingerprint())
+			assert.Equal(t, uint32(42), tesseractID.Variant())
+			assert.True(t, tesseractID.Flag(Systemic))
+
+			// Test unsupported flags
+			_, err = GenerateTesseractIDv0(fingerprint, 42, AssetStateful)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			tesseractID := RandomTesseractIDv0()
+
+			assert.NoError(t, tesseractID.Validate())
+			assert.Equal(t, TagTesseractV0, tesseractID.Tag())
+		})
+	})
+}
+
+func TestTesseractID_BinaryMarshal(t *testing.T) {
+	tesseract := RandomTesseractIDv0()
+
+	encoded, err := tesseract.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, tesseract.Bytes(), encoded)
+
+	var decoded TesseractID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, tesseract, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: tesseract id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a tesseract id",
+	)
+}
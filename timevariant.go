@@ -0,0 +1,107 @@
+package identifiers
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// timeVariantSequenceBits is the number of sequence bits in the lower bits of a time variant
+	timeVariantSequenceBits = 8
+	// timeVariantMaxSequence is the largest sequence number of a time variant
+	timeVariantMaxSequence = 1<<timeVariantSequenceBits - 1
+	// timeVariantMaxStart is the largest (random) sequence number of the first time variant of a minute
+	timeVariantMaxStart = 1 << (timeVariantSequenceBits - 1)
+	// timeVariantMaxMinutes is the number of minutes that can be encoded in a time variant
+	timeVariantMaxMinutes = 1 << (32 - timeVariantSequenceBits)
+	// timeVariantTrackedMinutes is the number of minutes whose sequences are tracked
+	timeVariantTrackedMinutes = 64
+)
+
+// timeVariants is the process-wide state of the time variants that were generated, which tracks the
+// last sequence number of the most recent minutes so that their time variants are never repeated
+var timeVariants = struct {
+	mutex     sync.Mutex
+	sequences map[uint32]uint32
+}{
+	sequences: make(map[uint32]uint32, timeVariantTrackedMinutes),
+}
+
+// timeVariantEpoch is the epoch of time variants (2024-01-01 UTC),
+// which encode the number of minutes that have elapsed since it
+var timeVariantEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// TimeVariant returns a time-sortable variant ID for the given time, so that the variants of an account
+// minted over time sort chronologically (at the resolution of a minute) when ordered by their variant IDs.
+//
+// The upper 24 bits of the variant ID are the number of whole minutes between the epoch of time variants
+// (2024-01-01 UTC) and the given time, and the lower 8 bits are a sequence number that is never zero, so
+// that a time variant is never the zero variant. The first variant of a minute starts at a random sequence
+// number between 1 and 128, and every subsequent variant generated for the same minute increments it, so
+// that the variants of a minute sort in the order they were generated.
+//
+// Time variants are only unique within a single process. Processes that generate variants for the same
+// minute collide as soon as their sequences overlap, so the variants of an account must be generated by a
+// single process, or be reserved with a VariantAllocator instead. Within a process, the sequences of the 64
+// most recent minutes that variants were generated for are tracked, so variants are never repeated as long
+// as they are generated for times that are (roughly) monotonic, such as the current time. Generating a variant
+// for a minute older than those starts a new random sequence for it, which can repeat earlier variants.
+//
+// At least 128 variants can be generated for each minute, after which ErrVariantsExhausted is returned
+// for the minute. Time variants can be generated for times from the epoch up to (not including) 2^24
+// minutes after it (about 31 years, in late 2055). Returns an error for times outside this range.
+func TimeVariant(t time.Time) (uint32, error) {
+	if t.Before(timeVariantEpoch) {
+		return 0, fmt.Errorf("invalid time variant: %v is before the epoch", t)
+	}
+
+	minutes := t.Sub(timeVariantEpoch) / time.Minute
+	if minutes >= timeVariantMaxMinutes {
+		return 0, fmt.Errorf("invalid time variant: %v is beyond the range of time variants", t)
+	}
+
+	sequence, err := nextTimeVariantSequence(uint32(minutes))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time variant: %w for %v", err, t.Truncate(time.Minute))
+	}
+
+	return uint32(minutes)<<timeVariantSequenceBits | sequence, nil
+}
+
+// nextTimeVariantSequence returns the next sequence number of the time variants for the given minute
+func nextTimeVariantSequence(minute uint32) (uint32, error) {
+	timeVariants.mutex.Lock()
+	defer timeVariants.mutex.Unlock()
+
+	sequence, ok := timeVariants.sequences[minute]
+	if !ok {
+		// Stop tracking the oldest minute to make room for the new minute
+		if len(timeVariants.sequences) >= timeVariantTrackedMinutes {
+			delete(timeVariants.sequences, slices.Min(slices.Collect(maps.Keys(timeVariants.sequences))))
+		}
+
+		// Start a new random sequence for the minute
+		sequence = rand.Uint32N(timeVariantMaxStart) + 1
+		timeVariants.sequences[minute] = sequence
+
+		return sequence, nil
+	}
+
+	if sequence == timeVariantMaxSequence {
+		return 0, ErrVariantsExhausted
+	}
+
+	timeVariants.sequences[minute] = sequence + 1
+
+	return sequence + 1, nil
+}
+
+// VariantTime returns the time embedded in the given time variant (see TimeVariant),
+// which is the start of the minute in which the variant was generated (in UTC).
+func VariantTime(variant uint32) time.Time {
+	return timeVariantEpoch.Add(time.Duration(variant>>timeVariantSequenceBits) * time.Minute)
+}
@@ -0,0 +1,190 @@
+package identifiers
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resetTimeVariants clears the tracked sequences of time variants,
+// so that tests are not affected by the time variants of earlier tests
+func resetTimeVariants(t *testing.T) {
+	t.Helper()
+
+	timeVariants.mutex.Lock()
+	defer timeVariants.mutex.Unlock()
+
+	clear(timeVariants.sequences)
+}
+
+func TestTimeVariant(t *testing.T) {
+	resetTimeVariants(t)
+
+	t.Run("Encoding", func(t *testing.T) {
+		moment := time.Date(2025, time.March, 14, 15, 9, 26, 0, time.UTC)
+
+		variant, err := TimeVariant(moment)
+		require.NoError(t, err)
+
+		minutes := uint32(moment.Sub(timeVariantEpoch) / time.Minute)
+		assert.Equal(t, minutes, variant>>8)
+		assert.NotZero(t, variant&0xFF)
+
+		// Test that the embedded time is the start of the minute
+		assert.Equal(t, time.Date(2025, time.March, 14, 15, 9, 0, 0, time.UTC), VariantTime(variant))
+	})
+
+	t.Run("Epoch", func(t *testing.T) {
+		// Test that the variants at the epoch are never the zero variant
+		for range 100 {
+			variant, err := TimeVariant(timeVariantEpoch)
+			require.NoError(t, err)
+			require.NotZero(t, variant)
+			require.Less(t, variant, uint32(256))
+		}
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		moment := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+		first, err := TimeVariant(moment)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, first&0xFF, uint32(128))
+
+		// Test that the variants of a minute are unique and sort in the order they were generated
+		variants := []uint32{first}
+
+		for second := 1; first&0xFF+uint32(len(variants)) <= 255; second++ {
+			variant, err := TimeVariant(moment.Add(time.Duration(second%60) * time.Second))
+			require.NoError(t, err)
+
+			variants = append(variants, variant)
+		}
+
+		assert.True(t, slices.IsSorted(variants))
+		assert.Len(t, slices.Compact(variants), len(variants))
+		assert.Equal(t, uint32(255), variants[len(variants)-1]&0xFF)
+
+		// Test that the variants of the minute are exhausted
+		_, err = TimeVariant(moment.Add(30 * time.Second))
+		require.ErrorIs(t, err, ErrVariantsExhausted)
+		require.EqualError(t, err, "invalid time variant: variants exhausted for 2026-10-15 12:00:00 +0000 UTC")
+
+		// Test that the next minute starts a new sequence
+		next, err := TimeVariant(moment.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, moment.Add(time.Minute), VariantTime(next))
+		assert.LessOrEqual(t, next&0xFF, uint32(128))
+	})
+
+	t.Run("Interleaved", func(t *testing.T) {
+		first := time.Date(2026, time.October, 16, 8, 0, 0, 0, time.UTC)
+		second := first.Add(time.Minute)
+
+		// Test that the sequences of interleaved minutes are tracked separately
+		variants := make([]uint32, 0, 100)
+
+		for idx := range 100 {
+			variant, err := TimeVariant([]time.Time{first, second}[idx%2])
+			require.NoError(t, err)
+
+			variants = append(variants, variant)
+		}
+
+		slices.Sort(variants)
+		assert.Len(t, slices.Compact(variants), 100)
+	})
+
+	t.Run("Tracked", func(t *testing.T) {
+		resetTimeVariants(t)
+
+		moment := time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+		for minute := range timeVariantTrackedMinutes + 1 {
+			_, err := TimeVariant(moment.Add(time.Duration(minute) * time.Minute))
+			require.NoError(t, err)
+		}
+
+		// Test that only the most recent minutes are tracked
+		timeVariants.mutex.Lock()
+		defer timeVariants.mutex.Unlock()
+
+		assert.Len(t, timeVariants.sequences, timeVariantTrackedMinutes)
+		assert.Contains(t, timeVariants.sequences, uint32(moment.Add(time.Minute).Sub(timeVariantEpoch)/time.Minute))
+		assert.NotContains(t, timeVariants.sequences, uint32(moment.Sub(timeVariantEpoch)/time.Minute))
+	})
+
+	t.Run("Chronological", func(t *testing.T) {
+		moment := time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+		variants := make([]uint32, 0, 100)
+		for minute := range 100 {
+			variant, err := TimeVariant(moment.Add(time.Duration(minute) * time.Minute))
+			require.NoError(t, err)
+
+			variants = append(variants, variant)
+		}
+
+		assert.True(t, slices.IsSorted(variants))
+		assert.Equal(t, moment.Add(99*time.Minute), VariantTime(variants[99]))
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		last := timeVariantEpoch.Add((1<<24 - 1) * time.Minute)
+
+		variant, err := TimeVariant(last.Add(59 * time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, last, VariantTime(variant))
+		assert.Equal(t, 2055, last.Year())
+
+		_, err = TimeVariant(last.Add(time.Minute))
+		assert.ErrorContains(t, err, "is beyond the range of time variants")
+
+		_, err = TimeVariant(timeVariantEpoch.Add(-time.Nanosecond))
+		assert.ErrorContains(t, err, "is before the epoch")
+	})
+}
+
+func TestGenerateIDv1Timed(t *testing.T) {
+	resetTimeVariants(t)
+
+	moment := time.Date(2026, time.October, 15, 9, 30, 0, 0, time.UTC)
+	fingerprint := RandomFingerprint()
+
+	asset, err := GenerateAssetIDv1Timed(fingerprint, moment, 3, 0, AssetStateful)
+	require.NoError(t, err)
+	assert.Equal(t, TagAssetV1, asset.Tag())
+	assert.Equal(t, uint16(3), asset.Standard())
+	assert.True(t, asset.Flag(AssetStateful))
+	assert.Equal(t, moment, VariantTime(asset.Variant()))
+
+	logic, err := GenerateLogicIDv1Timed(fingerprint, 0, moment, LogicIntrinsic)
+	require.NoError(t, err)
+	assert.Equal(t, TagLogicV1, logic.Tag())
+	assert.True(t, logic.Flag(LogicIntrinsic))
+	assert.Equal(t, moment, VariantTime(logic.Variant()))
+
+	participant, err := GenerateParticipantIDv1Timed(fingerprint, moment)
+	require.NoError(t, err)
+	assert.Equal(t, TagParticipantV1, participant.Tag())
+	assert.Equal(t, moment, VariantTime(participant.Variant()))
+
+	// Test that identifiers minted later sort after earlier ones
+	later, err := GenerateAssetIDv1Timed(fingerprint, moment.Add(time.Minute), 3, 0, AssetStateful)
+	require.NoError(t, err)
+	assert.Equal(t, -1, asset.Compare(later))
+
+	// Test times outside the range of time variants
+	before := timeVariantEpoch.Add(-time.Hour)
+
+	_, err = GenerateAssetIDv1Timed(fingerprint, before, 3, 0)
+	assert.ErrorContains(t, err, "invalid time variant")
+
+	_, err = GenerateLogicIDv1Timed(fingerprint, 0, before)
+	assert.ErrorContains(t, err, "invalid time variant")
+
+	_, err = GenerateParticipantIDv1Timed(fingerprint, before)
+	assert.ErrorContains(t, err, "invalid time variant")
+}
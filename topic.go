@@ -0,0 +1,283 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+)
+
+// TopicID is a unique identifier for an event (log) topic in the MOI Protocol.
+// It is 32 bytes long and its first 4 bytes are structured as follows:
+//   - Tag: The first byte contains the tag for the topic identifier.
+//   - Flags: The second byte contains flags for the topic identifier.
+//   - Metadata: As of v0, TopicID has no metadata.
+//
+// Like all identifiers, the TopicID also contains a Fingerprint and a Variant ID.
+// Flags of a TopicID are specific to a version and are invalid if set in an unsupported version.
+type TopicID [32]byte
+
+// NewTopicID creates a new TopicID from the 32-byte value.
+// It returns an error if the given data is not a valid TopicID.
+func NewTopicID(data [32]byte) (TopicID, error) {
+	// Convert the data into a TopicID
+	topicID := TopicID(data)
+	// Validate the TopicID
+	if err := topicID.Validate(); err != nil {
+		return Nil, err
+	}
+
+	return topicID, nil
+}
+
+// NewTopicIDFromBytes creates a new TopicID from the given byte slice.
+// The given value must have a length of 32 and validate into a TopicID.
+func NewTopicIDFromBytes(data []byte) (TopicID, error) {
+	// Check length of the data
+	if len(data) != 32 {
+		return Nil, errors.New("invalid length: topic id must be 32 bytes")
+	}
+
+	return NewTopicID([32]byte(data))
+}
+
+// NewTopicIDFromHex creates a new TopicID from the given hex string.
+// The given value must decode as hexadecimal string (0x prefix is optional),
+// with a length of 64 characters (32 bytes) and validate into a TopicID.
+func NewTopicIDFromHex(data string) (TopicID, error) {
+	// Serve valid identifiers of the kind from the process-wide ParseCache (if installed)
+	id, lookup, ok := lookupParseCache(data)
+	if ok && id.Tag().Kind() == KindTopic {
+		return TopicID(id), nil
+	}
+
+	// Decode the given hex string into []byte
+	decoded, err := decodeHexString(data)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Create a new TopicID from the decoded value
+	// Length check is performed in NewTopicIDFromBytes
+	converted, err := NewTopicIDFromBytes(decoded)
+	if err != nil {
+		return Nil, err
+	}
+
+	// Cache the valid identifier, so that it is only decoded and validated once
+	lookup.store(Identifier(converted))
+
+	return converted, nil
+}
+
+// MustTopicID is an enforced version of NewTopicID.
+// Panics if an error occurs. Use with caution.
+func MustTopicID(data [32]byte) TopicID { return must(NewTopicID(data)) }
+
+// MustTopicIDFromBytes is an enforced version of NewTopicIDFromBytes.
+// Panics if an error occurs. Use with caution.
+func MustTopicIDFromBytes(data []byte) TopicID { return must(NewTopicIDFromBytes(data)) }
+
+// MustTopicIDFromHex is an enforced version of NewTopicIDFromHex.
+// Panics if an error occurs. Use with caution.
+func MustTopicIDFromHex(data string) TopicID { return must(NewTopicIDFromHex(data)) }
+
+// Bytes returns the TopicID as a []byte
+func (topic TopicID) Bytes() []byte { return topic[:] }
+
+// String returns the TopicID as a hex-encoded string.
+// This is identical to TopicID.Hex() but is required for the fmt.Stringer interface
+func (topic TopicID) String() string { return topic.Hex() }
+
+// Hex returns the TopicID as a hex-encoded string with the 0x prefix
+func (topic TopicID) Hex() string {
+	return prefix0xString + hex.EncodeToString(topic[:])
+}
+
+// IsNil returns if the TopicID is nil, i.e., 0x000..000
+func (topic TopicID) IsNil() bool { return topic == TopicID{} }
+
+// AsIdentifier returns the TopicID as an Identifier.
+func (topic TopicID) AsIdentifier() Identifier {
+	return Identifier(topic)
+}
+
+// Tag returns the IdentifierTag for the TopicID.
+func (topic TopicID) Tag() IdentifierTag {
+	return IdentifierTag(topic[0])
+}
+
+// Flags returns the byte of flag bits from the TopicID
+func (topic TopicID) Flags() byte {
+	return topic[1]
+}
+
+// Fingerprint returns the 24-byte fingerprint ID from the TopicID.
+func (topic TopicID) Fingerprint() [24]byte {
+	return trimFingerprint(topic)
+}
+
+// AccountID returns the 24-byte account ID from the TopicID.
+// This is identical to TopicID.Fingerprint()
+func (topic TopicID) AccountID() [24]byte {
+	return trimFingerprint(topic)
+}
+
+// Variant returns the 32-bit variant ID from the TopicID.
+func (topic TopicID) Variant() uint32 {
+	variant := trimVariant(topic)
+	return binary.BigEndian.Uint32(variant[:])
+}
+
+// IsVariant returns if the TopicID has a non-zero variant ID
+func (topic TopicID) IsVariant() bool {
+	variant := trimVariant(topic)
+	return !(variant[0] == 0 && variant[1] == 0 && variant[2] == 0 && variant[3] == 0)
+}
+
+// Flag returns if the given Flag is set on the TopicID.
+//
+// If the specified flag is not supported by the TopicID,
+// it will return False, regardless of the actual flag value.
+func (topic TopicID) Flag(flag Flag) bool {
+	// Check if the flag is supported by TopicID.
+	// If not supported, return FALSE, regardless of the actual flag value
+	if !flag.Supports(topic.Tag()) {
+		return false
+	}
+
+	return getFlag(topic[1], flag.index)
+}
+
+// Validate returns an error if the TopicID is invalid.
+// An error is returned if the TopicID has an invalid tag or contains unsupported flags.
+func (topic TopicID) Validate() error {
+	// Check basic validity of the identifier tag
+	if err := topic.Tag().Validate(); err != nil {
+		return invalidTag(err)
+	}
+
+	// Check if the tag is a topic tag
+	if topic.Tag().Kind() != KindTopic {
+		return ErrNotTopicID
+	}
+
+	// Check that there are no unsupported flags set
+	if (topic[1] & topic.Tag().FlagMask()) != 0 {
+		return BadFlagsError{Tag: topic.Tag()}
+	}
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(topic)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	// Ensure TopicID implements text and binary marshaling interfaces
+	_ encoding.TextMarshaler     = (*TopicID)(nil)
+	_ encoding.TextUnmarshaler   = (*TopicID)(nil)
+	_ encoding.BinaryMarshaler   = (*TopicID)(nil)
+	_ encoding.BinaryUnmarshaler = (*TopicID)(nil)
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for TopicID
+func (topic TopicID) MarshalText() ([]byte, error) {
+	return marshal32(topic)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for TopicID
+func (topic *TopicID) UnmarshalText(data []byte) error {
+	decoded, err := unmarshalCached(data, KindTopic)
+	if err != nil {
+		return err
+	}
+
+	*topic = decoded
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for TopicID.
+// The TopicID is encoded as its raw 32 bytes.
+func (topic TopicID) MarshalBinary() ([]byte, error) {
+	return topic.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for TopicID.
+// The data must be 32 bytes long and validate into a TopicID, or be 32 zero bytes
+// (as encoded by MarshalBinary for the zero value) which decode into the zero value
+func (topic *TopicID) UnmarshalBinary(data []byte) error {
+	if isNilBytes(data) {
+		*topic = TopicID{}
+		return nil
+	}
+
+	decoded, err := NewTopicIDFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	*topic = decoded
+	return nil
+}
+
+// GenerateTopicIDv0 creates a new TopicID for v0 with the given parameters.
+// The fingerprint is deterministically derived from the hash of the LogicID that emits
+// the event and the signature of the event, such as "Transfer(address,address,uint64)".
+// Returns an error if the event signature is empty or unsupported flags are used.
+//
+// [tag:1][{systemic}{reserved:7}][reserved:2][fingerprint:24][variant:4]
+func GenerateTopicIDv0(logic LogicID, signature string, flags ...Flag) (TopicID, error) {
+	// Check that the event signature is not empty
+	if signature == "" {
+		return Nil, errors.New("invalid signature: event signature must not be empty")
+	}
+
+	// Create the metadata buffer
+	// [tag][flags][reserved]
+	metadata := make([]byte, 4)
+	// Attach the tag for TopicID v0
+	metadata[0] = byte(TagTopicV0)
+
+	// Attach the flags to the metadata
+	for _, flag := range flags {
+		// Check if the given flag is supported by TopicID v0
+		if !flag.Supports(TagTopicV0) {
+			return Nil, ErrUnsupportedFlag
+		}
+
+		// Set the flag in the metadata
+		metadata[1] = setFlag(metadata[1], flag.index, true)
+	}
+
+	// Derive the fingerprint from the logic and event signature
+	fingerprint := hashFingerprint("moi.topic", logic.Bytes(), []byte(signature))
+
+	// Order the topic ID buffer
+	// [metadata][fingerprint][variant]
+	buffer := make([]byte, 0, 32)
+	buffer = append(buffer, metadata...)
+	buffer = append(buffer, fingerprint[:]...)
+	// Append 4 bytes for the variant (always zero)
+	buffer = append(buffer, make([]byte, 4)...)
+
+	// Check that the flags and metadata are consistent with the rules of the kind
+	if err := checkKindRules(Identifier(buffer)); err != nil {
+		return Nil, err
+	}
+
+	return TopicID(buffer), nil
+}
+
+// RandomTopicIDv0 creates a random v0 TopicID
+// with a random logic, event signature and no flags.
+//   - There is a 0% chance that the Systemic flag will be set.
+func RandomTopicIDv0() TopicID {
+	// Safe to ignore error as the signature is not empty and no flags are used
+	topic, _ := GenerateTopicIDv0(RandomLogicIDv0(), "Event"+strconv.FormatUint(rand.Uint64(), 10)+"()")
+	return topic
+}
@@ -0,0 +1,306 @@
+package identifiers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicID(t *testing.T) {
+	data := [32]byte{
+		byte(TagTopicV0), // Tag
+		0b10000000,       // Flags
+		0x00, 0x00,       // Metadata
+
+		// Fingerprint
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+		0x00, 0x00, 0x00, 0x42, // Variant
+	}
+
+	// Create a test TopicID
+	topicID, err := NewTopicID(data)
+	require.NoError(t, err)
+
+	// Test Tag
+	assert.Equal(t, TagTopicV0, topicID.Tag())
+
+	// Test Fingerprint
+	assert.Equal(t, [24]byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	}, topicID.Fingerprint())
+
+	// Test Variant
+	assert.Equal(t, uint32(0x42), topicID.Variant())
+	// Test IsVariant
+	assert.True(t, topicID.IsVariant())
+
+	// Test Flags
+	assert.True(t, topicID.Flag(Systemic))
+	assert.False(t, topicID.Flag(LogicIntrinsic)) // unsupported flag
+
+	// Test AsIdentifier
+	identifier := Identifier(data[:])
+	assert.Equal(t, identifier, topicID.AsIdentifier())
+
+	// Test From Identifier
+	converted, err := identifier.AsTopicID()
+	require.NoError(t, err)
+	require.Equal(t, topicID, converted)
+
+	// Test Bytes
+	assert.Equal(t, data[:], topicID.Bytes())
+
+	// Test String & Hex
+	expectedHex := "0x8080000001020304050607081112131415161718212223242526272800000042"
+	assert.Equal(t, expectedHex, topicID.String())
+	assert.Equal(t, expectedHex, topicID.Hex())
+}
+
+//nolint:dupl // similar functions
+func TestTopicID_Constructor(t *testing.T) {
+	t.Run("NewTopicID", func(t *testing.T) {
+		t.Run("Valid", func(t *testing.T) {
+			topicID, err := NewTopicID([32]byte{
+				byte(TagTopicV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+				// Empty bytes for fingerprint and variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, topicID.Validate())
+		})
+
+		t.Run("InvalidTag", func(t *testing.T) {
+			_, err := NewTopicID([32]byte{0xF0}) // Invalid tag kind
+			require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+			_, err = NewTopicID([32]byte{byte(TagTopicV0) | 0x0F}) // Invalid tag version
+			require.EqualError(t, err, "invalid tag: unsupported tag version")
+
+			_, err = NewTopicID([32]byte{byte(TagLogicV0)}) // Invalid tag
+			require.EqualError(t, err, "invalid tag: not a topic id")
+		})
+
+		t.Run("InvalidFlags", func(t *testing.T) {
+			_, err := NewTopicID([32]byte{
+				byte(TagTopicV0), // Tag
+				0b11111111,       // Invalid flags
+			})
+			require.EqualError(t, err, "invalid flags: unsupported flags for topic id")
+		})
+	})
+
+	t.Run("NewTopicIDFromBytes", func(t *testing.T) {
+		// Less than 32 bytes
+		t.Run("< 32 bytes", func(t *testing.T) {
+			_, err := NewTopicIDFromBytes([]byte{byte(TagTopicV0), 0x00, 0x00, 0x01})
+			require.EqualError(t, err, "invalid length: topic id must be 32 bytes")
+		})
+
+		// Exactly 32 bytes
+		t.Run("= 32 bytes", func(t *testing.T) {
+			topicID, err := NewTopicIDFromBytes([]byte{
+				byte(TagTopicV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			})
+
+			require.NoError(t, err)
+			require.NoError(t, topicID.Validate())
+			require.Equal(t, TopicID{
+				byte(TagTopicV0), 0x00, 0x00, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+				0x00, 0x00, 0x00, 0x01,
+			}, topicID)
+		})
+
+		// More than 32 bytes
+		t.Run("> 32 bytes", func(t *testing.T) {
+			_, err := NewTopicIDFromBytes([]byte{
+				byte(TagTopicV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+				0xFF, 0xFF, 0xFF, 0xFF, // Extra bytes
+			})
+			require.EqualError(t, err, "invalid length: topic id must be 32 bytes")
+		})
+	})
+
+	t.Run("NewTopicIDFromHex", func(t *testing.T) {
+		t.Run("ValidHex", func(t *testing.T) {
+			topicID, err := NewTopicIDFromHex("0x" + hex.EncodeToString([]byte{
+				byte(TagTopicV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, topicID.Validate())
+		})
+
+		t.Run("ValidHexNoPrefix", func(t *testing.T) {
+			topicID, err := NewTopicIDFromHex(hex.EncodeToString([]byte{
+				byte(TagTopicV0), // Tag
+				0b00000000,       // Flags
+				0x00, 0x01,       // Metadata
+
+				// Fingerprint
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+				0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+
+				0x00, 0x00, 0x00, 0x01, // Variant
+			}))
+
+			require.NoError(t, err)
+			require.NoError(t, topicID.Validate())
+		})
+
+		t.Run("InvalidHex", func(t *testing.T) {
+			_, err := NewTopicIDFromHex("invalid-hex")
+			require.EqualError(t, err, "encoding/hex: invalid byte: U+0069 'i'")
+
+			_, err = NewTopicIDFromHex("0xf") // odd length
+			require.EqualError(t, err, "encoding/hex: odd length hex string")
+		})
+	})
+
+	t.Run("MustTopicID", func(t *testing.T) {
+		t.Run("MustTopicID", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTopicID([32]byte{0xFF}) })
+		})
+
+		t.Run("MustTopicIDFromBytes", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTopicIDFromBytes([]byte{0xFF}) })
+		})
+
+		t.Run("MustTopicIDFromHex", func(t *testing.T) {
+			assert.Panics(t, func() { _ = MustTopicIDFromHex("0xFF") })
+		})
+	})
+}
+
+func TestTopicID_TextMarshal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		topicID := RandomTopicIDv0()
+
+		encoded, err := json.Marshal(topicID)
+		require.NoError(t, err)
+		require.Equal(t, `"`+topicID.Hex()+`"`, string(encoded))
+
+		var decoded TopicID
+
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, topicID, decoded)
+	})
+
+	t.Run("MissingPrefix", func(t *testing.T) {
+		var decoded TopicID
+
+		require.Equal(t, json.Unmarshal([]byte(`"invalid-json"`), &decoded), ErrMissingHexPrefix)
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		var decoded TopicID
+
+		require.Equal(t, json.Unmarshal([]byte(`"0xffabcd"`), &decoded), ErrInvalidLength)
+	})
+
+	t.Run("HexError", func(t *testing.T) {
+		var decoded TopicID
+
+		require.EqualError(t,
+			json.Unmarshal([]byte(`"0xYY01001001020304050607081112131415161718212223242526272800000042"`), &decoded),
+			"encoding/hex: invalid byte: U+0059 'Y'",
+		)
+	})
+}
+
+func TestTopicID_Generation(t *testing.T) {
+	t.Run("v0", func(t *testing.T) {
+		t.Run("Generate", func(t *testing.T) {
+			logic := RandomLogicIDv0()
+			topicID, err := GenerateTopicIDv0(logic, "Transfer(address,address,uint64)", Systemic)
+			require.NoError(t, err)
+
+			assert.Equal(t, TagTopicV0, topicID.Tag())
+			assert.Equal(t, uint32(0), topicID.Variant())
+			assert.True(t, topicID.Flag(Systemic))
+
+			// Test deterministic generation
+			regenerated, err := GenerateTopicIDv0(logic, "Transfer(address,address,uint64)", Systemic)
+			require.NoError(t, err)
+			assert.Equal(t, topicID, regenerated)
+
+			// Test that the signature affects the fingerprint
+			other, err := GenerateTopicIDv0(logic, "Approve(address,uint64)", Systemic)
+			require.NoError(t, err)
+			assert.NotEqual(t, topicID.Fingerprint(), other.Fingerprint())
+
+			// Test empty signature
+			_, err = GenerateTopicIDv0(logic, "")
+			require.EqualError(t, err, "invalid signature: event signature must not be empty")
+
+			// Test unsupported flags
+			_, err = GenerateTopicIDv0(logic, "Transfer(address,address,uint64)", AssetLogical)
+			assert.Equal(t, err, ErrUnsupportedFlag)
+		})
+
+		t.Run("Random", func(t *testing.T) {
+			topicID := RandomTopicIDv0()
+
+			assert.NoError(t, topicID.Validate())
+			assert.Equal(t, TagTopicV0, topicID.Tag())
+		})
+	})
+}
+
+func TestTopicID_BinaryMarshal(t *testing.T) {
+	topic := RandomTopicIDv0()
+
+	encoded, err := topic.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, topic.Bytes(), encoded)
+
+	var decoded TopicID
+
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, topic, decoded)
+
+	require.EqualError(t, decoded.UnmarshalBinary([]byte{0x01}), "invalid length: topic id must be 32 bytes")
+	require.EqualError(t, decoded.UnmarshalBinary(append([]byte{byte(TagAssetV0)}, make([]byte, 31)...)),
+		"invalid tag: not a topic id",
+	)
+}
@@ -0,0 +1,201 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// migration is a rule that rewrites an Identifier from one version of its kind to the next.
+// It is responsible for re-mapping the flags and metadata into the layout of the next version.
+type migration func(Identifier) (Identifier, error)
+
+// migrations is a map of IdentifierTag to the migration rule
+// that upgrades identifiers with that tag to the next version.
+var migrations = map[IdentifierTag]migration{
+	// Layouts for v1 are identical to v0, so these only require the tag to be rewritten
+	TagParticipantV0: retag(TagParticipantV1),
+	// The metadata of AssetID v1 contains the dimension and an 8-bit standard
+	TagAssetV0: migrateAssetV1,
+	// The metadata of LogicID v1 contains the edition of the logic
+	TagLogicV0: migrateLogicV1,
+}
+
+// migrateAssetV1 is the migration rule that upgrades a v0 AssetID to v1. The 16-bit standard of v0 is
+// re-mapped into the 8-bit standard of v1, and the upgraded AssetID has no dimension. Because the standard
+// is big-endian, its metadata bytes are unchanged, but v0 assets with a standard above 255 cannot be upgraded.
+func migrateAssetV1(id Identifier) (Identifier, error) {
+	if err := checkStandardV1(binary.BigEndian.Uint16(id[2:4])); err != nil {
+		return Nil, err
+	}
+
+	return retag(TagAssetV1)(id)
+}
+
+// migrateLogicV1 is the migration rule that upgrades a v0 LogicID to v1. If the variant ID of the logic kind
+// is interpreted as its edition (see VariantSemantics), the variant ID of v0 is encoded as the edition in the
+// metadata of v1, otherwise the edition of v1 is 0. The variant ID is preserved in both cases. v0 logics with a
+// standard, or with an edition above 65535, cannot be upgraded.
+func migrateLogicV1(id Identifier) (Identifier, error) {
+	if standard := binary.BigEndian.Uint16(id[2:4]); standard != 0 {
+		return Nil, fmt.Errorf("logic standard %d cannot be represented in v1", standard)
+	}
+
+	// The edition is 0 unless the variant ID of v0 is interpreted as the edition
+	edition, _ := variantAs(id, EditionVariant)
+	if edition > math.MaxUint16 {
+		return Nil, fmt.Errorf("variant %d does not fit into the edition of v1", edition)
+	}
+
+	binary.BigEndian.PutUint16(id[2:4], uint16(edition))
+
+	return retag(TagLogicV1)(id)
+}
+
+// retag returns a migration rule that rewrites the tag of an Identifier while preserving its
+// flags and metadata. It can only be used between versions that share the same layout.
+func retag(tag IdentifierTag) migration {
+	return func(id Identifier) (Identifier, error) {
+		id[0] = byte(tag)
+
+		// Flags supported by a version remain supported for all subsequent versions,
+		// but this is validated anyway to guard against incorrect flag masks
+		if err := id.Validate(); err != nil {
+			return Nil, err
+		}
+
+		return id, nil
+	}
+}
+
+// Upgrade rewrites the given Identifier into the given version of its kind.
+// The identifier is migrated one version at a time, with each step re-mapping the
+// flags and metadata according to the migration rules of the kind.
+//
+// Returns the identifier unchanged if it is already at the given version.
+// Returns an error if the identifier is invalid, if the version is older than the
+// version of the identifier or if no migration rule exists for any of the steps.
+func Upgrade(id Identifier, toVersion uint8) (Identifier, error) {
+	if err := id.Validate(); err != nil {
+		return Nil, err
+	}
+
+	// Check that the target version is supported for the kind
+	target, err := NewIdentifierTag(id.Tag().Kind(), toVersion)
+	if err != nil {
+		return Nil, err
+	}
+
+	if id.Tag().Version() > toVersion {
+		return Nil, fmt.Errorf("cannot downgrade %v to %v", id.Tag(), target)
+	}
+
+	for id.Tag() != target {
+		migrate, ok := migrations[id.Tag()]
+		if !ok {
+			return Nil, fmt.Errorf("no migration rule for %v", id.Tag())
+		}
+
+		migrated, err := migrate(id)
+		if err != nil {
+			return Nil, fmt.Errorf("failed to migrate %v: %w", id.Tag(), err)
+		}
+
+		id = migrated
+	}
+
+	return id, nil
+}
+
+// Transformation describes the transformation that was applied by NormalizeIdentifier
+// to bring an input into the newest supported encoding of its identifier kind.
+type Transformation struct {
+	// Legacy indicates that the input was in a legacy format and was converted into From
+	Legacy bool
+	// From is the tag of the identifier as it was decoded from the input
+	From IdentifierTag
+	// To is the tag of the normalized identifier
+	To IdentifierTag
+}
+
+// Applied returns whether the input had to be transformed during normalization.
+func (transform Transformation) Applied() bool {
+	return transform.Legacy || transform.From != transform.To
+}
+
+// String returns a description of the Transformation, such as "asset/v0 -> asset/v1"
+// or "legacy -> logic/v0 -> logic/v1". Returns "none" if no transformation was applied.
+func (transform Transformation) String() string {
+	if !transform.Applied() {
+		return "none"
+	}
+
+	steps := make([]string, 0, 3)
+	if transform.Legacy {
+		steps = append(steps, "legacy")
+	}
+
+	steps = append(steps, transform.From.String())
+	if transform.From != transform.To {
+		steps = append(steps, transform.To.String())
+	}
+
+	return strings.Join(steps, " -> ")
+}
+
+// legacyConverters is a map of the byte lengths of legacy identifier
+// formats to the converter that is used to convert them by NormalizeIdentifier.
+var legacyConverters = map[int]func(string) (Identifier, error){
+	legacyLogicIDLength: func(s string) (Identifier, error) {
+		logic, err := ConvertLegacyLogicID(s)
+		return logic.AsIdentifier(), err
+	},
+	legacyAssetIDLength: func(s string) (Identifier, error) {
+		asset, err := ConvertLegacyAssetID(s)
+		return asset.AsIdentifier(), err
+	},
+}
+
+// ParseCanonical decodes the given identifier string and normalizes it to the newest
+// supported version of its kind. It accepts identifiers of any supported version as well as the
+// legacy LogicID and AssetID formats (see ConvertLegacyLogicID and ConvertLegacyAssetID), and is
+// intended for ingesting data that was produced with different identifier versions.
+// Use NormalizeIdentifier to also learn which transformation was applied to the input.
+func ParseCanonical(input string) (Identifier, error) {
+	id, _, err := NormalizeIdentifier(input)
+	return id, err
+}
+
+// NormalizeIdentifier is like ParseCanonical, but also returns the Transformation
+// that was applied to the input to normalize it to the newest supported version.
+func NormalizeIdentifier(input string) (Identifier, Transformation, error) {
+	decoded, err := decodeHexString(input)
+	if err != nil {
+		return Nil, Transformation{}, err
+	}
+
+	var id Identifier
+
+	// Convert the input if it has the length of a legacy format
+	convert, legacy := legacyConverters[len(decoded)]
+	if legacy {
+		id, err = convert(input)
+	} else {
+		id, err = NewIdentifierFromHex(input)
+	}
+
+	if err != nil {
+		return Nil, Transformation{}, err
+	}
+
+	// Identifiers with an unrecognized kind are rejected by Upgrade
+	latest, _ := MaxVersion(id.Tag().Kind())
+
+	normalized, err := Upgrade(id, latest)
+	if err != nil {
+		return Nil, Transformation{}, err
+	}
+
+	return normalized, Transformation{Legacy: legacy, From: id.Tag(), To: normalized.Tag()}, nil
+}
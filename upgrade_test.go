@@ -0,0 +1,188 @@
+package identifiers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgrade(t *testing.T) {
+	fingerprint := RandomFingerprint()
+
+	t.Run("ParticipantID", func(t *testing.T) {
+		v0, err := GenerateMultisigParticipantID(
+			[]ParticipantID{RandomParticipantIDv0(), RandomParticipantIDv0(), RandomParticipantIDv0()}, 2, 5,
+		)
+		require.NoError(t, err)
+
+		v1, err := Upgrade(v0.AsIdentifier(), 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, TagParticipantV1, v1.Tag())
+		assert.Equal(t, v0[1:], v1[1:])
+
+		participant, err := v1.AsParticipantID()
+		require.NoError(t, err)
+		assert.Equal(t, uint8(2), participant.Threshold())
+	})
+
+	t.Run("AssetID", func(t *testing.T) {
+		v0 := must(GenerateAssetIDv0(fingerprint, 10, 2, AssetLogical))
+
+		v1, err := Upgrade(v0.AsIdentifier(), 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, must(GenerateAssetIDv1(fingerprint, 10, 2, 0, AssetLogical)).AsIdentifier(), v1)
+
+		// The standard of v1 must fit into 8 bits
+		_, err = Upgrade(must(GenerateAssetIDv0(fingerprint, 10, 0x0114)).AsIdentifier(), 1)
+		require.EqualError(t, err,
+			"failed to migrate asset/v0: invalid asset standard: 276 does not fit into 8 bits for v1")
+	})
+
+	t.Run("LogicID", func(t *testing.T) {
+		v0 := must(GenerateLogicIDv0(fingerprint, 7, 0, LogicIntrinsic, LogicExtrinsic))
+
+		v1, err := Upgrade(v0.AsIdentifier(), 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, must(GenerateLogicIDv1(fingerprint, 7, 7, LogicIntrinsic, LogicExtrinsic)).AsIdentifier(), v1)
+
+		// The standard of v0 cannot be represented in v1
+		_, err = Upgrade(must(GenerateLogicIDv0(fingerprint, 7, 2)).AsIdentifier(), 1)
+		require.EqualError(t, err, "failed to migrate logic/v0: logic standard 2 cannot be represented in v1")
+
+		// The variant of v0 must fit into the edition of v1
+		_, err = Upgrade(must(GenerateLogicIDv0(fingerprint, 0x10000, 0)).AsIdentifier(), 1)
+		require.EqualError(t, err, "failed to migrate logic/v0: variant 65536 does not fit into the edition of v1")
+
+		t.Run("OpaqueVariant", func(t *testing.T) {
+			setLogicSemantics := func(semantics VariantSemantics) {
+				registryLock.Lock()
+				defer registryLock.Unlock()
+
+				_ = updateRegistry(func(tables *registryTables) error {
+					tables.variantSemantics[KindLogic] = semantics
+					return nil
+				})
+			}
+
+			setLogicSemantics(OpaqueVariant)
+			t.Cleanup(func() { setLogicSemantics(EditionVariant) })
+
+			// The variant of v0 is not the edition, so the edition of v1 is 0
+			v1, err := Upgrade(v0.AsIdentifier(), 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, must(GenerateLogicIDv1(fingerprint, 0, 7, LogicIntrinsic, LogicExtrinsic)).AsIdentifier(), v1)
+
+			// The variant of v0 is not limited to the range of editions
+			_, err = Upgrade(must(GenerateLogicIDv0(fingerprint, 0x10000, 0)).AsIdentifier(), 1)
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("SameVersion", func(t *testing.T) {
+		id := RandomAssetIDv1().AsIdentifier()
+
+		upgraded, err := Upgrade(id, 1)
+		require.NoError(t, err)
+		assert.Equal(t, id, upgraded)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := Upgrade(Identifier{0xF0}, 1)
+		require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+		_, err = Upgrade(RandomTopicIDv0().AsIdentifier(), 1)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		_, err = Upgrade(RandomAssetIDv1().AsIdentifier(), 0)
+		require.EqualError(t, err, "cannot downgrade asset/v1 to asset/v0")
+	})
+
+	t.Run("CustomKind", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{MaxVersion: 1, FlagMasks: []byte{0, 0}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		_, err := Upgrade(Identifier{0xE0}, 1)
+		require.EqualError(t, err, "no migration rule for kind(14)/v0")
+
+		migrations[0xE0] = func(Identifier) (Identifier, error) { return Nil, errors.New("bad layout") }
+		t.Cleanup(func() { delete(migrations, 0xE0) })
+
+		_, err = Upgrade(Identifier{0xE0}, 1)
+		require.EqualError(t, err, "failed to migrate kind(14)/v0: bad layout")
+
+		migrations[0xE0] = retag(0xE1)
+
+		upgraded, err := Upgrade(Identifier{0xE0, 0xFF}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, Identifier{0xE1, 0xFF}, upgraded)
+
+		// Retagging must reject flags that are not supported by the new tag
+		_, err = retag(TagTopicV0)(Identifier{byte(TagParticipantV0), 0x01})
+		require.EqualError(t, err, "invalid flags: unsupported flags for topic id")
+	})
+}
+
+func TestParseCanonical(t *testing.T) {
+	fingerprint := RandomFingerprint()
+
+	v0 := must(GenerateAssetIDv0(fingerprint, 3, 1, AssetStateful))
+	v1 := must(GenerateAssetIDv1(fingerprint, 3, 1, 0, AssetStateful))
+	topic := RandomTopicIDv0()
+
+	legacy := legacyLogicID(0x03, 7, [32]byte{})
+	legacyV1 := must(GenerateLogicIDv1([24]byte{}, 7, 7, LogicIntrinsic, LogicExtrinsic))
+	legacyAsset := legacyAssetID(0x01, 2, 20, [32]byte{})
+	legacyAssetV1 := must(GenerateAssetIDv1([24]byte{}, 2, 20, 0, AssetStateful))
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  Identifier
+		transform string
+	}{
+		{"Upgraded", v0.String(), v1.AsIdentifier(), "asset/v0 -> asset/v1"},
+		{"Latest", v1.String(), v1.AsIdentifier(), "none"},
+		{"LatestWithoutPrefix", v1.String()[2:], v1.AsIdentifier(), "none"},
+		{"SingleVersion", topic.String(), topic.AsIdentifier(), "none"},
+		{"Legacy", legacy, legacyV1.AsIdentifier(), "legacy -> logic/v0 -> logic/v1"},
+		{"LegacyAsset", legacyAsset, legacyAssetV1.AsIdentifier(), "legacy -> asset/v0 -> asset/v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, transform, err := NormalizeIdentifier(tt.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, id)
+			assert.Equal(t, tt.transform, transform.String())
+			assert.Equal(t, tt.transform != "none", transform.Applied())
+
+			parsed, err := ParseCanonical(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, id, parsed)
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := ParseCanonical("0x1234")
+		require.EqualError(t, err, "invalid length: identifier must be 32 bytes")
+
+		_, err = ParseCanonical("0x" + strings.Repeat("f0", 32))
+		require.EqualError(t, err, "invalid tag: unsupported tag kind")
+
+		_, err = ParseCanonical("0xZZ")
+		require.EqualError(t, err, "encoding/hex: invalid byte: U+005A 'Z'")
+
+		_, err = ParseCanonical(legacyLogicID(0x10, 0, [32]byte{}))
+		require.EqualError(t, err, "unsupported tag version: legacy logic id version 1")
+	})
+}
@@ -0,0 +1,133 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// URIScheme is the scheme of URIs for identifiers, such as moi://asset/0x...
+const URIScheme = "moi"
+
+// URI is a moi:// URI for an identifier, used for deep links in wallets and explorers.
+//
+// URIs are of the form moi://<kind>/<identifier>[/<variant>][?<query>], where the kind is the name
+// of the identifier kind, or its decimal value for custom kinds without a name, and the identifier is
+// hex-encoded with the 0x prefix. If the identifier is a variant (see Identifier.IsVariant), the
+// identifier is encoded with a zero variant ID followed by the decimal variant ID as its own path
+// segment, so that all variants of an identifier share the same prefix. The query is not
+// interpreted by this package and is available for application-specific parameters.
+type URI struct {
+	Identifier Identifier
+	Query      url.Values
+}
+
+// String returns the URI in the form moi://<kind>/<identifier>[/<variant>][?<query>]
+func (uri URI) String() string {
+	base := uri.Identifier
+	binary.BigEndian.PutUint32(base[28:], 0)
+
+	path := "/" + base.Hex()
+	if uri.Identifier.IsVariant() {
+		path += "/" + strconv.FormatUint(uint64(uri.Identifier.Variant()), 10)
+	}
+
+	encoded := url.URL{
+		Scheme:   URIScheme,
+		Host:     uriHost(uri.Identifier.Tag().Kind()),
+		Path:     path,
+		RawQuery: uri.Query.Encode(),
+	}
+
+	return encoded.String()
+}
+
+// ParseURI parses the given moi:// URI into a URI.
+// The kind in the URI can be its name or its decimal value, and must match the kind
+// of the identifier, which must be valid.
+// If the URI has a variant segment, the identifier in the URI must have a zero variant ID.
+func ParseURI(s string) (URI, error) {
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("invalid uri: %w", err)
+	}
+
+	if parsed.Scheme != URIScheme {
+		return URI{}, fmt.Errorf("invalid uri: unsupported scheme %q", parsed.Scheme)
+	}
+
+	kind, err := parseURIHost(parsed.Host)
+	if err != nil {
+		return URI{}, fmt.Errorf("invalid uri: %w", err)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(parsed.Path, "/"), "/")
+	if len(segments) > 2 {
+		return URI{}, errors.New("invalid uri: too many path segments")
+	}
+
+	var id Identifier
+	if err = id.UnmarshalText([]byte(segments[0])); err != nil {
+		return URI{}, fmt.Errorf("invalid uri: %w", err)
+	}
+
+	if err = id.Validate(); err != nil {
+		return URI{}, err
+	}
+
+	if id.Tag().Kind() != kind {
+		return URI{}, fmt.Errorf("invalid uri: kind %v does not match %v identifier", kind, id.Tag().Kind())
+	}
+
+	if len(segments) == 2 {
+		if id.IsVariant() {
+			return URI{}, errors.New("invalid uri: variant of identifier specified twice")
+		}
+
+		variant, err := strconv.ParseUint(segments[1], 10, 32)
+		if err != nil {
+			return URI{}, fmt.Errorf("invalid uri: invalid variant: %w", err)
+		}
+
+		binary.BigEndian.PutUint32(id[28:], uint32(variant))
+	}
+
+	return URI{Identifier: id, Query: parsed.Query()}, nil
+}
+
+// uriHost returns the host of a URI for the kind, which is the name of the kind,
+// or its decimal value for kinds without a name (which cannot be parsed as names)
+func uriHost(kind IdentifierKind) string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	if name, ok := kindNames[kind]; ok {
+		return name
+	}
+
+	return strconv.FormatUint(uint64(kind), 10)
+}
+
+// parseURIHost parses the host of a URI into a kind, which is either its decimal value or its name
+func parseURIHost(host string) (IdentifierKind, error) {
+	if value, err := strconv.ParseUint(host, 10, 4); err == nil {
+		return IdentifierKind(value), nil
+	}
+
+	return ParseIdentifierKind(host)
+}
+
+// URI returns the moi:// URI for the Identifier without any query parameters
+func (id Identifier) URI() string { return URI{Identifier: id}.String() }
+
+// URI returns the moi:// URI for the ParticipantID without any query parameters
+func (participant ParticipantID) URI() string { return participant.AsIdentifier().URI() }
+
+// URI returns the moi:// URI for the AssetID without any query parameters
+func (asset AssetID) URI() string { return asset.AsIdentifier().URI() }
+
+// URI returns the moi:// URI for the LogicID without any query parameters
+func (logic LogicID) URI() string { return logic.AsIdentifier().URI() }
@@ -0,0 +1,116 @@
+package identifiers
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURI(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 1))
+	variant := must(GenerateAssetIDv0(asset.Fingerprint(), 42, 1))
+
+	t.Run("Base", func(t *testing.T) {
+		encoded := asset.URI()
+		require.Equal(t, "moi://asset/"+asset.Hex(), encoded)
+
+		parsed, err := ParseURI(encoded)
+		require.NoError(t, err)
+		require.Equal(t, asset.AsIdentifier(), parsed.Identifier)
+		require.Empty(t, parsed.Query)
+	})
+
+	t.Run("Variant", func(t *testing.T) {
+		encoded := variant.URI()
+		require.Equal(t, "moi://asset/"+asset.Hex()+"/42", encoded)
+
+		parsed, err := ParseURI(encoded)
+		require.NoError(t, err)
+		require.Equal(t, variant.AsIdentifier(), parsed.Identifier)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		uri := URI{Identifier: variant.AsIdentifier(), Query: url.Values{"amount": {"100"}, "memo": {"a b"}}}
+
+		encoded := uri.String()
+		require.Equal(t, "moi://asset/"+asset.Hex()+"/42?amount=100&memo=a+b", encoded)
+
+		parsed, err := ParseURI(encoded)
+		require.NoError(t, err)
+		require.Equal(t, uri, parsed)
+	})
+
+	t.Run("Types", func(t *testing.T) {
+		participant := RandomParticipantIDv0()
+		assert.Equal(t, participant.AsIdentifier().URI(), participant.URI())
+		assert.True(t, strings.HasPrefix(participant.URI(), "moi://participant/"))
+
+		logic := RandomLogicIDv0()
+		assert.Equal(t, logic.AsIdentifier().URI(), logic.URI())
+		assert.True(t, strings.HasPrefix(logic.URI(), "moi://logic/"))
+	})
+
+	t.Run("UnnamedKind", func(t *testing.T) {
+		const kindCustom = IdentifierKind(0x0E)
+
+		require.NoError(t, RegisterKind(kindCustom, KindSpec{FlagMasks: []byte{0}}))
+		t.Cleanup(func() { unregisterKind(t, kindCustom) })
+
+		id := Identifier{byte(kindCustom << 4)}
+		id[31] = 9
+
+		encoded := id.URI()
+		require.Equal(t, "moi://14/"+Identifier{byte(kindCustom << 4)}.Hex()+"/9", encoded)
+
+		parsed, err := ParseURI(encoded)
+		require.NoError(t, err)
+		require.Equal(t, id, parsed.Identifier)
+	})
+}
+
+func TestParseURI(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0))
+	variant := must(asset.AsIdentifier().DeriveVariant(7, nil, nil))
+
+	invalid := asset.AsIdentifier()
+	invalid[0] = 0xF0
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"Malformed", "moi://asset/%zz", "invalid uri: parse"},
+		{"Scheme", "https://asset/" + asset.Hex(), "invalid uri: unsupported scheme \"https\""},
+		{"Kind", "moi://unknown/" + asset.Hex(), "invalid uri: unsupported tag kind: \"unknown\""},
+		{"KindValue", "moi://16/" + asset.Hex(), "invalid uri: unsupported tag kind: \"16\""},
+		{"KindValueMismatch", "moi://2/" + asset.Hex(), "invalid uri: kind logic does not match asset identifier"},
+		{"Segments", "moi://asset/" + asset.Hex() + "/1/2", "invalid uri: too many path segments"},
+		{"Identifier", "moi://asset/0x1234", "invalid uri: "},
+		{"Invalid", "moi://asset/" + invalid.Hex(), "invalid tag: unsupported tag kind"},
+		{"KindMismatch", "moi://logic/" + asset.Hex(), "invalid uri: kind logic does not match asset identifier"},
+		{"VariantTwice", "moi://asset/" + variant.Hex() + "/7", "invalid uri: variant of identifier specified twice"},
+		{"VariantInvalid", "moi://asset/" + asset.Hex() + "/x", "invalid uri: invalid variant"},
+		{"VariantOverflow", "moi://asset/" + asset.Hex() + "/4294967296", "invalid uri: invalid variant"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseURI(test.input)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+
+	// Kinds are case-insensitive
+	parsed, err := ParseURI("moi://ASSET/" + asset.Hex())
+	require.NoError(t, err)
+	require.Equal(t, asset.AsIdentifier(), parsed.Identifier)
+
+	// Kinds can also be given as their decimal value
+	parsed, err = ParseURI("moi://1/" + asset.Hex())
+	require.NoError(t, err)
+	require.Equal(t, asset.AsIdentifier(), parsed.Identifier)
+}
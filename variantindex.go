@@ -0,0 +1,149 @@
+package identifiers
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// VariantIndex is an index of the variant IDs present for each 24-byte account ID.
+//
+// It stores the variants of each account in a compressed VariantSet, which allows queries such as
+// which editions of a logic exist without storing the full 32-byte identifier of every variant.
+// Only the account ID and variant ID of indexed identifiers are retained, so identifiers of different
+// kinds with the same account ID share their variants. A VariantIndex is not safe for concurrent use,
+// and must be created with NewVariantIndex.
+type VariantIndex struct {
+	accounts map[[24]byte]*VariantSet
+}
+
+var (
+	// Ensure VariantIndex implements binary marshaling interfaces
+	_ encoding.BinaryMarshaler   = (*VariantIndex)(nil)
+	_ encoding.BinaryUnmarshaler = (*VariantIndex)(nil)
+)
+
+// NewVariantIndex creates a new empty VariantIndex
+func NewVariantIndex() *VariantIndex {
+	return &VariantIndex{accounts: make(map[[24]byte]*VariantSet)}
+}
+
+// Add adds the variant ID of the given identifier under its account ID.
+// Returns false if the variant is already in the index for the account.
+func (index *VariantIndex) Add(id TaggedIdentifier) bool {
+	set, ok := index.accounts[id.AccountID()]
+	if !ok {
+		set = &VariantSet{}
+		index.accounts[id.AccountID()] = set
+	}
+
+	return set.Add(id.Variant())
+}
+
+// Remove removes the variant ID of the given identifier from its account ID.
+// Returns false if the variant is not in the index for the account.
+func (index *VariantIndex) Remove(id TaggedIdentifier) bool {
+	set, ok := index.accounts[id.AccountID()]
+	if !ok || !set.Remove(id.Variant()) {
+		return false
+	}
+
+	if set.Len() == 0 {
+		delete(index.accounts, id.AccountID())
+	}
+
+	return true
+}
+
+// Has returns whether the variant ID of the given identifier is in the index for its account ID
+func (index *VariantIndex) Has(id TaggedIdentifier) bool {
+	set, ok := index.accounts[id.AccountID()]
+	return ok && set.Contains(id.Variant())
+}
+
+// Variants returns a copy of the set of variant IDs for the given account ID.
+// Returns an empty set if there are no variants for the account.
+func (index *VariantIndex) Variants(account [24]byte) *VariantSet {
+	set, ok := index.accounts[account]
+	if !ok {
+		return &VariantSet{}
+	}
+
+	return set.Clone()
+}
+
+// Accounts returns the account IDs in the index in ascending order
+func (index *VariantIndex) Accounts() [][24]byte {
+	accounts := make([][24]byte, 0, len(index.accounts))
+	for account := range index.accounts {
+		accounts = append(accounts, account)
+	}
+
+	slices.SortFunc(accounts, func(a, b [24]byte) int { return bytes.Compare(a[:], b[:]) })
+
+	return accounts
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for VariantIndex.
+// The index is encoded as the number of accounts (4 bytes, big-endian), followed by each
+// account ID in ascending order with its VariantSet (see VariantSet.MarshalBinary)
+func (index *VariantIndex) MarshalBinary() ([]byte, error) {
+	buffer := binary.BigEndian.AppendUint32(nil, uint32(len(index.accounts)))
+
+	for _, account := range index.Accounts() {
+		encoded, _ := index.accounts[account].MarshalBinary()
+
+		buffer = append(buffer, account[:]...)
+		buffer = append(buffer, encoded...)
+	}
+
+	return buffer, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for VariantIndex.
+// The data must be a valid encoding of a VariantIndex, as produced by VariantIndex.MarshalBinary
+func (index *VariantIndex) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("invalid variant index: %w", ErrInvalidLength)
+	}
+
+	count := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	accounts := make(map[[24]byte]*VariantSet)
+
+	var previous [24]byte
+
+	for i := 0; i < count; i++ {
+		if len(data) < 24 {
+			return fmt.Errorf("invalid variant index: %w", ErrInvalidLength)
+		}
+
+		account := [24]byte(data[:24])
+		if i > 0 && bytes.Compare(account[:], previous[:]) <= 0 {
+			return errors.New("invalid variant index: accounts are not in ascending order")
+		}
+
+		set, remaining, err := decodeVariantSet(data[24:])
+		if err != nil {
+			return err
+		}
+
+		if set.Len() == 0 {
+			return errors.New("invalid variant index: empty variant set")
+		}
+
+		accounts[account], previous, data = set, account, remaining
+	}
+
+	if len(data) != 0 {
+		return fmt.Errorf("invalid variant index: %w", ErrInvalidLength)
+	}
+
+	index.accounts = accounts
+
+	return nil
+}
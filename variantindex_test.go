@@ -0,0 +1,96 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantIndex(t *testing.T) {
+	accountA, accountB := accountWith(0xAA), accountWith(0xBB)
+
+	logicA0 := must(GenerateLogicIDv0(accountA, 0, 0))
+	logicA3 := must(GenerateLogicIDv0(accountA, 3, 0))
+	assetA3 := must(GenerateAssetIDv0(accountA, 3, 0))
+	assetB7 := must(GenerateAssetIDv0(accountB, 7, 0))
+
+	index := NewVariantIndex()
+	require.True(t, index.Add(logicA0))
+	require.True(t, index.Add(logicA3))
+	require.True(t, index.Add(assetB7))
+
+	// Identifiers with the same account and variant share their entry
+	require.False(t, index.Add(assetA3))
+	require.True(t, index.Has(assetA3))
+	require.False(t, index.Has(must(GenerateLogicIDv0(accountA, 4, 0))))
+	require.False(t, index.Has(must(GenerateLogicIDv0(accountWith(0xCC), 0, 0))))
+
+	require.Equal(t, []uint32{0, 3}, index.Variants(accountA).Variants())
+	require.Equal(t, []uint32{7}, index.Variants(accountB).Variants())
+	require.Equal(t, 0, index.Variants(accountWith(0xCC)).Len())
+	require.Equal(t, [][24]byte{accountA, accountB}, index.Accounts())
+
+	// Variants returns a copy of the set
+	index.Variants(accountA).Add(9)
+	require.Equal(t, 2, index.Variants(accountA).Len())
+
+	require.False(t, index.Remove(must(GenerateLogicIDv0(accountWith(0xCC), 0, 0))))
+	require.False(t, index.Remove(must(GenerateLogicIDv0(accountA, 4, 0))))
+	require.True(t, index.Remove(assetB7))
+	require.Equal(t, [][24]byte{accountA}, index.Accounts())
+}
+
+func TestVariantIndex_BinaryMarshal(t *testing.T) {
+	index := NewVariantIndex()
+	index.Add(must(GenerateLogicIDv0(accountWith(0xAA), 1, 0)))
+	index.Add(must(GenerateLogicIDv0(accountWith(0xAA), 0x10000, 0)))
+	index.Add(must(GenerateAssetIDv0(accountWith(0xBB), 2, 0)))
+
+	encoded, err := index.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded := NewVariantIndex()
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, index, decoded)
+
+	t.Run("Errors", func(t *testing.T) {
+		accountA, accountB := accountWith(0xAA), accountWith(0xBB)
+		set := []byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 1, 0, 1}
+
+		entryA := concat(accountA[:], set)
+		entryB := concat(accountB[:], set)
+		empty := concat(accountA[:], []byte{0, 0, 0, 0})
+
+		tests := []struct {
+			name string
+			data []byte
+			err  string
+		}{
+			{"Header", []byte{0}, "invalid variant index: invalid length"},
+			{"Account", []byte{0, 0, 0, 1, 0xAA}, "invalid variant index: invalid length"},
+			{"Order", concat([]byte{0, 0, 0, 2}, entryB, entryA), "accounts are not in ascending order"},
+			{"Set", concat([]byte{0, 0, 0, 1}, entryA[:30]), "invalid variant set: invalid length"},
+			{"Empty", concat([]byte{0, 0, 0, 1}, empty), "invalid variant index: empty variant set"},
+			{"Trailing", concat([]byte{0, 0, 0, 1}, entryA, []byte{0}), "invalid variant index: invalid length"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				require.ErrorContains(t, decoded.UnmarshalBinary(test.data), test.err)
+			})
+		}
+
+		// The index is not modified on failure
+		require.Equal(t, index, decoded)
+	})
+}
+
+// concat returns the concatenation of the given byte slices
+func concat(parts ...[]byte) []byte {
+	var buffer []byte
+	for _, part := range parts {
+		buffer = append(buffer, part...)
+	}
+
+	return buffer
+}
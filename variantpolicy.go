@@ -0,0 +1,131 @@
+package identifiers
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// VariantRange is a range of variant IDs from From to To (both inclusive)
+type VariantRange struct {
+	From, To uint32
+}
+
+// Contains returns whether the given variant ID is in the VariantRange
+func (span VariantRange) Contains(variant uint32) bool {
+	return span.From <= variant && variant <= span.To
+}
+
+// VariantPolicy is a policy of reserved variant ranges for each identifier kind, which encodes protocol
+// conventions such as variants 0-255 being reserved for system use. The policy is enforced by its Validate
+// and DeriveVariant methods, and can be applied to bulk validation with WithValidator(policy.Validate).
+//
+// The zero variant ID identifies the base identifier of an account rather than one of its variants,
+// so identifiers with a zero variant ID are never rejected, even if it is in a reserved range. A
+// VariantPolicy is safe for concurrent use, and must be created with NewVariantPolicy.
+type VariantPolicy struct {
+	mutex sync.RWMutex
+	// reserved contains the sorted and merged reserved ranges for each kind
+	reserved [16][]VariantRange
+}
+
+// NewVariantPolicy creates a new VariantPolicy without any reserved ranges
+func NewVariantPolicy() *VariantPolicy {
+	return &VariantPolicy{}
+}
+
+// Reserve declares the variant IDs from `from` to `to` (both inclusive) as reserved for the given kind.
+// Overlapping and adjacent ranges of a kind are merged. Returns ErrUnsupportedKind if the kind is not
+// supported, or an error if `from` is greater than `to`.
+func (policy *VariantPolicy) Reserve(kind IdentifierKind, from, to uint32) error {
+	if !registry.Load().supports(kind) {
+		return ErrUnsupportedKind
+	}
+
+	if from > to {
+		return fmt.Errorf("invalid variant range: %d is greater than %d", from, to)
+	}
+
+	policy.mutex.Lock()
+	defer policy.mutex.Unlock()
+
+	ranges := append(slices.Clone(policy.reserved[kind]), VariantRange{From: from, To: to})
+	slices.SortFunc(ranges, func(a, b VariantRange) int { return cmp.Compare(a.From, b.From) })
+
+	// Merge the ranges that overlap or are adjacent to the previous range
+	merged := ranges[:1]
+
+	for _, span := range ranges[1:] {
+		last := &merged[len(merged)-1]
+
+		if uint64(span.From) <= uint64(last.To)+1 {
+			last.To = max(last.To, span.To)
+			continue
+		}
+
+		merged = append(merged, span)
+	}
+
+	policy.reserved[kind] = merged
+
+	return nil
+}
+
+// Ranges returns the reserved ranges of the given kind in ascending order
+func (policy *VariantPolicy) Ranges(kind IdentifierKind) []VariantRange {
+	if kind > 0x0F {
+		return nil
+	}
+
+	policy.mutex.RLock()
+	defer policy.mutex.RUnlock()
+
+	return slices.Clone(policy.reserved[kind])
+}
+
+// IsReserved returns whether the given variant ID is reserved for the given kind.
+// The zero variant ID is never reserved (see VariantPolicy).
+func (policy *VariantPolicy) IsReserved(kind IdentifierKind, variant uint32) bool {
+	if kind > 0x0F || variant == 0 {
+		return false
+	}
+
+	policy.mutex.RLock()
+	defer policy.mutex.RUnlock()
+
+	ranges := policy.reserved[kind]
+
+	// Find the first range that ends at or after the variant, which contains it if it starts before it
+	position, _ := slices.BinarySearchFunc(ranges, variant, func(span VariantRange, variant uint32) int {
+		return cmp.Compare(span.To, variant)
+	})
+
+	return position < len(ranges) && ranges[position].From <= variant
+}
+
+// Validate returns an error if the given identifier is invalid (see Identifier.Validate),
+// or if its variant ID is reserved for its kind. Returns an error that wraps ErrReservedVariant
+// for reserved variant IDs.
+func (policy *VariantPolicy) Validate(id Identifier) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	if policy.IsReserved(id.Tag().Kind(), id.Variant()) {
+		return fmt.Errorf("%w: variant %d of %v id", ErrReservedVariant, id.Variant(), id.Tag().Kind())
+	}
+
+	return nil
+}
+
+// DeriveVariant derives a new identifier from the given identifier with the given variant ID and specified
+// flags set/unset (see Identifier.DeriveVariant). Returns an error if the given flags are not supported for
+// the identifier, or an error that wraps ErrReservedVariant if the variant ID is reserved for its kind.
+func (policy *VariantPolicy) DeriveVariant(id Identifier, variant uint32, set, unset []Flag) (Identifier, error) {
+	if policy.IsReserved(id.Tag().Kind(), variant) {
+		return Nil, fmt.Errorf("%w: variant %d of %v id", ErrReservedVariant, variant, id.Tag().Kind())
+	}
+
+	return id.DeriveVariant(variant, set, unset)
+}
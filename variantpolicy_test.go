@@ -0,0 +1,120 @@
+package identifiers
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantRange_Contains(t *testing.T) {
+	span := VariantRange{From: 10, To: 20}
+
+	assert.True(t, span.Contains(10))
+	assert.True(t, span.Contains(15))
+	assert.True(t, span.Contains(20))
+	assert.False(t, span.Contains(9))
+	assert.False(t, span.Contains(21))
+}
+
+func TestVariantPolicy_Reserve(t *testing.T) {
+	policy := NewVariantPolicy()
+
+	require.NoError(t, policy.Reserve(KindAsset, 100, 200))
+	require.NoError(t, policy.Reserve(KindAsset, 0, 10))
+	require.NoError(t, policy.Reserve(KindAsset, 300, 400))
+	assert.Equal(t, []VariantRange{{0, 10}, {100, 200}, {300, 400}}, policy.Ranges(KindAsset))
+
+	// Test that overlapping and adjacent ranges are merged
+	require.NoError(t, policy.Reserve(KindAsset, 150, 250))
+	require.NoError(t, policy.Reserve(KindAsset, 11, 20))
+	assert.Equal(t, []VariantRange{{0, 20}, {100, 250}, {300, 400}}, policy.Ranges(KindAsset))
+
+	require.NoError(t, policy.Reserve(KindAsset, 50, 350))
+	assert.Equal(t, []VariantRange{{0, 20}, {50, 400}}, policy.Ranges(KindAsset))
+
+	// Test that ranges at the end of the variant space are merged without overflowing
+	require.NoError(t, policy.Reserve(KindAsset, math.MaxUint32, math.MaxUint32))
+	require.NoError(t, policy.Reserve(KindAsset, math.MaxUint32-1, math.MaxUint32))
+	assert.Equal(t, []VariantRange{{0, 20}, {50, 400}, {math.MaxUint32 - 1, math.MaxUint32}}, policy.Ranges(KindAsset))
+
+	// Test that ranges are declared per kind
+	assert.Empty(t, policy.Ranges(KindLogic))
+	assert.Nil(t, policy.Ranges(IdentifierKind(0x10)))
+
+	// Test that the returned ranges are a copy
+	policy.Ranges(KindAsset)[0].To = 1000
+	assert.Equal(t, VariantRange{0, 20}, policy.Ranges(KindAsset)[0])
+
+	assert.EqualError(t, policy.Reserve(KindAsset, 20, 10), "invalid variant range: 20 is greater than 10")
+	assert.Equal(t, ErrUnsupportedKind, policy.Reserve(IdentifierKind(0x0E), 0, 10))
+	assert.Equal(t, ErrUnsupportedKind, policy.Reserve(IdentifierKind(0x10), 0, 10))
+}
+
+func TestVariantPolicy_IsReserved(t *testing.T) {
+	policy := NewVariantPolicy()
+	require.NoError(t, policy.Reserve(KindLogic, 0, 255))
+	require.NoError(t, policy.Reserve(KindLogic, 1000, 1999))
+
+	for variant, reserved := range map[uint32]bool{
+		0: false, 1: true, 255: true, 256: false, 999: false, 1000: true, 1500: true, 1999: true, 2000: false,
+	} {
+		assert.Equal(t, reserved, policy.IsReserved(KindLogic, variant), "variant %d", variant)
+	}
+
+	assert.False(t, policy.IsReserved(KindAsset, 1))
+	assert.False(t, policy.IsReserved(IdentifierKind(0x10), 1))
+}
+
+func TestVariantPolicy_Validate(t *testing.T) {
+	policy := NewVariantPolicy()
+	require.NoError(t, policy.Reserve(KindAsset, 0, 255))
+
+	base := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0))
+
+	// Test that the zero variant and unreserved variants are valid
+	assert.NoError(t, policy.Validate(base.AsIdentifier()))
+	assert.NoError(t, policy.Validate(must(base.DeriveVariant(256, nil, nil)).AsIdentifier()))
+	assert.NoError(t, policy.Validate(must(GenerateLogicIDv0(RandomFingerprint(), 42, 0)).AsIdentifier()))
+
+	err := policy.Validate(must(base.DeriveVariant(42, nil, nil)).AsIdentifier())
+	assert.ErrorIs(t, err, ErrReservedVariant)
+	assert.EqualError(t, err, "reserved variant: variant 42 of asset id")
+
+	var invalid Identifier
+	invalid[0] = 0xF0
+
+	assert.EqualError(t, policy.Validate(invalid), "invalid tag: unsupported tag kind")
+
+	// Test that the policy can be enforced in bulk validation
+	ids := []Identifier{base.AsIdentifier(), must(base.DeriveVariant(7, nil, nil)).AsIdentifier()}
+	report := ValidateAll(context.Background(), ids, WithValidator(policy.Validate))
+
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, 1, report.Errors[0].Index)
+	assert.ErrorIs(t, report.Errors[0].Err, ErrReservedVariant)
+}
+
+func TestVariantPolicy_DeriveVariant(t *testing.T) {
+	policy := NewVariantPolicy()
+	require.NoError(t, policy.Reserve(KindAsset, 0, 255))
+
+	base := must(GenerateAssetIDv0(RandomFingerprint(), 0, 0)).AsIdentifier()
+
+	derived, err := policy.DeriveVariant(base, 256, []Flag{AssetStateful}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, must(base.DeriveVariant(256, []Flag{AssetStateful}, nil)), derived)
+
+	// Test that deriving the zero variant is allowed
+	derived, err = policy.DeriveVariant(derived, 0, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), derived.Variant())
+
+	_, err = policy.DeriveVariant(base, 255, nil, nil)
+	assert.EqualError(t, err, "reserved variant: variant 255 of asset id")
+
+	_, err = policy.DeriveVariant(base, 256, []Flag{LogicIntrinsic}, nil)
+	assert.Equal(t, ErrUnsupportedFlag, err)
+}
@@ -0,0 +1,52 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"iter"
+	"math"
+)
+
+// Variants returns an iterator over the variants of the Identifier with the variant IDs from
+// `from` to `to` (both inclusive) in ascending order. Each variant retains the tag, flags, metadata
+// and account ID of the Identifier, like Identifier.DeriveVariant without any changes to its flags.
+// The iterator yields no identifiers if `from` is greater than `to`.
+func (id Identifier) Variants(from, to uint32) iter.Seq[Identifier] {
+	return func(yield func(Identifier) bool) {
+		if from > to {
+			return
+		}
+
+		variant := id
+
+		for current := from; ; current++ {
+			binary.BigEndian.PutUint32(variant[28:], current)
+			if !yield(variant) {
+				return
+			}
+
+			// Stop at the last variant without overflowing the variant ID
+			if current == to {
+				return
+			}
+		}
+	}
+}
+
+// VariantsOfAccount returns an iterator over all the identifiers of the given kind with the given
+// account ID in ascending order of their variant IDs, starting from the zero variant. The identifiers
+// have the v0 tag of the kind with no flags or metadata set. The iterator yields no identifiers if
+// the kind is not supported. As the iterator covers the entire variant space, callers are expected
+// to stop iterating once they have found the identifiers they need.
+func VariantsOfAccount(account [24]byte, kind IdentifierKind) iter.Seq[Identifier] {
+	tag, err := NewIdentifierTag(kind, 0)
+	if err != nil {
+		return func(func(Identifier) bool) {}
+	}
+
+	var base Identifier
+
+	base[0] = byte(tag)
+	copy(base[4:28], account[:])
+
+	return base.Variants(0, math.MaxUint32)
+}
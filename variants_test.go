@@ -0,0 +1,75 @@
+package identifiers
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier_Variants(t *testing.T) {
+	asset := must(GenerateAssetIDv0(RandomFingerprint(), 0, 3, AssetStateful)).AsIdentifier()
+
+	t.Run("Range", func(t *testing.T) {
+		variants := slices.Collect(asset.Variants(5, 9))
+		require.Len(t, variants, 5)
+
+		for position, variant := range variants {
+			assert.Equal(t, must(asset.DeriveVariant(uint32(5+position), nil, nil)), variant)
+		}
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		assert.Equal(t, []Identifier{must(asset.DeriveVariant(7, nil, nil))}, slices.Collect(asset.Variants(7, 7)))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Empty(t, slices.Collect(asset.Variants(9, 5)))
+	})
+
+	t.Run("Maximum", func(t *testing.T) {
+		variants := slices.Collect(asset.Variants(math.MaxUint32-1, math.MaxUint32))
+		require.Len(t, variants, 2)
+		assert.Equal(t, uint32(math.MaxUint32), variants[1].Variant())
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		var variants []uint32
+
+		for variant := range asset.Variants(0, math.MaxUint32) {
+			if variant.Variant() == 3 {
+				break
+			}
+
+			variants = append(variants, variant.Variant())
+		}
+
+		assert.Equal(t, []uint32{0, 1, 2}, variants)
+	})
+}
+
+func TestVariantsOfAccount(t *testing.T) {
+	account := RandomFingerprint()
+
+	var logics []LogicID
+
+	for id := range VariantsOfAccount(account, KindLogic) {
+		if len(logics) == 3 {
+			break
+		}
+
+		logics = append(logics, must(id.AsLogicID()))
+	}
+
+	require.Len(t, logics, 3)
+
+	for variant, logic := range logics {
+		assert.Equal(t, must(GenerateLogicIDv0(account, uint32(variant), 0)), logic)
+	}
+
+	// Test that unsupported kinds yield no identifiers
+	assert.Empty(t, slices.Collect(VariantsOfAccount(account, IdentifierKind(0x0E))))
+	assert.Empty(t, slices.Collect(VariantsOfAccount(account, IdentifierKind(0x1F))))
+}
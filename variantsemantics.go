@@ -0,0 +1,78 @@
+package identifiers
+
+import (
+	"fmt"
+)
+
+// VariantSemantics describes how the variant ID of an identifier kind is interpreted.
+// The semantics of each kind are declared in the registry, with the semantics of builtin
+// kinds defined by this package and the semantics of custom kinds declared with RegisterKind.
+type VariantSemantics uint8
+
+const (
+	// OpaqueVariant is the VariantSemantics of kinds whose variant ID has no defined meaning
+	OpaqueVariant VariantSemantics = iota
+	// SubAccountVariant is the VariantSemantics of kinds whose variant ID
+	// identifies a sub-account of the account, such as ParticipantID
+	SubAccountVariant
+	// SeriesVariant is the VariantSemantics of kinds whose variant ID identifies
+	// an item in a series under the same account, such as the editions of an AssetID
+	SeriesVariant
+	// EditionVariant is the VariantSemantics of kinds whose variant ID identifies
+	// an edition (version) of the same entity, such as the upgrades of a LogicID
+	EditionVariant
+	// IndexVariant is the VariantSemantics of kinds whose variant ID is the index of the entity
+	// within its parent entity, such as the index of a ReceiptID within its interaction
+	IndexVariant
+)
+
+// variantSemanticsNames is a map of VariantSemantics to its name
+var variantSemanticsNames = map[VariantSemantics]string{
+	OpaqueVariant:     "opaque",
+	SubAccountVariant: "sub-account",
+	SeriesVariant:     "series",
+	EditionVariant:    "edition",
+	IndexVariant:      "index",
+}
+
+// defaultVariantSemantics is the VariantSemantics of each builtin IdentifierKind.
+// It is the initial state of the registry tables, to which the semantics of custom kinds
+// can be added with RegisterKind. Kinds that are not listed have opaque variant IDs.
+var defaultVariantSemantics = map[IdentifierKind]VariantSemantics{
+	KindParticipant: SubAccountVariant,
+	KindAsset:       SeriesVariant,
+	KindLogic:       EditionVariant,
+	KindReceipt:     IndexVariant,
+	KindKey:         IndexVariant,
+}
+
+// String returns the name of the VariantSemantics, such as "edition".
+// Unknown semantics are rendered as "semantics(<value>)".
+func (semantics VariantSemantics) String() string {
+	if name, ok := variantSemanticsNames[semantics]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("semantics(%d)", uint8(semantics))
+}
+
+// VariantSemantics returns the VariantSemantics of the IdentifierKind.
+// Returns OpaqueVariant for kinds that are not recognized.
+func (kind IdentifierKind) VariantSemantics() VariantSemantics {
+	current := registry.Load()
+	if !current.supports(kind) {
+		return OpaqueVariant
+	}
+
+	return current.variantSemantics[kind]
+}
+
+// variantAs returns the variant ID of the given identifier and whether the variant
+// ID of its kind is interpreted with the given VariantSemantics.
+func variantAs(id Identifier, semantics VariantSemantics) (uint32, bool) {
+	if id.Tag().Kind().VariantSemantics() != semantics {
+		return 0, false
+	}
+
+	return id.Variant(), true
+}
@@ -0,0 +1,71 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantSemantics_String(t *testing.T) {
+	assert.Equal(t, "opaque", OpaqueVariant.String())
+	assert.Equal(t, "sub-account", SubAccountVariant.String())
+	assert.Equal(t, "series", SeriesVariant.String())
+	assert.Equal(t, "edition", EditionVariant.String())
+	assert.Equal(t, "index", IndexVariant.String())
+	assert.Equal(t, "semantics(16)", VariantSemantics(16).String())
+}
+
+func TestIdentifierKind_VariantSemantics(t *testing.T) {
+	tests := []struct {
+		kind      IdentifierKind
+		semantics VariantSemantics
+	}{
+		{KindParticipant, SubAccountVariant},
+		{KindAsset, SeriesVariant},
+		{KindLogic, EditionVariant},
+		{KindReceipt, IndexVariant},
+		{KindKey, IndexVariant},
+		{KindInteraction, OpaqueVariant},
+		{KindTopic, OpaqueVariant},
+		{IdentifierKind(0x0E), OpaqueVariant},
+		{IdentifierKind(0x10), OpaqueVariant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			assert.Equal(t, tt.semantics, tt.kind.VariantSemantics())
+		})
+	}
+}
+
+func TestVariantAccessors(t *testing.T) {
+	participant := must(GenerateParticipantIDv0(RandomFingerprint(), 3))
+	interaction := must(GenerateInteractionIDv0(participant, 9))
+
+	accessors := []struct {
+		name     string
+		accessor func() (uint32, bool)
+		variant  uint32
+	}{
+		{"SubAccount", participant.SubAccount, 3},
+		{"Series", must(GenerateAssetIDv0(RandomFingerprint(), 5, 0)).Series, 5},
+		{"Edition", must(GenerateLogicIDv0(RandomFingerprint(), 7, 0)).Edition, 7},
+		{"ReceiptIndex", must(GenerateReceiptIDv0(interaction, 11)).Index, 11},
+		{"KeyIndex", must(GenerateKeyIDv0(participant, 13)).Index, 13},
+	}
+
+	for _, tt := range accessors {
+		t.Run(tt.name, func(t *testing.T) {
+			variant, ok := tt.accessor()
+			assert.True(t, ok)
+			assert.Equal(t, tt.variant, variant)
+		})
+	}
+
+	t.Run("Mismatch", func(t *testing.T) {
+		// The accessors are driven by the semantics of the kind in the tag
+		variant, ok := LogicID(must(GenerateAssetIDv0(RandomFingerprint(), 5, 0))).Edition()
+		assert.False(t, ok)
+		assert.Zero(t, variant)
+	})
+}
@@ -0,0 +1,439 @@
+package identifiers
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// arrayContainerMax is the maximum cardinality of an array container.
+	// Containers with a greater cardinality are stored as bitmaps.
+	arrayContainerMax = 4096
+	// bitmapContainerWords is the number of 64-bit words in a bitmap container
+	bitmapContainerWords = 1 << 16 / 64
+)
+
+// VariantSet is a compressed set of 32-bit variant IDs, based on roaring bitmaps.
+//
+// Variant IDs are partitioned by their upper 16 bits into containers, which store the lower 16 bits
+// either as a sorted array (for sparse containers) or as a bitmap (for dense containers). This
+// keeps the set compact for both sparse and dense variant IDs. The zero value is an empty set
+// that is ready to use. A VariantSet is not safe for concurrent use.
+type VariantSet struct {
+	keys       []uint16
+	containers []*variantContainer
+}
+
+// variantContainer stores the lower 16 bits of the variant IDs with the same upper 16 bits.
+// It is stored as a sorted array if its cardinality is at most arrayContainerMax, or as a bitmap otherwise.
+type variantContainer struct {
+	array  []uint16
+	bitmap []uint64
+	count  int
+}
+
+var (
+	// Ensure VariantSet implements binary marshaling interfaces
+	_ encoding.BinaryMarshaler   = (*VariantSet)(nil)
+	_ encoding.BinaryUnmarshaler = (*VariantSet)(nil)
+)
+
+// contains returns whether the container contains the given value
+func (container *variantContainer) contains(low uint16) bool {
+	if container.bitmap != nil {
+		return container.bitmap[low>>6]&(1<<(low&63)) != 0
+	}
+
+	_, found := slices.BinarySearch(container.array, low)
+
+	return found
+}
+
+// add adds the given value to the container and returns whether it was added
+func (container *variantContainer) add(low uint16) bool {
+	if container.bitmap != nil {
+		word, bit := low>>6, uint64(1)<<(low&63)
+		if container.bitmap[word]&bit != 0 {
+			return false
+		}
+
+		container.bitmap[word] |= bit
+		container.count++
+
+		return true
+	}
+
+	position, found := slices.BinarySearch(container.array, low)
+	if found {
+		return false
+	}
+
+	container.array = slices.Insert(container.array, position, low)
+	container.count++
+
+	if container.count > arrayContainerMax {
+		container.bitmap, container.array = container.words(), nil
+	}
+
+	return true
+}
+
+// remove removes the given value from the container and returns whether it was removed
+func (container *variantContainer) remove(low uint16) bool {
+	if container.bitmap != nil {
+		word, bit := low>>6, uint64(1)<<(low&63)
+		if container.bitmap[word]&bit == 0 {
+			return false
+		}
+
+		container.bitmap[word] &^= bit
+		container.count--
+
+		if container.count <= arrayContainerMax {
+			container.array, container.bitmap = container.values(), nil
+		}
+
+		return true
+	}
+
+	position, found := slices.BinarySearch(container.array, low)
+	if !found {
+		return false
+	}
+
+	container.array = slices.Delete(container.array, position, position+1)
+	container.count--
+
+	return true
+}
+
+// each calls the given function for each value in the container in ascending order.
+// Returns false if the iteration was stopped by the function.
+func (container *variantContainer) each(fn func(uint16) bool) bool {
+	if container.bitmap == nil {
+		for _, low := range container.array {
+			if !fn(low) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for index, word := range container.bitmap {
+		for word != 0 {
+			if !fn(uint16(index*64 + bits.TrailingZeros64(word))) {
+				return false
+			}
+
+			word &= word - 1
+		}
+	}
+
+	return true
+}
+
+// values returns the values in the container as a sorted array
+func (container *variantContainer) values() []uint16 {
+	values := make([]uint16, 0, container.count)
+	container.each(func(low uint16) bool {
+		values = append(values, low)
+		return true
+	})
+
+	return values
+}
+
+// words returns the values in the container as a bitmap.
+// The returned bitmap is a copy if the container is stored as a bitmap.
+func (container *variantContainer) words() []uint64 {
+	words := make([]uint64, bitmapContainerWords)
+	if container == nil {
+		return words
+	}
+
+	if container.bitmap != nil {
+		copy(words, container.bitmap)
+		return words
+	}
+
+	for _, low := range container.array {
+		words[low>>6] |= 1 << (low & 63)
+	}
+
+	return words
+}
+
+// clone returns a deep copy of the container
+func (container *variantContainer) clone() *variantContainer {
+	return &variantContainer{
+		array:  slices.Clone(container.array),
+		bitmap: slices.Clone(container.bitmap),
+		count:  container.count,
+	}
+}
+
+// find returns the position of the container for the given key and whether it exists
+func (set *VariantSet) find(key uint16) (int, bool) {
+	return slices.BinarySearch(set.keys, key)
+}
+
+// Add adds the given variant ID to the set and returns whether it was added
+func (set *VariantSet) Add(variant uint32) bool {
+	key, low := uint16(variant>>16), uint16(variant)
+
+	position, found := set.find(key)
+	if !found {
+		set.keys = slices.Insert(set.keys, position, key)
+		set.containers = slices.Insert(set.containers, position, &variantContainer{})
+	}
+
+	return set.containers[position].add(low)
+}
+
+// Remove removes the given variant ID from the set and returns whether it was removed
+func (set *VariantSet) Remove(variant uint32) bool {
+	key, low := uint16(variant>>16), uint16(variant)
+
+	position, found := set.find(key)
+	if !found || !set.containers[position].remove(low) {
+		return false
+	}
+
+	// Remove the container if it is empty
+	if set.containers[position].count == 0 {
+		set.keys = slices.Delete(set.keys, position, position+1)
+		set.containers = slices.Delete(set.containers, position, position+1)
+	}
+
+	return true
+}
+
+// Contains returns whether the set contains the given variant ID
+func (set *VariantSet) Contains(variant uint32) bool {
+	position, found := set.find(uint16(variant >> 16))
+	return found && set.containers[position].contains(uint16(variant))
+}
+
+// Len returns the number of variant IDs in the set
+func (set *VariantSet) Len() int {
+	count := 0
+	for _, container := range set.containers {
+		count += container.count
+	}
+
+	return count
+}
+
+// Range calls the given function for each variant ID in the set in ascending order.
+// Iteration stops if the function returns false.
+func (set *VariantSet) Range(fn func(uint32) bool) {
+	for position, key := range set.keys {
+		proceed := set.containers[position].each(func(low uint16) bool {
+			return fn(uint32(key)<<16 | uint32(low))
+		})
+
+		if !proceed {
+			return
+		}
+	}
+}
+
+// Variants returns the variant IDs in the set in ascending order
+func (set *VariantSet) Variants() []uint32 {
+	variants := make([]uint32, 0, set.Len())
+	set.Range(func(variant uint32) bool {
+		variants = append(variants, variant)
+		return true
+	})
+
+	return variants
+}
+
+// Clone returns a deep copy of the set
+func (set *VariantSet) Clone() *VariantSet {
+	clone := &VariantSet{keys: slices.Clone(set.keys), containers: make([]*variantContainer, len(set.containers))}
+	for position, container := range set.containers {
+		clone.containers[position] = container.clone()
+	}
+
+	return clone
+}
+
+// Union returns a new set with the variant IDs that are in either set
+func (set *VariantSet) Union(other *VariantSet) *VariantSet {
+	return set.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a new set with the variant IDs that are in both sets
+func (set *VariantSet) Intersect(other *VariantSet) *VariantSet {
+	return set.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference returns a new set with the variant IDs that are in this set but not in the other set
+func (set *VariantSet) Difference(other *VariantSet) *VariantSet {
+	return set.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// combine returns a new set by combining the containers of both sets word by word with the given operation
+func (set *VariantSet) combine(other *VariantSet, operation func(a, b uint64) uint64) *VariantSet {
+	keys := append(slices.Clone(set.keys), other.keys...)
+	slices.Sort(keys)
+
+	keys = slices.Compact(keys)
+	result := &VariantSet{}
+
+	for _, key := range keys {
+		var left, right *variantContainer
+
+		if position, found := set.find(key); found {
+			left = set.containers[position]
+		}
+
+		if position, found := other.find(key); found {
+			right = other.containers[position]
+		}
+
+		combined := &variantContainer{bitmap: left.words()}
+		for index, word := range right.words() {
+			combined.bitmap[index] = operation(combined.bitmap[index], word)
+			combined.count += bits.OnesCount64(combined.bitmap[index])
+		}
+
+		if combined.count == 0 {
+			continue
+		}
+
+		if combined.count <= arrayContainerMax {
+			combined.array, combined.bitmap = combined.values(), nil
+		}
+
+		result.keys = append(result.keys, key)
+		result.containers = append(result.containers, combined)
+	}
+
+	return result
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for VariantSet.
+//
+// The set is encoded as the number of containers (4 bytes), followed by each container as its key
+// (2 bytes) and cardinality (4 bytes), and its values as an array of 2-byte values if the cardinality
+// is at most 4096 or as a bitmap of 1024 8-byte words otherwise. All integers are big-endian.
+func (set *VariantSet) MarshalBinary() ([]byte, error) {
+	buffer := binary.BigEndian.AppendUint32(nil, uint32(len(set.keys)))
+
+	for position, key := range set.keys {
+		container := set.containers[position]
+
+		buffer = binary.BigEndian.AppendUint16(buffer, key)
+		buffer = binary.BigEndian.AppendUint32(buffer, uint32(container.count))
+
+		if container.bitmap != nil {
+			for _, word := range container.bitmap {
+				buffer = binary.BigEndian.AppendUint64(buffer, word)
+			}
+
+			continue
+		}
+
+		for _, low := range container.array {
+			buffer = binary.BigEndian.AppendUint16(buffer, low)
+		}
+	}
+
+	return buffer, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for VariantSet.
+// The data must be a valid encoding of a VariantSet, as produced by VariantSet.MarshalBinary
+func (set *VariantSet) UnmarshalBinary(data []byte) error {
+	decoded, remaining, err := decodeVariantSet(data)
+	if err != nil {
+		return err
+	}
+
+	if len(remaining) != 0 {
+		return fmt.Errorf("invalid variant set: %w", ErrInvalidLength)
+	}
+
+	*set = *decoded
+
+	return nil
+}
+
+// decodeVariantSet decodes a VariantSet from the start of the given data and returns the remaining data
+func decodeVariantSet(data []byte) (*VariantSet, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("invalid variant set: %w", ErrInvalidLength)
+	}
+
+	count := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	set := &VariantSet{}
+
+	for i := 0; i < count; i++ {
+		if len(data) < 6 {
+			return nil, nil, fmt.Errorf("invalid variant set: %w", ErrInvalidLength)
+		}
+
+		key, cardinality := binary.BigEndian.Uint16(data), int(binary.BigEndian.Uint32(data[2:]))
+		data = data[6:]
+
+		if len(set.keys) > 0 && key <= set.keys[len(set.keys)-1] {
+			return nil, nil, errors.New("invalid variant set: container keys are not in ascending order")
+		}
+
+		if cardinality == 0 || cardinality > 1<<16 {
+			return nil, nil, fmt.Errorf("invalid variant set: invalid container cardinality %d", cardinality)
+		}
+
+		container := &variantContainer{count: cardinality}
+
+		if cardinality > arrayContainerMax {
+			if len(data) < bitmapContainerWords*8 {
+				return nil, nil, fmt.Errorf("invalid variant set: %w", ErrInvalidLength)
+			}
+
+			container.bitmap = make([]uint64, bitmapContainerWords)
+			population := 0
+
+			for index := range container.bitmap {
+				container.bitmap[index] = binary.BigEndian.Uint64(data[index*8:])
+				population += bits.OnesCount64(container.bitmap[index])
+			}
+
+			if population != cardinality {
+				return nil, nil, errors.New("invalid variant set: container cardinality does not match bitmap")
+			}
+
+			data = data[bitmapContainerWords*8:]
+		} else {
+			if len(data) < cardinality*2 {
+				return nil, nil, fmt.Errorf("invalid variant set: %w", ErrInvalidLength)
+			}
+
+			container.array = make([]uint16, cardinality)
+
+			for index := range container.array {
+				container.array[index] = binary.BigEndian.Uint16(data[index*2:])
+
+				if index > 0 && container.array[index] <= container.array[index-1] {
+					return nil, nil, errors.New("invalid variant set: container values are not in ascending order")
+				}
+			}
+
+			data = data[cardinality*2:]
+		}
+
+		set.keys = append(set.keys, key)
+		set.containers = append(set.containers, container)
+	}
+
+	return set, data, nil
+}
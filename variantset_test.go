@@ -0,0 +1,184 @@
+package identifiers
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// denseVariantSet returns a VariantSet with the variants from start (inclusive) to end (exclusive)
+func denseVariantSet(start, end uint32) *VariantSet {
+	set := &VariantSet{}
+	for variant := start; variant < end; variant++ {
+		set.Add(variant)
+	}
+
+	return set
+}
+
+func TestVariantSet(t *testing.T) {
+	var set VariantSet
+
+	require.True(t, set.Add(5))
+	require.True(t, set.Add(1))
+	require.True(t, set.Add(0x10000))
+	require.False(t, set.Add(5))
+
+	require.True(t, set.Contains(1))
+	require.True(t, set.Contains(0x10000))
+	require.False(t, set.Contains(2))
+	require.False(t, set.Contains(0x20000))
+	require.Equal(t, 3, set.Len())
+	require.Equal(t, []uint32{1, 5, 0x10000}, set.Variants())
+
+	require.False(t, set.Remove(2))
+	require.False(t, set.Remove(0x20000))
+	require.True(t, set.Remove(0x10000))
+	require.Equal(t, []uint16{0}, set.keys)
+	require.Equal(t, []uint32{1, 5}, set.Variants())
+
+	// Range stops when the function returns false
+	var ranged []uint32
+
+	set.Range(func(variant uint32) bool {
+		ranged = append(ranged, variant)
+		return false
+	})
+	require.Equal(t, []uint32{1}, ranged)
+
+	// Clones are independent of the original set
+	clone := set.Clone()
+	clone.Add(7)
+	require.False(t, set.Contains(7))
+}
+
+func TestVariantSet_Bitmap(t *testing.T) {
+	set := denseVariantSet(0, arrayContainerMax)
+	require.Nil(t, set.containers[0].bitmap)
+
+	// Exceeding the array capacity converts the container into a bitmap
+	require.True(t, set.Add(arrayContainerMax))
+	require.NotNil(t, set.containers[0].bitmap)
+	require.False(t, set.Add(arrayContainerMax))
+	require.True(t, set.Contains(100))
+	require.False(t, set.Contains(5000))
+	require.Equal(t, arrayContainerMax+1, set.Len())
+
+	variants := set.Variants()
+	require.Len(t, variants, arrayContainerMax+1)
+	require.Equal(t, uint32(arrayContainerMax), variants[arrayContainerMax])
+
+	// Range stops within a bitmap container
+	count := 0
+	set.Range(func(uint32) bool {
+		count++
+		return count < 10
+	})
+	require.Equal(t, 10, count)
+
+	// Falling back to the array capacity converts the container into an array
+	require.False(t, set.Remove(5000))
+	require.True(t, set.Remove(0))
+	require.Nil(t, set.containers[0].bitmap)
+	require.Equal(t, arrayContainerMax, set.Len())
+	require.Equal(t, uint32(1), set.Variants()[0])
+}
+
+func TestVariantSet_Operations(t *testing.T) {
+	a := denseVariantSet(0, 10)
+	a.Add(0x20000)
+
+	b := denseVariantSet(5, 15)
+	b.Add(0x30000)
+
+	require.Equal(t, append(denseVariantSet(0, 15).Variants(), 0x20000, 0x30000), a.Union(b).Variants())
+	require.Equal(t, denseVariantSet(5, 10).Variants(), a.Intersect(b).Variants())
+	require.Equal(t, append(denseVariantSet(0, 5).Variants(), 0x20000), a.Difference(b).Variants())
+
+	// Empty containers are dropped from the results
+	require.Equal(t, []uint16{0}, a.Intersect(b).keys)
+
+	t.Run("Dense", func(t *testing.T) {
+		dense := denseVariantSet(0, 10000)
+		sparse := denseVariantSet(9990, 10010)
+
+		union := dense.Union(sparse)
+		require.Equal(t, 10010, union.Len())
+		require.NotNil(t, union.containers[0].bitmap)
+
+		intersection := dense.Intersect(sparse)
+		require.Equal(t, denseVariantSet(9990, 10000).Variants(), intersection.Variants())
+		require.Nil(t, intersection.containers[0].bitmap)
+
+		require.Equal(t, 9990, dense.Difference(sparse).Len())
+		require.Equal(t, 10000, dense.Clone().Len())
+	})
+}
+
+func TestVariantSet_BinaryMarshal(t *testing.T) {
+	set := denseVariantSet(0, 5000)
+	set.Add(0x10001)
+	set.Add(0x10003)
+
+	encoded, err := set.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, encoded, 4+(6+8192)+(6+4))
+
+	decoded := &VariantSet{}
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, set, decoded)
+
+	empty, _ := (&VariantSet{}).MarshalBinary()
+	require.Equal(t, []byte{0, 0, 0, 0}, empty)
+	require.NoError(t, decoded.UnmarshalBinary(empty))
+	require.Equal(t, 0, decoded.Len())
+
+	t.Run("Errors", func(t *testing.T) {
+		array := func(key uint16, values ...uint16) []byte {
+			buffer := binary.BigEndian.AppendUint16(nil, key)
+			buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(values)))
+
+			for _, value := range values {
+				buffer = binary.BigEndian.AppendUint16(buffer, value)
+			}
+
+			return buffer
+		}
+
+		header := func(count uint32, containers ...[]byte) []byte {
+			buffer := binary.BigEndian.AppendUint32(nil, count)
+			for _, container := range containers {
+				buffer = append(buffer, container...)
+			}
+
+			return buffer
+		}
+
+		bitmap := binary.BigEndian.AppendUint16(nil, 0)
+		bitmap = binary.BigEndian.AppendUint32(bitmap, arrayContainerMax+1)
+
+		tests := []struct {
+			name string
+			data []byte
+			err  string
+		}{
+			{"Header", []byte{0, 0}, "invalid variant set: invalid length"},
+			{"ContainerHeader", header(1, []byte{0, 0, 0}), "invalid variant set: invalid length"},
+			{"KeyOrder", header(2, array(1, 1), array(1, 2)), "container keys are not in ascending order"},
+			{"CardinalityZero", header(1, array(1)), "invalid container cardinality 0"},
+			{"CardinalityLarge", header(1, []byte{0, 0, 0, 1, 0, 1}), "invalid container cardinality 65537"},
+			{"BitmapLength", header(1, bitmap), "invalid variant set: invalid length"},
+			{"BitmapCount", header(1, bitmap, make([]byte, 8192)), "cardinality does not match bitmap"},
+			{"ArrayLength", header(1, array(1, 1)[:7]), "invalid variant set: invalid length"},
+			{"ArrayOrder", header(1, array(1, 2, 1)), "container values are not in ascending order"},
+			{"Trailing", append(header(1, array(1, 1)), 0), "invalid variant set: invalid length"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				require.ErrorContains(t, decoded.UnmarshalBinary(test.data), test.err)
+			})
+		}
+	})
+}